@@ -0,0 +1,156 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/n10ty/houp/pkg/isodata"
+)
+
+// runData implements the "houp data" subcommand
+func runData(args []string) int {
+	if len(args) == 0 {
+		dataUsage()
+		return 1
+	}
+
+	switch args[0] {
+	case "update":
+		return runDataUpdate(args[1:])
+	case "version":
+		fmt.Printf("countries  %s (%d entries)\n", isodata.CountriesVersion, len(isodata.Countries))
+		fmt.Printf("currencies %s (%d entries)\n", isodata.CurrenciesVersion, len(isodata.Currencies))
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown data command %q\n\n", args[0])
+		dataUsage()
+		return 1
+	}
+}
+
+func dataUsage() {
+	fmt.Fprintf(os.Stderr, `Usage:
+  houp data update [options]
+  houp data version
+
+Maintains the ISO 3166-1 country and ISO 4217 currency tables in
+pkg/isodata. "update" downloads the CSV sources and regenerates
+countries.go and currencies.go; "version" prints the data version stamps
+compiled into this binary.
+`)
+}
+
+// runDataUpdate implements "houp data update"
+func runDataUpdate(args []string) int {
+	fs := flag.NewFlagSet("data update", flag.ExitOnError)
+	dir := fs.String("dir", filepath.Join("pkg", "isodata"), "Directory of the isodata package")
+	countries := fs.String("countries", isodata.CountriesSource, "ISO 3166-1 CSV source (URL or file path)")
+	currencies := fs.String("currencies", isodata.CurrenciesSource, "ISO 4217 CSV source (URL or file path)")
+	dryRun := fs.Bool("dry-run", false, "Print version stamps without writing files")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage:
+  houp data update [options]
+
+Regenerates pkg/isodata/countries.go and currencies.go from CSV sources.
+Run it from the root of the houp repository.
+
+Options:
+  --dir string
+        Directory of the isodata package (default "pkg/isodata")
+
+  --countries string
+        ISO 3166-1 CSV source, URL or file path
+        (default %q)
+
+  --currencies string
+        ISO 4217 CSV source, URL or file path
+        (default %q)
+
+  --dry-run
+        Print version stamps without writing files (default false)
+`, isodata.CountriesSource, isodata.CurrenciesSource)
+	}
+	fs.Parse(args)
+
+	countryList, err := loadCSV(*countries, isodata.ParseCountriesCSV)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	currencyList, err := loadCSV(*currencies, isodata.ParseCurrenciesCSV)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	countrySrc, err := isodata.RenderCountries(*countries, countryList)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	currencySrc, err := isodata.RenderCurrencies(*currencies, currencyList)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("countries  %s -> %s (%d entries)\n", isodata.CountriesVersion, isodata.CountriesVersionOf(countryList), len(countryList))
+	fmt.Printf("currencies %s -> %s (%d entries)\n", isodata.CurrenciesVersion, isodata.CurrenciesVersionOf(currencyList), len(currencyList))
+	if *dryRun {
+		return 0
+	}
+
+	files := []struct {
+		name string
+		src  []byte
+	}{
+		{"countries.go", countrySrc},
+		{"currencies.go", currencySrc},
+	}
+	for _, f := range files {
+		path := filepath.Join(*dir, f.name)
+		if err := os.WriteFile(path, f.src, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", path, err)
+			return 1
+		}
+		fmt.Printf("Wrote %s\n", path)
+	}
+	return 0
+}
+
+// loadCSV opens source, downloading it when it is an http(s) URL, and parses it
+func loadCSV[T any](source string, parse func(io.Reader) (T, error)) (T, error) {
+	var zero T
+	var r io.ReadCloser
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Get(source)
+		if err != nil {
+			return zero, fmt.Errorf("fetching %s: %w", source, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return zero, fmt.Errorf("fetching %s: %s", source, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return zero, err
+		}
+		r = f
+	}
+	defer r.Close()
+
+	v, err := parse(r)
+	if err != nil {
+		return zero, fmt.Errorf("%s: %w", source, err)
+	}
+	return v, nil
+}
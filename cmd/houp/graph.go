@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/n10ty/houp/pkg/generator"
+)
+
+// runGraph implements the "houp graph" subcommand
+func runGraph(args []string) int {
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	jsonOut := fs.Bool("json", false, "Print the graph as JSON instead of DOT")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage:
+  houp graph [options] <package-pattern> [package-pattern...]
+
+Prints the dive dependency graph of the packages: an edge for each field whose
+dive or jsonof rule runs the validation of another struct, including structs
+of other packages. Validation gaps are drawn in red: a dive into a struct
+without validation, or a field holding a validated struct without dive.
+
+Options:
+  --json
+        Print the graph as JSON instead of DOT (default false)
+
+Examples:
+  houp graph ./... | dot -Tsvg > validation.svg
+  houp graph --json ./api | jq '.edges[] | select(.gap)'
+`)
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no package path specified\n\n")
+		fs.Usage()
+		return 1
+	}
+
+	dirs, err := generator.ResolvePackageDirs(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var pkgInfos []*generator.PackageInfo
+	for _, dir := range dirs {
+		pkgInfo, err := generator.ParsePackage(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing %s: %v\n", dir, err)
+			return 1
+		}
+		pkgInfos = append(pkgInfos, pkgInfo)
+	}
+
+	graph := generator.BuildGraph(pkgInfos)
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(graph); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	writeDOT(os.Stdout, graph)
+	return 0
+}
+
+// writeDOT writes the graph in Graphviz DOT format. Nodes are labeled pkg.Type,
+// structs of other packages are dashed and gaps are red.
+func writeDOT(w io.Writer, graph *generator.Graph) {
+	fmt.Fprintln(w, "digraph houp {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+	fmt.Fprintln(w, "\tnode [shape=box];")
+	for _, n := range graph.Nodes {
+		attrs := fmt.Sprintf("label=%q", path.Base(n.Package)+"."+n.Name)
+		if n.External {
+			attrs += ", style=dashed"
+		}
+		if !n.Validated {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(w, "\t%q [%s];\n", n.ID, attrs)
+	}
+	for _, e := range graph.Edges {
+		label := e.Field
+		if e.Kind != generator.EdgeDive {
+			label += " (" + e.Kind + ")"
+		}
+		attrs := fmt.Sprintf("label=%q", label)
+		if e.Gap != "" {
+			attrs += fmt.Sprintf(", color=red, fontcolor=red, tooltip=%q", e.Gap)
+		}
+		if e.Kind == generator.EdgeNested {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(w, "\t%q -> %q [%s];\n", e.From, e.To, attrs)
+	}
+	fmt.Fprintln(w, "}")
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/n10ty/houp/pkg/generator"
 )
@@ -11,16 +12,53 @@ import (
 const version = "0.1.0"
 
 func main() {
+	// Dispatch subcommands before parsing generator flags
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "stats":
+			os.Exit(runStats(os.Args[2:]))
+		case "data":
+			os.Exit(runData(os.Args[2:]))
+		case "model":
+			os.Exit(runModel(os.Args[2:]))
+		case "graph":
+			os.Exit(runGraph(os.Args[2:]))
+		case "version":
+			os.Exit(runVersion(os.Args[2:]))
+		}
+	}
+
 	// Define flags
 	var (
 		suffix         = flag.String("suffix", "_validation.gen", "Suffix for the generated validation file (generates validation.gen.go)")
 		overwrite      = flag.Bool("overwrite", true, "Overwrite existing generated files")
 		dryRun         = flag.Bool("dry-run", false, "Show what would be generated without writing files")
 		unknownTagMode = flag.String("unknown-tags", "fail", "How to handle unknown validation tags: 'fail' or 'skip'")
-		multiError     = flag.Bool("multi-error", false, "Collect all validation errors (not yet implemented)")
+		crossPackage   = flag.String("cross-package", "skip", "How to handle dives into types of other packages without Validate(): 'skip' or 'strict'")
+		configPath     = flag.String("config", "", "Path to a JSON config file with message templates and header lines")
+		i18n           = flag.String("i18n", "", "Comma-separated locales to write message catalogs for, source language first, e.g. 'en,de'")
+		receiverMode   = flag.String("receiver", "pointer", "Receiver of generated methods: 'pointer' or 'value'")
+		methodName     = flag.String("method-name", "Validate", "Name of the generated validation method, e.g. ValidateInput next to a handwritten Validate")
+		receiverName   = flag.String("receiver-name", "", "Receiver variable of generated methods (default: derived from the struct name)")
+		subpackage     = flag.String("subpackage", "", "Generate Validate<Type> functions into a package of this name in a subdirectory instead of methods")
+		runtimePkg     = flag.String("runtime", "", "Import ISO tables, pattern regexps and check helpers from this package instead of generating them, e.g. github.com/n10ty/houp/runtime")
+		errorMode      = flag.String("errors", "fmt", "Errors returned by Validate(): 'fmt' or 'structured' (*houp.FieldError)")
+		multiError     = flag.Bool("multi-error", false, "Return every validation failure joined with errors.Join instead of the first")
+		contextMode    = flag.Bool("context", false, "Give every struct a ValidateContext(ctx) method that passes ctx to validators")
+		maxErrors      = flag.Int("max-errors", 0, "With multi-error, return once this many failures are collected (0: no limit)")
+		buildTags      = flag.String("tags", "", "Comma-separated build tags to load packages with, as with go build -tags")
+		includeTests   = flag.Bool("include-tests", false, "Also generate for structs in _test.go files (writes validation.gen_test.go)")
+		keepGoing      = flag.Bool("keep-going", false, "Generate every struct possible and report all failures at the end")
+		validateFields = flag.Bool("validate-fields", false, "Also generate ValidateFields(fields ...string) methods that check only the listed fields")
+		validateExcept = flag.Bool("validate-except", false, "Also generate ValidateExcept(fields ...string) methods that skip the listed fields")
+		validMethod    = flag.Bool("valid", false, "Also generate Valid() bool methods that report whether Validate() passes")
+		mustValidate   = flag.Bool("must-validate", false, "Also generate MustValidate() methods that panic when Validate() fails")
+		constructors   = flag.Bool("constructors", false, "Also generate New<Struct> constructors that validate the new value")
 		showVersion    = flag.Bool("version", false, "Show version information")
 		help           = flag.Bool("help", false, "Show help message")
+		headerLines    stringList
 	)
+	flag.Var(&headerLines, "header", "Line to add to the header of generated files, e.g. a copyright notice; may be repeated")
 
 	flag.Usage = usage
 	flag.Parse()
@@ -41,6 +79,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *crossPackage != "skip" && *crossPackage != "strict" {
+		fmt.Fprintf(os.Stderr, "Error: --cross-package must be 'skip' or 'strict', got: %s\n", *crossPackage)
+		os.Exit(1)
+	}
+
+	if *receiverMode != "pointer" && *receiverMode != "value" {
+		fmt.Fprintf(os.Stderr, "Error: --receiver must be 'pointer' or 'value', got: %s\n", *receiverMode)
+		os.Exit(1)
+	}
+
+	if *errorMode != "fmt" && *errorMode != "structured" {
+		fmt.Fprintf(os.Stderr, "Error: --errors must be 'fmt' or 'structured', got: %s\n", *errorMode)
+		os.Exit(1)
+	}
+
+	if *maxErrors < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --max-errors must not be negative, got: %d\n", *maxErrors)
+		os.Exit(1)
+	}
+
 	// Get package paths from args
 	args := flag.Args()
 	if len(args) == 0 {
@@ -49,25 +107,88 @@ func main() {
 		os.Exit(1)
 	}
 
+	var locales []string
+	if *i18n != "" {
+		for _, locale := range strings.Split(*i18n, ",") {
+			if !validLocale(locale) {
+				fmt.Fprintf(os.Stderr, "Error: --i18n has an invalid locale: %q\n", locale)
+				os.Exit(1)
+			}
+			locales = append(locales, locale)
+		}
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(*buildTags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	var messageTemplates map[string]string
+	var header []string
+	if *configPath != "" {
+		cfg, err := generator.LoadConfig(*configPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		messageTemplates = cfg.Messages
+		header = cfg.Header
+	}
+	header = append(header, headerLines...)
+
 	// Create options
 	opts := &generator.GenerateOptions{
-		Suffix:         *suffix,
-		Overwrite:      *overwrite,
-		DryRun:         *dryRun,
-		UnknownTagMode: *unknownTagMode,
-		MultiError:     *multiError,
+		Suffix:           *suffix,
+		Overwrite:        *overwrite,
+		DryRun:           *dryRun,
+		UnknownTagMode:   *unknownTagMode,
+		CrossPackageMode: *crossPackage,
+		ErrorMode:        *errorMode,
+		ReceiverMode:     *receiverMode,
+		ReceiverName:     *receiverName,
+		MethodName:       *methodName,
+		Subpackage:       *subpackage,
+		Runtime:          *runtimePkg,
+		MessageTemplates: messageTemplates,
+		Locales:          locales,
+		MultiError:       *multiError,
+		MaxErrors:        *maxErrors,
+		Context:          *contextMode,
+		IncludeTests:     *includeTests,
+		BuildTags:        tags,
+		KeepGoing:        *keepGoing,
+		ValidateFields:   *validateFields,
+		ValidateExcept:   *validateExcept,
+		ValidMethod:      *validMethod,
+		MustValidate:     *mustValidate,
+		Constructors:     *constructors,
+		Version:          "v" + version,
+		Command:          commandLine(os.Args),
+		Header:           header,
 	}
 
-	// Run generator for each package path
-	hasErrors := false
+	// Run generator for each package path. With --keep-going the failures are
+	// reported together once every package is done.
+	var failures []string
 	for _, pkgPath := range args {
 		if err := generator.Generate(pkgPath, opts); err != nil {
-			fmt.Fprintf(os.Stderr, "Error generating validation for %s: %v\n", pkgPath, err)
-			hasErrors = true
+			msg := fmt.Sprintf("Error generating validation for %s: %v", pkgPath, err)
+			if !*keepGoing {
+				fmt.Fprintln(os.Stderr, msg)
+			}
+			failures = append(failures, msg)
 		}
 	}
 
-	if hasErrors {
+	if len(failures) > 0 {
+		if *keepGoing {
+			fmt.Fprintf(os.Stderr, "\n%d of %d packages had failures:\n", len(failures), len(args))
+			for _, msg := range failures {
+				fmt.Fprintln(os.Stderr, msg)
+			}
+		}
 		os.Exit(1)
 	}
 }
@@ -77,6 +198,23 @@ func usage() {
 
 Usage:
   houp [options] <package-path> [package-path...]
+  houp stats [options] <package-pattern> [package-pattern...]
+  houp model [options] <package-pattern> [package-pattern...]
+  houp graph [options] <package-pattern> [package-pattern...]
+  houp data <update|version>
+  houp version [--json]
+
+Commands:
+  stats                 Summarize rule usage, largest structs, rule
+                        combinations and unused custom validators
+  model                 Print the parsed structs, fields and rules as JSON
+  graph                 Print which structs dive into which as DOT or JSON,
+                        marking fields where nested validation does not run
+  data update           Regenerate the ISO 3166-1/ISO 4217 tables in
+                        pkg/isodata from their CSV sources
+  data version          Print the ISO data version stamps
+  version               Print the houp version; --json adds the commit, Go
+                        version, schema version and supported rules
 
 Options:
   --suffix string
@@ -94,9 +232,119 @@ Options:
         Values: "fail" - exit with error
                 "skip" - log warning and continue
 
+  --cross-package string
+        How to handle a dive into a type of another package that has no
+        Validate() method and no validate tags (default "skip")
+        Values: "skip"   - leave the type unvalidated
+                "strict" - exit with error
+
+  --config string
+        Path to a JSON config file. "messages" replaces the built-in error
+        message of a rule with a template, where the first %%s is the field
+        and the second the rule parameter:
+          {"messages": {"min": "%%s must contain at least %%s characters"}}
+        "header" lists lines to add to the header of generated files
+
+  --header string
+        Line to add below the "Code generated" header of every generated
+        file, e.g. a copyright notice; repeat the flag for several lines.
+        Comes after the lines of "header" in the config file:
+          {"header": ["Copyright 2024 Example Corp."]}
+
+  --i18n string
+        Comma-separated locales, source language first (e.g. "en,de").
+        Field errors get message keys and are translated at runtime with
+        houp.SetCatalog or houp.Localize; validation.messages.<locale>.json
+        is written per locale, keeping the translations already made
+
+  --receiver string
+        Receiver of generated methods (default "pointer")
+        Values: "pointer" - func (u *User) Validate() error
+                "value"   - func (u User) Validate() error, for types passed
+                            by value; struct validators still get a pointer
+
+  --method-name string
+        Name of the generated validation method (default "Validate"), e.g.
+        ValidateInput to keep handwritten Validate methods during a
+        migration. Dives into structs of the package call it; a struct can
+        set its own with a //validate:method=Name comment
+
+  --receiver-name string
+        Receiver variable of generated methods, e.g. "v". Structs with
+        methods of their own keep their receiver name, and a struct can set
+        one with a //validate:receiver=name comment (default: the first
+        letter of the struct name, longer when generated code uses it)
+
+  --subpackage string
+        Generate free functions, e.g. ValidateUser(u *models.User) error,
+        into a package of this name in a subdirectory, such as
+        models/validation, instead of methods on the types. Validated
+        structs and their struct validators must be exported
+
+  --runtime string
+        Import path of a helper package, e.g. github.com/n10ty/houp/runtime,
+        whose ISO code tables, pattern regexps and check functions (iban,
+        isbn, cron, ...) generated code uses instead of declaring its own.
+        Shrinks generated files; the package must match the houp version
+
+  --errors string
+        Errors returned by generated Validate() methods (default "fmt")
+        Values: "fmt"        - fmt.Errorf messages
+                "structured" - *houp.FieldError values with the struct, field,
+                               rule, parameter and value; same messages
+
   --multi-error
-        Collect all validation errors instead of returning on first error
-        (not yet fully implemented) (default false)
+        Check every field and return all failures joined with errors.Join
+        instead of the first one; single structs can opt in with a
+        //validate:multierror comment (default false)
+
+  --context
+        Give every struct a ValidateContext(ctx context.Context) error
+        method that passes ctx to validators taking a context and to nested
+        structs; Validate() calls it with context.Background() (default false)
+
+  --max-errors int
+        With multi-error, return once this many failures are collected, so
+        very wide structs do not build large errors (default 0, no limit)
+
+  --tags string
+        Comma-separated build tags to load packages with, as with go build
+        -tags; GOOS and GOARCH are taken from the environment. Structs of
+        files with other build constraints are still generated into
+        validation.<constraint>.gen.go files carrying the same //go:build line
+
+  --include-tests
+        Also generate Validate() methods for structs declared in in-package
+        _test.go files, written to validation.gen_test.go (default false)
+
+  --keep-going
+        Keep generating after a struct fails: every other struct is generated,
+        failed structs get a Validate() that returns an error, and all
+        failures are reported at the end with a non-zero exit (default false)
+
+  --validate-fields
+        Also generate a ValidateFields(fields ...string) error method per
+        struct that checks only the listed fields, named as in Go, e.g. for
+        PATCH requests; struct validators are not run (default false)
+
+  --validate-except
+        Also generate a ValidateExcept(fields ...string) error method per
+        struct that checks every field but the listed ones, e.g. IDs filled in
+        by the server; struct validators are not run (default false)
+
+  --valid
+        Also generate a Valid() bool method per struct that reports whether
+        Validate() returns nil, unless the struct has its own Valid (default false)
+
+  --must-validate
+        Also generate a MustValidate() method per struct that panics with the
+        error of Validate(), for test fixtures and startup config checks
+        (default false)
+
+  --constructors
+        Also generate a New<Struct>(fields...) (*Struct, error) constructor
+        per struct that sets every field and returns the struct only if it
+        passes Validate() (default false)
 
   --version
         Show version information
@@ -108,6 +356,9 @@ Examples:
   # Show version
   houp --version
 
+  # Print build info and the rule inventory for CI checks
+  houp version --json
+
   # Generate validation for a package (creates validation.gen.go)
   houp ./models
 
@@ -120,32 +371,144 @@ Examples:
   # Skip unknown validation tags instead of failing
   houp --unknown-tags=skip ./models
 
+  # Fail when a dive cannot validate a type of another package
+  houp --cross-package=strict ./api
+
+  # Use the team's wording for error messages
+  houp --config=houp.json ./api
+
+  # Add the company copyright notice to generated files
+  houp --header="Copyright 2024 Example Corp. All rights reserved." ./api
+
+  # Write message catalogs to translate errors into German
+  houp --i18n=en,de ./api
+
+  # Return *houp.FieldError values callers can inspect with errors.As
+  houp --errors=structured ./api
+
   # Use custom suffix for generated file
   houp --suffix=_validate ./models
 
   # Generate for multiple packages with options
   houp --dry-run --unknown-tags=skip ./models ./api
 
+  # Give every struct ValidateContext(ctx) for request-scoped validators
+  houp --context ./api
+
+  # Generate value receivers for types passed by value
+  houp --receiver=value ./events
+
+  # Generate ValidateInput() next to handwritten Validate() methods
+  houp --method-name=ValidateInput ./models
+
+  # Name every receiver "self" instead of the struct's first letter
+  houp --receiver-name=self ./models
+
+  # Keep generated code out of the domain package: models/validation
+  houp --subpackage=validation ./models
+
+  # Share ISO tables and helpers instead of generating them in every package
+  houp --runtime=github.com/n10ty/houp/runtime ./models
+
+  # Adopt houp in a legacy package: generate what works, list what does not
+  houp --keep-going ./legacy
+
+  # Also generate ValidateFields("Name", "Email") for partial updates
+  houp --validate-fields ./api
+
+  # Also generate ValidateExcept("ID", "CreatedAt") for inbound data
+  houp --validate-except ./api
+
+  # Also generate Valid() bool for call sites that only need a boolean
+  houp --valid ./models
+
+  # Also generate MustValidate() for test fixtures and startup checks
+  houp --must-validate ./config
+
+  # Generate validating constructors such as NewUser(...) (*User, error)
+  houp --constructors ./models
+
+  # Resolve types as an enterprise build for Windows does
+  GOOS=windows houp --tags=enterprise ./license
+
+  # Include fixtures and request builders declared in _test.go files
+  houp --include-tests ./api
+
+  # Show rule usage across the module
+  houp stats ./...
+
+  # Export the parsed validation model for other tools
+  houp model ./models
+
+  # Render the dive graph of the module
+  houp graph ./... | dot -Tsvg > validation.svg
+
 Output:
   Generates a single validation.gen.go file per package containing all
   Validate() methods for structs with validation tags. This consolidates
-  all validation code in one place, avoiding multiple files.
+  all validation code in one place, avoiding multiple files. The header of
+  the file records the houp version, its tag set and the command line.
 
 Supported Validation Tags:
   required              Field must not be zero value
   omitempty             Skip validation if field is empty
-  min=N                 Minimum value/length (numbers, strings, slices)
-  max=N                 Maximum value/length (numbers, strings, slices)
+  min=N                 Minimum value/length (numbers, strings, slices, maps)
+  max=N                 Maximum value/length (numbers, strings, slices, maps)
+  len=N                 Exact length (strings, slices, maps)
+  trim                  Option: min/max/len measure strings.TrimSpace of the value
+  runes                 Option: min/max/len count UTF-8 runes instead of bytes
   gt=N                  Greater than (numbers only)
   lt=N                  Less than (numbers only)
   gte=N                 Greater than or equal (numbers only)
   lte=N                 Less than or equal (numbers only)
+  gt=now ... lte=now    Compare a time.Time with time.Now()
+  future, past          time.Time after / before time.Now()
+  numeric               String holding a number; gt/gte/lt/lte then compare its value
+  finite                Not NaN or ±Inf (floats only)
   regexp=pkg:Var        Match against imported regexp variable
   unique                Values must be unique (slices of scalars)
-  unique=Field          Field values must be unique (slices of structs, field must be string)
-  dive                  Recursively validate nested structs
+  unique=Field          Field values must be unique (slices of structs)
+  unique=A+B            Combination of field values must be unique (slices of structs)
+  dive                  Recursively validate nested structs, slice elements
+                        and map values
+  keys ... endkeys      After dive: rules for map keys (dive,keys,uuid,endkeys)
+  isbn, isbn10, isbn13  Valid ISBN including check digit
+  gtfield=Field ... ltefield=Field
+                        Compare with another field (numbers, time.Time)
+  postcode_iso3166_alpha2=Field
+                        Postal code valid for the alpha-2 country in Field
+  datetime=layout       Go time layout, | between alternatives; lang=de|es|fr|it|nl|pt
+                        accepts localized month and weekday names
+  cron                  Cron expression (5 or 6 fields, or @daily-style descriptor)
+  boolean               String accepted by strconv.ParseBool
+  duration              Valid time.ParseDuration string (e.g. 30s, 5m)
+  unixts[=ms]           Unix timestamp (seconds or milliseconds) in range;
+                        from=YYYY-MM-DD,to=YYYY-MM-DD options
+  iban                  Valid IBAN including mod-97 checksum
+  bic                   Valid BIC / SWIFT code
+  mongodb               Valid MongoDB ObjectID (24 hex characters)
+  md5, sha1, sha256, sha512
+                        Hex digest of the algorithm's length
+  printable             Only printable characters (rejects control characters)
+  no_control_chars      No control characters such as newlines or escapes
+  base64                Standard base64 (strings, []byte)
+  datauri               Base64 data URI (data:image/png;base64,...)
+  oneof=a b c           One of the space-separated values (strings, integers)
+  subsetof=a b c        Every slice element is one of the values
+  json                  Well-formed JSON (strings, []byte, json.RawMessage)
+  jsonof=pkg/path:Type  []byte / json.RawMessage holding a valid JSON Type
+  latitude, longitude   Coordinate within -90..90 / -180..180 (floats, numeric strings)
   pkg/path:FuncName     Custom validator function
 
+Struct Comments:
+  //validate:pkg/path:FuncName
+                        Struct-level custom validator
+  //validate:pagination=Page,PerPage[,maxPerPage=N]
+                        Page >= 1, page size in range, offset fits in an int
+  //validate:freeze      Also generate ValidateAndFreeze, which validates and
+                        returns a deep copy
+  //validate:skip        Do not generate Validate for the struct
+
 Tag Examples:
   validate:"required"
   validate:"required,min=3,max=50"
@@ -159,3 +522,43 @@ Tag Examples:
 For more information, visit: https://github.com/n10ty/houp
 `)
 }
+
+// validLocale reports whether locale is usable in a file name, e.g. "en" or "pt-BR"
+func validLocale(locale string) bool {
+	if locale == "" {
+		return false
+	}
+	for _, r := range locale {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// stringList is a flag that collects every value it is given
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, "\n")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// commandLine returns args as a shell command, with the program as "houp" and
+// arguments quoted where a shell would split or expand them
+func commandLine(args []string) string {
+	words := []string{"houp"}
+	for _, arg := range args[1:] {
+		if arg == "" || strings.ContainsFunc(arg, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./=,:@%+", r))
+		}) {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		words = append(words, arg)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/n10ty/houp/pkg/generator"
+	"github.com/n10ty/houp/pkg/model"
+)
+
+// runModel implements the "houp model" subcommand
+func runModel(args []string) int {
+	fs := flag.NewFlagSet("model", flag.ExitOnError)
+	includeTests := fs.Bool("include-tests", false, "Also include structs declared in _test.go files")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage:
+  houp model [options] <package-pattern> [package-pattern...]
+
+Prints the parsed validation model of each package as a JSON array: its
+structs, their fields and the rules of each field. The layout is described
+by the github.com/n10ty/houp/pkg/model package.
+
+Options:
+  --include-tests
+        Also include structs declared in _test.go files (default false)
+
+Examples:
+  houp model ./models
+  houp model ./... | jq '.[].structs[].name'
+`)
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no package path specified\n\n")
+		fs.Usage()
+		return 1
+	}
+
+	dirs, err := generator.ResolvePackageDirs(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	pkgs := []*model.Package{}
+	for _, dir := range dirs {
+		parse := generator.ParsePackage
+		if *includeTests {
+			parse = generator.ParsePackageWithTests
+		}
+		pkgInfo, err := parse(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing %s: %v\n", dir, err)
+			return 1
+		}
+		pkgs = append(pkgs, generator.Model(pkgInfo))
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(pkgs); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
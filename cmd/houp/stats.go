@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/n10ty/houp/pkg/generator"
+)
+
+// runStats implements the "houp stats" subcommand
+func runStats(args []string) int {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	jsonOut := fs.Bool("json", false, "Print the report as JSON")
+	top := fs.Int("top", 10, "Number of entries to show per section")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage:
+  houp stats [options] <package-pattern> [package-pattern...]
+
+Summarizes validation rule usage: how often each rule is used, the largest
+structs, common rule combinations and custom validators no tag references.
+
+Options:
+  --json
+        Print the report as JSON (default false)
+
+  --top int
+        Number of entries to show per section (default 10)
+
+Examples:
+  houp stats ./...
+  houp stats --json ./models ./api
+`)
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no package path specified\n\n")
+		fs.Usage()
+		return 1
+	}
+
+	dirs, err := generator.ResolvePackageDirs(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var pkgInfos []*generator.PackageInfo
+	for _, dir := range dirs {
+		pkgInfo, err := generator.ParsePackage(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing %s: %v\n", dir, err)
+			return 1
+		}
+		pkgInfos = append(pkgInfos, pkgInfo)
+	}
+
+	report, err := generator.CollectStats(pkgInfos)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	printStats(report, *top)
+	return 0
+}
+
+// printStats writes a human-readable stats report to stdout
+func printStats(report *generator.StatsReport, top int) {
+	fmt.Printf("Packages: %d  Structs: %d  Validated fields: %d\n", report.Packages, report.Structs, report.Fields)
+
+	fmt.Println("\nRule usage:")
+	for _, rc := range limit(generator.SortedRuleCounts(report.RuleCounts), top) {
+		fmt.Printf("  %-24s %d\n", rc.Name, rc.Count)
+	}
+
+	fmt.Println("\nLargest structs:")
+	for i, s := range report.LargestStructs {
+		if i >= top {
+			break
+		}
+		fmt.Printf("  %-40s %d fields, %d rules\n", s.Package+"."+s.Name, s.Fields, s.Rules)
+	}
+
+	fmt.Println("\nRule combinations:")
+	for _, rc := range limit(generator.SortedRuleCounts(report.Combinations), top) {
+		fmt.Printf("  %-40s %d\n", rc.Name, rc.Count)
+	}
+
+	fmt.Println("\nUnused custom validators:")
+	if len(report.UnusedValidators) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, name := range report.UnusedValidators {
+		fmt.Printf("  %s\n", name)
+	}
+}
+
+// limit truncates a rule count list to at most n entries
+func limit(counts []generator.RuleCount, n int) []generator.RuleCount {
+	if n >= 0 && len(counts) > n {
+		return counts[:n]
+	}
+	return counts
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/n10ty/houp/pkg/generator"
+	"github.com/n10ty/houp/pkg/isodata"
+	"github.com/n10ty/houp/pkg/model"
+)
+
+// versionInfo is the output of "houp version --json"
+type versionInfo struct {
+	Version       string            `json:"version"`
+	ModuleVersion string            `json:"module_version,omitempty"` // version of the main module as built, e.g. v0.1.0 or (devel)
+	Commit        string            `json:"commit,omitempty"`
+	CommitTime    string            `json:"commit_time,omitempty"`
+	Modified      bool              `json:"modified,omitempty"` // built from a tree with uncommitted changes
+	GoVersion     string            `json:"go_version"`
+	SchemaVersion int               `json:"schema_version"` // version of the "houp model" JSON layout
+	TagSet        string            `json:"tag_set"`        // generator.TagSetVersion, as in generated file headers
+	Data          map[string]string `json:"data"`           // ISO data version stamps
+	Rules         []string          `json:"rules"`
+	Options       []string          `json:"options"`
+}
+
+// buildVersionInfo collects the version, the build info embedded by the Go
+// toolchain and the rule inventory
+func buildVersionInfo() versionInfo {
+	info := versionInfo{
+		Version:       version,
+		SchemaVersion: model.Version,
+		TagSet:        generator.TagSetVersion(),
+		Data: map[string]string{
+			"countries":  isodata.CountriesVersion,
+			"currencies": isodata.CurrenciesVersion,
+		},
+		Rules:   generator.SupportedRules(),
+		Options: generator.SupportedOptions(),
+	}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	info.ModuleVersion = bi.Main.Version
+	info.GoVersion = bi.GoVersion
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			info.Commit = s.Value
+		case "vcs.time":
+			info.CommitTime = s.Value
+		case "vcs.modified":
+			info.Modified = s.Value == "true"
+		}
+	}
+	return info
+}
+
+// runVersion implements the "houp version" subcommand
+func runVersion(args []string) int {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Print build info and the rule inventory as JSON")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage:
+  houp version [options]
+
+Prints the houp version and the tag set generated file headers record. With
+--json it also prints the commit it was built from, the Go version, the
+"houp model" schema version, the ISO data version stamps and the supported
+rules and options, so that CI can check tool and rule compatibility before
+regenerating.
+
+Options:
+  --json
+        Print build info and the rule inventory as JSON (default false)
+
+Examples:
+  houp version
+  houp version --json | jq -e '.rules | index("unique")'
+`)
+	}
+	fs.Parse(args)
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n\n", fs.Arg(0))
+		fs.Usage()
+		return 1
+	}
+
+	info := buildVersionInfo()
+	if !*asJSON {
+		fmt.Printf("houp version %s\n", info.Version)
+		fmt.Printf("tag set %s\n", info.TagSet)
+		if info.Commit != "" {
+			fmt.Printf("commit %s\n", info.Commit)
+		}
+		return 0
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(info); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
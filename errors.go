@@ -0,0 +1,71 @@
+// Package houp holds the types that code generated by houp can return at runtime.
+// Generated code only imports it when built with --errors=structured or --i18n.
+package houp
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// FieldError is returned by generated Validate methods in structured error mode
+// when a field fails a rule. Callers can use errors.As to branch on the rule that
+// failed instead of matching message text, or marshal it into a response body.
+type FieldError struct {
+	Struct   string // name of the validated struct
+	Field    string // Go name of the field
+	JSONName string // name of the field in its json tag, or the Go name without one
+	Rule     string // rule that failed, e.g. "min" or "email"
+	Param    string // rule parameter, e.g. "3" for min=3; empty for rules without one
+	Value    any    // value of the field, or of the element for rules after dive
+	Err      error  // the message, wrapping the nested error for dives into structs
+}
+
+// Error returns the same message generated code returns without structured errors
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error, so errors.Is and errors.As can reach the
+// errors of nested structs
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the sentinel error of the rule that failed, see
+// RuleError
+func (e *FieldError) Is(target error) bool {
+	sentinel := RuleError(e.Rule)
+	return sentinel != nil && sentinel == target
+}
+
+// MarshalJSON encodes the error as {"field":...,"rule":...,"message":...}, naming
+// the field as it appears in JSON, so handlers can write it to a response as is
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	field := e.JSONName
+	if field == "" {
+		field = e.Field
+	}
+	return json.Marshal(struct {
+		Field   string `json:"field"`
+		Rule    string `json:"rule"`
+		Message string `json:"message"`
+	}{field, e.Rule, e.Error()})
+}
+
+// FieldErrors returns the field errors in err, which may be a single
+// *FieldError or several joined with errors.Join by --multi-error. The result
+// marshals to a JSON array; errors that are not field errors are left out.
+func FieldErrors(err error) []*FieldError {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var fes []*FieldError
+		for _, e := range joined.Unwrap() {
+			fes = append(fes, FieldErrors(e)...)
+		}
+		return fes
+	}
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return []*FieldError{fe}
+	}
+	return nil
+}
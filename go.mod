@@ -4,10 +4,8 @@ go 1.24.7
 
 require (
 	github.com/google/go-cmp v0.7.0
+	golang.org/x/mod v0.31.0
 	golang.org/x/tools v0.40.0
 )
 
-require (
-	golang.org/x/mod v0.31.0 // indirect
-	golang.org/x/sync v0.19.0 // indirect
-)
+require golang.org/x/sync v0.19.0 // indirect
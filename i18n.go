@@ -0,0 +1,102 @@
+package houp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Catalog maps the message keys of generated code to fmt formats in one language.
+// The formats take the same arguments, in the same order, as the built-in message
+// of the key, e.g. the index of a dive element.
+type Catalog map[string]string
+
+// ParseCatalog parses a translations file written by houp --i18n, a JSON object of
+// message keys and formats
+func ParseCatalog(data []byte) (Catalog, error) {
+	var c Catalog
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse catalog: %w", err)
+	}
+	return c, nil
+}
+
+var current atomic.Pointer[Catalog]
+
+// SetCatalog sets the catalog Errorf translates messages with. A nil catalog
+// restores the built-in messages.
+func SetCatalog(c Catalog) {
+	if c == nil {
+		current.Store(nil)
+		return
+	}
+	current.Store(&c)
+}
+
+// MessageError is an error built by Errorf. It keeps the key and arguments of the
+// message, so Localize can translate it again into another language.
+type MessageError struct {
+	Key    string // message key, e.g. "Signup.Username.min"
+	Format string // built-in format of the message
+	Args   []any  // arguments of the format
+	err    error
+}
+
+// Error returns the message in the language of the catalog set when it was created
+func (e *MessageError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error wrapped with %w, if any
+func (e *MessageError) Unwrap() error {
+	return errors.Unwrap(e.err)
+}
+
+// Errorf replaces fmt.Errorf in code generated with --i18n. It formats the
+// translation of key in the catalog set with SetCatalog, or format when the catalog
+// has none.
+func Errorf(key, format string, args ...any) error {
+	return &MessageError{Key: key, Format: format, Args: args, err: fmt.Errorf(lookup(current.Load(), key, format), args...)}
+}
+
+// Localize returns the message of err translated with c, e.g. for the language of
+// one request. Field errors, errors joined by --multi-error and errors of nested
+// structs are translated too; other errors keep their message.
+func Localize(err error, c Catalog) string {
+	switch e := err.(type) {
+	case nil:
+		return ""
+	case *MessageError:
+		args := make([]any, len(e.Args))
+		for i, arg := range e.Args {
+			if argErr, ok := arg.(error); ok {
+				arg = errors.New(Localize(argErr, c))
+			}
+			args[i] = arg
+		}
+		return fmt.Errorf(lookup(&c, e.Key, e.Format), args...).Error()
+	case *FieldError:
+		return Localize(e.Err, c)
+	case interface{ Unwrap() []error }:
+		var msgs []string
+		for _, joined := range e.Unwrap() {
+			if joined != nil {
+				msgs = append(msgs, Localize(joined, c))
+			}
+		}
+		return strings.Join(msgs, "\n")
+	}
+	return err.Error()
+}
+
+// lookup returns the format of key in c, or format when c has none
+func lookup(c *Catalog, key, format string) string {
+	if c != nil {
+		if translated, ok := (*c)[key]; ok {
+			return translated
+		}
+	}
+	return format
+}
@@ -0,0 +1,114 @@
+// Package contracttest checks HTTP bodies recorded in tests against the Validate
+// methods generated by houp, so handler tests fail when a request or response
+// stops satisfying its struct's validation tags.
+package contracttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Validator is implemented by every struct houp generates a Validate method for
+type Validator interface {
+	Validate() error
+}
+
+// Stage identifies the step of a contract check that failed
+type Stage string
+
+const (
+	// StageRead means the body could not be read
+	StageRead Stage = "read"
+	// StageDecode means the body is not valid JSON for the target type
+	StageDecode Stage = "decode"
+	// StageValidate means the decoded value failed its Validate method
+	StageValidate Stage = "validate"
+)
+
+// ContractError describes a body that does not satisfy its contract.
+// Err is the underlying read, decode or validation error.
+type ContractError struct {
+	Source string // "request" or "response"
+	Type   string // Go type the body was decoded into
+	Stage  Stage
+	Body   string
+	Err    error
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("%s body does not satisfy %s (%s): %v", e.Source, e.Type, e.Stage, e.Err)
+}
+
+func (e *ContractError) Unwrap() error {
+	return e.Err
+}
+
+// Check decodes a JSON body into v and validates it. Any failure is returned as a *ContractError.
+func Check(source string, body io.Reader, v Validator) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return &ContractError{Source: source, Type: typeName(v), Stage: StageRead, Err: err}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return &ContractError{Source: source, Type: typeName(v), Stage: StageDecode, Body: string(data), Err: err}
+	}
+
+	if err := v.Validate(); err != nil {
+		return &ContractError{Source: source, Type: typeName(v), Stage: StageValidate, Body: string(data), Err: err}
+	}
+
+	return nil
+}
+
+// Response decodes the body recorded by rec into a new T and validates it,
+// failing the test on any contract violation. The recorder's body is left unread.
+func Response[T any, PT interface {
+	*T
+	Validator
+}](t testing.TB, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+
+	var v T
+	if err := Check("response", bytes.NewReader(rec.Body.Bytes()), PT(&v)); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// Request decodes the body of req into a new T and validates it, failing the test
+// on any contract violation. The body is restored so req can still be served.
+func Request[T any, PT interface {
+	*T
+	Validator
+}](t testing.TB, req *http.Request) T {
+	t.Helper()
+
+	var v T
+	if req.Body == nil {
+		t.Fatal(&ContractError{Source: "request", Type: typeName(PT(&v)), Stage: StageRead, Err: fmt.Errorf("request has no body")})
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		t.Fatal(&ContractError{Source: "request", Type: typeName(PT(&v)), Stage: StageRead, Err: err})
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err := Check("request", bytes.NewReader(data), PT(&v)); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// typeName returns the name of the type a validator points to
+func typeName(v Validator) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", v), "*")
+}
@@ -0,0 +1,156 @@
+package contracttest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/n10ty/houp/testdata/input/banking"
+)
+
+// fakeTB records Fatal calls instead of failing the surrounding test
+type fakeTB struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Fatal(args ...any) {
+	f.failed = true
+	f.msg = fmt.Sprint(args...)
+	runtime.Goexit()
+}
+
+// run calls fn on its own goroutine so Fatal can stop it like testing.T does
+func run(fn func(tb testing.TB)) *fakeTB {
+	tb := &fakeTB{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fn(tb)
+	}()
+	wg.Wait()
+	return tb
+}
+
+const validTransfer = `{"debtor_iban":"GB82WEST12345698765432","creditor_iban":"DE89370400440532013000","creditor_bic":"DEUTDEFF"}`
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantStage Stage
+	}{
+		{
+			name: "valid body",
+			body: validTransfer,
+		},
+		{
+			name:      "malformed JSON",
+			body:      `{"debtor_iban":`,
+			wantStage: StageDecode,
+		},
+		{
+			name:      "wrong JSON type",
+			body:      `{"debtor_iban":42}`,
+			wantStage: StageDecode,
+		},
+		{
+			name:      "fails validation",
+			body:      `{"debtor_iban":"GB81WEST12345698765432","creditor_iban":"DE89370400440532013000"}`,
+			wantStage: StageValidate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tr banking.Transfer
+			err := Check("response", strings.NewReader(tt.body), &tr)
+			if tt.wantStage == "" {
+				if err != nil {
+					t.Fatalf("Check() unexpected error: %v", err)
+				}
+				return
+			}
+
+			var contractErr *ContractError
+			if !errors.As(err, &contractErr) {
+				t.Fatalf("Check() error = %v, want *ContractError", err)
+			}
+			if contractErr.Stage != tt.wantStage {
+				t.Errorf("Stage = %q, want %q", contractErr.Stage, tt.wantStage)
+			}
+			if contractErr.Type != "banking.Transfer" {
+				t.Errorf("Type = %q, want %q", contractErr.Type, "banking.Transfer")
+			}
+			if contractErr.Body != tt.body {
+				t.Errorf("Body = %q, want %q", contractErr.Body, tt.body)
+			}
+		})
+	}
+}
+
+func TestResponse(t *testing.T) {
+	handler := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteString(body)
+		return rec
+	}
+
+	var got banking.Transfer
+	tb := run(func(tb testing.TB) {
+		got = Response[banking.Transfer](tb, handler(validTransfer))
+	})
+	if tb.failed {
+		t.Fatalf("Response() failed on a valid body: %s", tb.msg)
+	}
+	if got.CreditorBIC != "DEUTDEFF" {
+		t.Errorf("Response() decoded CreditorBIC = %q", got.CreditorBIC)
+	}
+
+	tb = run(func(tb testing.TB) {
+		Response[banking.Transfer](tb, handler(`{"debtor_iban":"GB82WEST12345698765432","creditor_iban":"DE89370400440532013000","creditor_bic":"deutdeff"}`))
+	})
+	if !tb.failed {
+		t.Fatal("Response() did not fail on an invalid BIC")
+	}
+	if !strings.Contains(tb.msg, "field CreditorBIC must be a valid BIC") {
+		t.Errorf("Response() message = %q, want the validation error", tb.msg)
+	}
+}
+
+func TestRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/transfers", strings.NewReader(validTransfer))
+
+	tb := run(func(tb testing.TB) {
+		Request[banking.Transfer](tb, req)
+	})
+	if tb.failed {
+		t.Fatalf("Request() failed on a valid body: %s", tb.msg)
+	}
+
+	// The body is restored for the handler under test
+	var tr banking.Transfer
+	if err := Check("request", req.Body, &tr); err != nil {
+		t.Errorf("request body was not restored: %v", err)
+	}
+
+	tb = run(func(tb testing.TB) {
+		Request[banking.Transfer](tb, httptest.NewRequest(http.MethodPost, "/transfers", strings.NewReader(`{}`)))
+	})
+	if !tb.failed {
+		t.Fatal("Request() did not fail on an empty transfer")
+	}
+	if !strings.Contains(tb.msg, "request body does not satisfy banking.Transfer (validate)") {
+		t.Errorf("Request() message = %q", tb.msg)
+	}
+}
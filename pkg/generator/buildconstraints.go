@@ -0,0 +1,341 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// knownOS and knownArch mirror the GOOS and GOARCH lists of go/build; file name
+// suffixes using them are implicit build constraints
+var knownOS = []string{
+	"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "js",
+	"linux", "nacl", "netbsd", "openbsd", "plan9", "solaris", "wasip1", "windows", "zos",
+}
+
+var knownArch = []string{
+	"386", "amd64", "amd64p32", "arm", "armbe", "arm64", "arm64be", "loong64", "mips",
+	"mipsle", "mips64", "mips64le", "mips64p32", "mips64p32le", "ppc", "ppc64", "ppc64le",
+	"riscv", "riscv64", "s390", "s390x", "sparc", "sparc64", "wasm",
+}
+
+// unixOS lists the GOOS values that satisfy the "unix" build tag
+var unixOS = []string{
+	"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios",
+	"linux", "netbsd", "openbsd", "solaris",
+}
+
+// preferredOS and preferredArch are tried first when picking a build
+// configuration that satisfies a constraint, starting with the target of the
+// GOOS and GOARCH environment variables
+var preferredOS = []string{build.Default.GOOS, "linux", "darwin", "windows", "freebsd"}
+var preferredArch = []string{build.Default.GOARCH, "amd64", "arm64", "386", "arm"}
+
+// buildEnv is a build configuration used to load the files of one constraint group
+type buildEnv struct {
+	GOOS   string   // empty: the host's
+	GOARCH string   // empty: the host's
+	Tags   []string // extra -tags
+}
+
+// hostEnv returns the host build configuration with the build tags of opts, or
+// nil without tags
+func hostEnv(opts *GenerateOptions) *buildEnv {
+	if len(opts.BuildTags) == 0 {
+		return nil
+	}
+	return &buildEnv{Tags: append([]string(nil), opts.BuildTags...)}
+}
+
+// checkBuildTags reports an error if a tag of opts.BuildTags is not a build tag,
+// which consists of letters, digits, underscores and dots
+func checkBuildTags(opts *GenerateOptions) error {
+	for _, tag := range opts.BuildTags {
+		valid := tag != ""
+		for _, r := range tag {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '.') {
+				valid = false
+			}
+		}
+		if !valid {
+			return fmt.Errorf("build tag %q is not valid", tag)
+		}
+	}
+	return nil
+}
+
+// fileConstraint returns the build constraint of a source file as a //go:build
+// expression: its //go:build line combined with the GOOS/GOARCH implied by its
+// name. It is empty for files that are built everywhere.
+func fileConstraint(filename string, file *ast.File) (string, error) {
+	var parts []string
+
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+		for _, c := range group.List {
+			if !constraint.IsGoBuild(c.Text) {
+				continue
+			}
+			expr, err := constraint.Parse(c.Text)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", filepath.Base(filename), err)
+			}
+			parts = append(parts, expr.String())
+		}
+	}
+
+	goos, goarch := fileNameOSArch(filepath.Base(filename))
+	if goos != "" {
+		parts = append(parts, goos)
+	}
+	if goarch != "" {
+		parts = append(parts, goarch)
+	}
+
+	switch len(parts) {
+	case 0:
+		return "", nil
+	case 1:
+		return parts[0], nil
+	}
+	for i, part := range parts {
+		if strings.ContainsAny(part, "|") {
+			parts[i] = "(" + part + ")"
+		}
+	}
+	return strings.Join(parts, " && "), nil
+}
+
+// fileNameOSArch returns the GOOS and GOARCH implied by a file name such as
+// name_linux.go, name_arm64.go or name_windows_amd64_test.go. Like go/build, it
+// only looks at the part before the first dot.
+func fileNameOSArch(name string) (goos, goarch string) {
+	name, _, _ = strings.Cut(name, ".")
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return "", ""
+	}
+	l := strings.Split(name[i:], "_")
+	if n := len(l); n > 0 && l[n-1] == "test" {
+		l = l[:n-1]
+	}
+	n := len(l)
+	if n >= 2 && containsString(knownOS, l[n-2]) && containsString(knownArch, l[n-1]) {
+		return l[n-2], l[n-1]
+	}
+	if n >= 1 && containsString(knownOS, l[n-1]) {
+		return l[n-1], ""
+	}
+	if n >= 1 && containsString(knownArch, l[n-1]) {
+		return "", l[n-1]
+	}
+	return "", ""
+}
+
+// constraintEnv finds a build configuration under which expr holds. Tags that are
+// neither GOOS nor GOARCH values are passed with -tags, together with the tags of
+// buildTags that expr does not mention.
+func constraintEnv(expr string, buildTags []string) (*buildEnv, error) {
+	parsed, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var free []string
+	mentioned := make(map[string]bool)
+	collectTags(parsed, mentioned)
+	for tag := range mentioned {
+		if tag == "unix" || tag == "gc" || tag == "gccgo" || strings.HasPrefix(tag, "go1.") {
+			continue
+		}
+		free = append(free, tag)
+	}
+	sort.Strings(free)
+	if len(free) > 12 {
+		return nil, fmt.Errorf("build constraint %q uses too many tags", expr)
+	}
+
+	for mask := 0; mask < 1<<len(free); mask++ {
+		env := &buildEnv{}
+		set := make(map[string]bool)
+		valid := true
+		for i, tag := range free {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			set[tag] = true
+			switch {
+			case containsString(knownOS, tag):
+				valid = valid && env.GOOS == ""
+				env.GOOS = tag
+			case containsString(knownArch, tag):
+				valid = valid && env.GOARCH == ""
+				env.GOARCH = tag
+			default:
+				env.Tags = append(env.Tags, tag)
+			}
+		}
+		if !valid {
+			continue
+		}
+		if env.GOOS == "" {
+			env.GOOS = firstUnmentioned(preferredOS, knownOS, mentioned)
+		}
+		if env.GOARCH == "" {
+			env.GOARCH = firstUnmentioned(preferredArch, knownArch, mentioned)
+		}
+
+		ok := parsed.Eval(func(tag string) bool {
+			switch {
+			case tag == "unix":
+				return containsString(unixOS, env.GOOS)
+			case tag == "gc" || strings.HasPrefix(tag, "go1."):
+				return true
+			case tag == "gccgo":
+				return false
+			}
+			return set[tag] || tag == env.GOOS || tag == env.GOARCH
+		})
+		if ok {
+			for _, tag := range buildTags {
+				if !mentioned[tag] && !containsString(env.Tags, tag) {
+					env.Tags = append(env.Tags, tag)
+				}
+			}
+			sort.Strings(env.Tags)
+			return env, nil
+		}
+	}
+	return nil, fmt.Errorf("build constraint %q cannot be satisfied", expr)
+}
+
+// firstUnmentioned returns the first preferred value that a constraint does not
+// mention, so that it does not accidentally switch on a tag
+func firstUnmentioned(preferred, known []string, mentioned map[string]bool) string {
+	for _, list := range [][]string{preferred, known} {
+		for _, v := range list {
+			if !mentioned[v] {
+				return v
+			}
+		}
+	}
+	return preferred[0]
+}
+
+func collectTags(expr constraint.Expr, tags map[string]bool) {
+	switch e := expr.(type) {
+	case *constraint.TagExpr:
+		tags[e.Tag] = true
+	case *constraint.NotExpr:
+		collectTags(e.X, tags)
+	case *constraint.AndExpr:
+		collectTags(e.X, tags)
+		collectTags(e.Y, tags)
+	case *constraint.OrExpr:
+		collectTags(e.X, tags)
+		collectTags(e.Y, tags)
+	}
+}
+
+// environ returns the environment and build flags that select env for go/packages
+func (env *buildEnv) environ() ([]string, []string) {
+	vars := os.Environ()
+	if env.GOOS != "" {
+		vars = append(vars, "GOOS="+env.GOOS)
+	}
+	if env.GOARCH != "" {
+		vars = append(vars, "GOARCH="+env.GOARCH)
+	}
+	var flags []string
+	if len(env.Tags) > 0 {
+		flags = append(flags, "-tags="+strings.Join(env.Tags, ","))
+	}
+	return vars, flags
+}
+
+// scanConstraints returns the distinct build constraints of the non-test Go files
+// in dir, skipping files written by houp
+func scanConstraints(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var constraints []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || isGeneratedFileName(name) {
+			continue
+		}
+		file, err := parseFileHeader(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		expr, err := fileConstraint(name, file)
+		if err != nil {
+			return nil, err
+		}
+		if expr != "" && !seen[expr] {
+			seen[expr] = true
+			constraints = append(constraints, expr)
+		}
+	}
+	sort.Strings(constraints)
+	return constraints, nil
+}
+
+// isGeneratedFileName reports whether name is one of the validation files houp writes
+func isGeneratedFileName(name string) bool {
+	return strings.HasPrefix(name, "validation") && (strings.HasSuffix(name, ".gen.go") || strings.HasSuffix(name, ".gen_test.go"))
+}
+
+// constrainedFileName returns the output file for structs declared under a build
+// constraint, e.g. validation.linux.gen.go or validation.not_windows.gen.go. The key
+// follows a dot so that go/build does not read a GOOS/GOARCH suffix into the name.
+func constrainedFileName(expr string) string {
+	return "validation." + constraintKey(expr) + ".gen.go"
+}
+
+// constraintPrefix returns the prefix for package-level declarations of a
+// constrained output file, distinct from the unconstrained file's "pkg"
+func constraintPrefix(expr string) string {
+	return "pkg_" + constraintKey(expr)
+}
+
+// constraintKey turns a build constraint into an identifier-safe name
+func constraintKey(expr string) string {
+	r := strings.NewReplacer("!", "not_", "&&", "_", "||", "_or_", "(", "", ")", "", ".", "_", " ", "")
+	key := r.Replace(expr)
+	for strings.Contains(key, "__") {
+		key = strings.ReplaceAll(key, "__", "_")
+	}
+	return strings.Trim(key, "_")
+}
+
+// parseFileHeader parses a file up to its package clause, with comments
+func parseFileHeader(path string) (*ast.File, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse file: %w", err)
+	}
+	return file, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
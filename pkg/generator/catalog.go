@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// messageCallRe matches the key and format of the houp.Errorf calls of generated code
+var messageCallRe = regexp.MustCompile(`houp\.Errorf\(("(?:[^"\\]|\\.)*"), ("(?:[^"\\]|\\.)*")`)
+
+// catalogFileName returns the name of the translations file of locale
+func catalogFileName(locale string) string {
+	return "validation.messages." + locale + ".json"
+}
+
+// extractMessages returns the message keys and formats of the translatable errors
+// in generated code
+func extractMessages(codes ...string) (map[string]string, error) {
+	messages := make(map[string]string)
+	for _, code := range codes {
+		for _, m := range messageCallRe.FindAllStringSubmatch(code, -1) {
+			key, err := strconv.Unquote(m[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid message key %s: %w", m[1], err)
+			}
+			format, err := strconv.Unquote(m[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid message format %s: %w", m[2], err)
+			}
+			if prev, ok := messages[key]; ok && prev != format {
+				return nil, fmt.Errorf("message key %s is used for %q and %q", key, prev, format)
+			}
+			messages[key] = format
+		}
+	}
+	return messages, nil
+}
+
+// writeCatalogs writes the translations file of every locale of opts to pkgDir.
+// The file of the first locale holds the built-in messages. The files of the other
+// locales keep the translations of keys that still exist, and start new keys with
+// the built-in message.
+func writeCatalogs(pkgDir string, messages map[string]string, opts *GenerateOptions) error {
+	for i, locale := range opts.Locales {
+		path := filepath.Join(pkgDir, catalogFileName(locale))
+
+		catalog := messages
+		if i > 0 {
+			var err error
+			if catalog, err = mergeCatalog(path, messages); err != nil {
+				return err
+			}
+		}
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(catalog); err != nil {
+			return fmt.Errorf("failed to encode %s: %w", path, err)
+		}
+		if err := writeGeneratedFile(path, buf.String(), opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeCatalog returns messages with the translations of the existing catalog at path
+func mergeCatalog(path string, messages map[string]string) (map[string]string, error) {
+	var existing map[string]string
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	default:
+		if err := json.Unmarshal(data, &existing); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	}
+
+	merged := make(map[string]string, len(messages))
+	for key, format := range messages {
+		if translated, ok := existing[key]; ok {
+			format = translated
+		}
+		merged[key] = format
+	}
+	return merged, nil
+}
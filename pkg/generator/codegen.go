@@ -3,10 +3,12 @@ package generator
 import (
 	"bytes"
 	"fmt"
-	"go/format"
 	"go/types"
+	"maps"
 	"path/filepath"
 	"strings"
+
+	"golang.org/x/tools/imports"
 )
 
 // GenerateValidation generates validation code for a struct
@@ -18,6 +20,7 @@ func GenerateValidation(structInfo *StructInfo, pkgName string, opts *GenerateOp
 		Options:      opts,
 		RegexpVars:   make(map[string]string),
 		RegexpBuffer: []string{},
+		HelperFuncs:  make(map[string]string),
 	}
 
 	// Always add fmt import for error messages
@@ -32,7 +35,7 @@ func GenerateValidation(structInfo *StructInfo, pkgName string, opts *GenerateOp
 	var buf bytes.Buffer
 
 	// Header comment
-	buf.WriteString("// THIS IS AUTOGENERATED FILES, DO NOT EDIT IT\n\n")
+	buf.WriteString(fileHeader(opts))
 
 	// Package declaration
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
@@ -59,6 +62,12 @@ func GenerateValidation(structInfo *StructInfo, pkgName string, opts *GenerateOp
 		buf.WriteString("\n")
 	}
 
+	// Package-level helper functions
+	for _, decl := range ctx.HelperBuffer {
+		buf.WriteString(decl)
+		buf.WriteString("\n\n")
+	}
+
 	// Generated code
 	for _, line := range ctx.Buffer {
 		buf.WriteString(line)
@@ -66,7 +75,7 @@ func GenerateValidation(structInfo *StructInfo, pkgName string, opts *GenerateOp
 	}
 
 	// Format the generated code
-	formatted, err := format.Source(buf.Bytes())
+	formatted, err := formatSource("", buf.Bytes())
 	if err != nil {
 		// Return unformatted code with error for debugging
 		return buf.String(), fmt.Errorf("failed to format generated code for struct %s in package %s: %w", structInfo.Name, pkgName, err)
@@ -77,32 +86,134 @@ func GenerateValidation(structInfo *StructInfo, pkgName string, opts *GenerateOp
 
 // generateValidateMethod generates the Validate() method for a struct
 func generateValidateMethod(ctx *CodeGenContext) error {
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	if err := checkReceiverName(ctx.Receiver()); err != nil {
+		return err
+	}
+	if ctx.Options.Subpackage != "" {
+		if err := checkSubpackageStruct(ctx); err != nil {
+			return err
+		}
+	} else if err := checkMethodName(ctx.Struct, ctx.MethodName()); err != nil {
+		return err
+	}
+	if ctx.Struct.NonStruct {
+		return generateNamedTypeMethod(ctx)
+	}
+	validateMethodSignature(ctx, validateDoc(ctx))
 
-	// Method signature
-	ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("func (%s *%s) Validate() error {", receiverVar, ctx.Struct.Name))
+	// Page and page size checks come first, as struct validators may rely on them.
+	// They return at once even when collecting errors.
+	if ctx.Struct.Pagination != "" {
+		if err := generatePagination(ctx); err != nil {
+			return fmt.Errorf("pagination: %w", err)
+		}
+	}
+
+	multi := ctx.Options.MultiError || ctx.Struct.MultiError
+	errsDecl := len(ctx.Buffer)
+	collected := false
 
-	// Generate struct-level custom validator calls first
+	// Generate struct-level custom validator calls
 	for _, validator := range ctx.Struct.CustomValidators {
-		if err := generateStructValidatorCall(ctx, validator, receiverVar, ctx.PkgPath); err != nil {
+		start := len(ctx.Buffer)
+		if err := generateStructValidatorCall(ctx, validator, ctx.ReceiverPointer(), ctx.PkgPath); err != nil {
 			return fmt.Errorf("failed to generate struct-level validator %s: %w", validator.FuncName, err)
 		}
+		if multi {
+			collected = collectErrors(ctx, start) || collected
+		}
 	}
 
 	// Generate validation code for each field
 	for _, field := range ctx.Struct.Fields {
+		start := len(ctx.Buffer)
 		if err := generateFieldValidation(ctx, field); err != nil {
 			return fmt.Errorf("failed to generate validation for field %s: %w", field.Name, err)
 		}
+		if multi {
+			collected = collectErrors(ctx, start) || collected
+		}
 	}
 
-	// Return nil on success
-	ctx.Buffer = append(ctx.Buffer, "\treturn nil")
+	if collected {
+		ctx.AddImport("errors", "errors")
+		ctx.Buffer = append(ctx.Buffer[:errsDecl], append([]string{"\tvar errs []error"}, ctx.Buffer[errsDecl:]...)...)
+		ctx.Buffer = append(ctx.Buffer, "\treturn errors.Join(errs...)")
+	} else {
+		// Return nil on success
+		ctx.Buffer = append(ctx.Buffer, "\treturn nil")
+	}
 	ctx.Buffer = append(ctx.Buffer, "}")
 
 	return nil
 }
 
+// collectErrors wraps the code generated since start, the checks of one field or
+// struct validator, in a closure whose error is appended to errs. Each field still
+// stops at its first failing rule, so later rules can rely on earlier ones (a nil
+// check before a dereference), but every failing field is reported. It reports
+// whether there was any code to wrap. With MaxErrors, the method returns once that
+// many errors are collected.
+func collectErrors(ctx *CodeGenContext, start int) bool {
+	section := append([]string(nil), ctx.Buffer[start:]...)
+	var comment []string
+	if len(section) > 0 && strings.HasPrefix(section[0], "\t// ") && !strings.Contains(section[0], "\n") {
+		comment, section = section[:1], section[1:]
+	}
+	body := strings.Join(section, "\n")
+	hasCode := false
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "//") {
+			hasCode = true
+			break
+		}
+	}
+	if !hasCode {
+		return false
+	}
+
+	ctx.Buffer = append(ctx.Buffer[:start], comment...)
+	ctx.Buffer = append(ctx.Buffer,
+		"\tif err := func() error {",
+		indentCode(body, 1),
+		"\t\treturn nil",
+		"\t}(); err != nil {",
+		"\t\terrs = append(errs, err)")
+	if ctx.Options.MaxErrors > 0 {
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("\t\tif len(errs) == %d {", ctx.Options.MaxErrors),
+			"\t\t\treturn errors.Join(errs...)",
+			"\t\t}")
+	}
+	ctx.Buffer = append(ctx.Buffer, "\t}")
+	return true
+}
+
+// validateMethodSignature opens the Validate method, with doc as its doc comment.
+// Structs with context-aware validators get ValidateContext, and Validate runs it
+// with a background context. In subpackage mode it opens the validation function
+// instead.
+func validateMethodSignature(ctx *CodeGenContext, doc []string) {
+	if ctx.Options.Subpackage != "" {
+		validateFuncSignature(ctx, doc)
+		return
+	}
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer, doc...)
+	if ctx.Struct.NeedsContext {
+		ctx.AddImport("context", "context")
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("func (%s) %s() error {", ctx.ReceiverDecl(), ctx.MethodName()),
+			fmt.Sprintf("\treturn %s.%sContext(context.Background())", receiverVar, ctx.MethodName()),
+			"}",
+			"",
+			fmt.Sprintf("// %sContext is %s with ctx passed to the validators and nested structs that take a context.", ctx.MethodName(), ctx.MethodName()),
+			fmt.Sprintf("func (%s) %sContext(ctx context.Context) error {", ctx.ReceiverDecl(), ctx.MethodName()))
+	} else {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("func (%s) %s() error {", ctx.ReceiverDecl(), ctx.MethodName()))
+	}
+}
+
 // generateFieldValidation generates validation code for a single field
 func generateFieldValidation(ctx *CodeGenContext, field *FieldInfo) error {
 	// Validate rules first
@@ -130,12 +241,22 @@ func generateFieldValidation(ctx *CodeGenContext, field *FieldInfo) error {
 		otherRules = filtered
 	}
 
+	// Anonymous struct fields are validated as if tagged dive
+	if inlineNeedsDive(ctx, field) {
+		otherRules = append(otherRules, &DiveRule{})
+	}
+
 	if len(otherRules) == 0 {
 		return nil
 	}
+	ctx.Field = field
 
 	// Add comment for field
-	ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("\t// %s: %s", field.Name, extractTag(field.Tag, "validate")))
+	if tag := extractTag(field.Tag, "validate"); tag != "" {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("\t// %s: %s", field.Name, tag))
+	} else {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("\t// %s: inline struct", field.Name))
+	}
 
 	// Generate wrapper for omitempty if needed
 	if hasOmitEmpty {
@@ -145,7 +266,7 @@ func generateFieldValidation(ctx *CodeGenContext, field *FieldInfo) error {
 	} else {
 		// Generate validations directly
 		for _, rule := range otherRules {
-			code, err := rule.Generate(ctx, field)
+			code, err := generateRule(ctx, rule, field, &failureContext{Rule: rule, Value: ctx.FieldExpr(field).Ref, Label: field.Name})
 			if err != nil {
 				return err
 			}
@@ -161,19 +282,25 @@ func generateFieldValidation(ctx *CodeGenContext, field *FieldInfo) error {
 // generateOmitEmptyWrapper wraps validations in an empty check
 func generateOmitEmptyWrapper(ctx *CodeGenContext, field *FieldInfo, rules []ValidationRule) error {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	ref := ctx.FieldRef(field)
 
 	// Generate appropriate empty check based on type
 	var condition string
 
 	if typeInfo.IsPointer {
-		condition = fmt.Sprintf("%s.%s != nil", receiverVar, field.Name)
+		condition = fmt.Sprintf("%s != nil", ref)
 	} else if typeInfo.IsSlice {
-		condition = fmt.Sprintf("%s.%s != nil && len(%s.%s) > 0", receiverVar, field.Name, receiverVar, field.Name)
+		condition = fmt.Sprintf("%s != nil && len(%s) > 0", ref, ref)
+	} else if typeInfo.Kind == TypeMap {
+		condition = fmt.Sprintf("len(%s) > 0", ref)
 	} else if typeInfo.Kind == TypeString {
-		condition = fmt.Sprintf("%s.%s != \"\"", receiverVar, field.Name)
+		condition = fmt.Sprintf("%s != \"\"", ref)
 	} else if typeInfo.IsNumeric() {
-		condition = fmt.Sprintf("%s.%s != 0", receiverVar, field.Name)
+		condition = fmt.Sprintf("%s != 0", ref)
+	} else if typeInfo.IsUUID() {
+		condition = fmt.Sprintf("%s != %s.Nil", ref, ctx.AddImport(uuidPkgPath, "uuid"))
+	} else if structCond, ok := structNonZeroCondition(ctx, field, ref); ok {
+		condition = structCond
 	} else {
 		// For other types, skip omitempty
 		condition = "true"
@@ -183,7 +310,7 @@ func generateOmitEmptyWrapper(ctx *CodeGenContext, field *FieldInfo, rules []Val
 
 	// Generate validations inside the if block
 	for _, rule := range rules {
-		code, err := rule.Generate(ctx, field)
+		code, err := generateRule(ctx, rule, field, &failureContext{Rule: rule, Value: ctx.FieldExpr(field).Ref, Label: field.Name})
 		if err != nil {
 			return err
 		}
@@ -199,6 +326,28 @@ func generateOmitEmptyWrapper(ctx *CodeGenContext, field *FieldInfo, rules []Val
 	return nil
 }
 
+// structNonZeroCondition builds the "field is set" condition for a non-pointer struct field.
+// Types with an IsZero() bool method use it; other comparable structs are compared
+// against their zero composite literal. ok is false for non-struct or non-comparable types.
+func structNonZeroCondition(ctx *CodeGenContext, field *FieldInfo, fieldRef string) (string, bool) {
+	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+	t := typeInfo.GoType
+	if t == nil {
+		return "", false
+	}
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return "", false
+	}
+
+	if typeInfo.Implements(isZeroerInterface) {
+		return fmt.Sprintf("!%s.IsZero()", fieldRef), true
+	}
+	if types.Comparable(t) {
+		return fmt.Sprintf("%s != (%s{})", fieldRef, qualifiedTypeString(ctx, t)), true
+	}
+	return "", false
+}
+
 // indentCode adds additional indentation to generated code
 func indentCode(code string, levels int) string {
 	indent := strings.Repeat("\t", levels)
@@ -219,8 +368,11 @@ func generateStructValidatorCall(ctx *CodeGenContext, validator CustomValidator,
 	// Empty ImportPath means same package (new format: just FuncName)
 	// Or ImportPath matches current package path
 	if validator.ImportPath == "" || validator.ImportPath == currentPkgPath {
-		// Same package - call function directly without package qualifier
-		funcQualifier = ""
+		// Same package - call function directly without package qualifier,
+		// unless the generated code lives in a subpackage
+		if q := ctx.localQualifier(); q != "" {
+			funcQualifier = q + "."
+		}
 	} else {
 		// Different package - add import and use package qualifier
 		pkgAlias := ctx.AddImport(validator.ImportPath, filepath.Base(validator.ImportPath))
@@ -228,8 +380,13 @@ func generateStructValidatorCall(ctx *CodeGenContext, validator CustomValidator,
 	}
 
 	// Generate the validator call
-	// The validator function receives the entire struct as a pointer
-	validatorCall := fmt.Sprintf("\tif err := %s%s(%s); err != nil {", funcQualifier, validator.FuncName, receiverVar)
+	// The validator function receives the entire struct as a pointer,
+	// preceded by the context for context-aware validators
+	args := receiverVar
+	if validator.WithContext {
+		args = "ctx, " + receiverVar
+	}
+	validatorCall := fmt.Sprintf("\tif err := %s%s(%s); err != nil {", funcQualifier, validator.FuncName, args)
 	ctx.Buffer = append(ctx.Buffer, validatorCall)
 	ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("\t\treturn fmt.Errorf(\"struct validation failed: %%w\", err)"))
 	ctx.Buffer = append(ctx.Buffer, "\t}")
@@ -263,39 +420,48 @@ func GenerateFileValidation(fileInfo *FileInfo, pkgName string, opts *GenerateOp
 
 	// Create file prefix for unique regexp variable names
 	filePrefix := sanitizeFilenameForVar(fileInfo.Name)
+	declared := declaredFuncs([]*FileInfo{fileInfo})
 
 	// Combine all struct validations with shared context for regexp vars
 	allImports := make(map[string]string)
 	sharedRegexpVars := make(map[string]string)
 	var sharedRegexpBuffer []string
+	sharedHelperFuncs := make(map[string]string)
+	var sharedHelperBuffer []string
 	var allMethods []string
-	varCounter := 0
+	var failures GenerationErrors
 
 	for _, structInfo := range needsValidation {
 		// Regenerate with a combined context
 		ctx := &CodeGenContext{
-			Struct:       structInfo,
-			Imports:      allImports,
-			Buffer:       []string{},
-			Options:      opts,
-			TypesInfo:    typesInfo,
-			VarCounter:   varCounter,
-			RegexpVars:   sharedRegexpVars,
-			RegexpBuffer: sharedRegexpBuffer,
-			FilePrefix:   filePrefix,
-			PkgPath:      pkgPath,
+			Struct:        structInfo,
+			Imports:       allImports,
+			Buffer:        []string{},
+			Options:       opts,
+			TypesInfo:     typesInfo,
+			RegexpVars:    sharedRegexpVars,
+			RegexpBuffer:  sharedRegexpBuffer,
+			FilePrefix:    filePrefix,
+			PkgPath:       pkgPath,
+			PkgName:       pkgName,
+			HelperFuncs:   sharedHelperFuncs,
+			HelperBuffer:  sharedHelperBuffer,
+			DeclaredFuncs: declared,
 		}
 
-		ctx.AddImport("fmt", "fmt")
-
-		if err := generateValidateMethod(ctx); err != nil {
+		ctx, failure, err := generateStructMethod(ctx)
+		if err != nil {
 			return "", err
 		}
+		if failure != nil {
+			failures = append(failures, failure)
+		}
 
 		// Update shared state
-		varCounter = ctx.VarCounter
 		sharedRegexpVars = ctx.RegexpVars
 		sharedRegexpBuffer = ctx.RegexpBuffer
+		sharedHelperFuncs = ctx.HelperFuncs
+		sharedHelperBuffer = ctx.HelperBuffer
 
 		// Merge imports
 		for path, alias := range ctx.Imports {
@@ -310,7 +476,7 @@ func GenerateFileValidation(fileInfo *FileInfo, pkgName string, opts *GenerateOp
 	var buf bytes.Buffer
 
 	// Header
-	buf.WriteString("// THIS IS AUTOGENERATED FILES, DO NOT EDIT IT\n\n")
+	buf.WriteString(fileHeader(opts))
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
 
 	// Imports
@@ -337,6 +503,12 @@ func GenerateFileValidation(fileInfo *FileInfo, pkgName string, opts *GenerateOp
 		buf.WriteString("\n")
 	}
 
+	// Package-level helper functions
+	for _, decl := range sharedHelperBuffer {
+		buf.WriteString(decl)
+		buf.WriteString("\n\n")
+	}
+
 	// Methods
 	for i, method := range allMethods {
 		if i > 0 {
@@ -347,7 +519,7 @@ func GenerateFileValidation(fileInfo *FileInfo, pkgName string, opts *GenerateOp
 	}
 
 	// Format
-	formatted, err := format.Source(buf.Bytes())
+	formatted, err := formatSource("", buf.Bytes())
 	if err != nil {
 		// Collect struct names for better error message
 		structNames := make([]string, 0, len(needsValidation))
@@ -357,21 +529,61 @@ func GenerateFileValidation(fileInfo *FileInfo, pkgName string, opts *GenerateOp
 		return buf.String(), fmt.Errorf("failed to format generated code for structs [%s] in package %s: %w", strings.Join(structNames, ", "), pkgName, err)
 	}
 
+	if len(failures) > 0 {
+		return string(formatted), failures
+	}
 	return string(formatted), nil
 }
 
 // GeneratePackageValidation generates validation code for all structs across all files in a package
 func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (string, error) {
+	return generatePackageFile(pkgInfo, opts, false, "", nil)
+}
+
+// GeneratePackageConstrainedValidation generates validation code for the structs declared
+// in files with the given build constraint. The output carries the same //go:build line,
+// so a struct declared once per platform gets one Validate method per platform.
+// pkgInfo must be loaded with a build configuration that satisfies the constraint.
+func GeneratePackageConstrainedValidation(pkgInfo *PackageInfo, opts *GenerateOptions, constraint string) (string, error) {
+	return generatePackageFile(pkgInfo, opts, false, constraint, nil)
+}
+
+// GeneratePackageTestValidation generates validation code for the structs declared in
+// the package's _test.go files. The result belongs in a _test.go file so that it is
+// only compiled together with them. pkgInfo must come from ParsePackageWithTests.
+func GeneratePackageTestValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (string, error) {
+	return generatePackageFile(pkgInfo, opts, true, "", nil)
+}
+
+// packageRegexps are the regexp variables, by pattern, of a package's unconstrained
+// validation.gen.go. The test and build-constrained files are always compiled
+// together with it, so they use its variables instead of declaring their own.
+type packageRegexps map[string]string
+
+// generatePackageFile generates one package-level file for either the regular or the test
+// files that share the build constraint; an empty constraint selects unconstrained files.
+// With shared, the unconstrained regular file records its regexp variables there and the
+// other files use them.
+func generatePackageFile(pkgInfo *PackageInfo, opts *GenerateOptions, testFiles bool, constraint string, shared packageRegexps) (string, error) {
+	if opts.Context {
+		requireContext(pkgInfo)
+	}
+
 	// Collect all structs that need validation from all files
 	var needsValidation []*StructInfo
-	for _, fileInfo := range pkgInfo.Files {
+	for _, fileInfo := range sortedFiles(pkgInfo) {
 		// Skip files marked with //validate:skip
 		if fileInfo.Skip {
 			continue
 		}
 
-		// Skip test files
-		if strings.HasSuffix(fileInfo.Name, "_test.go") {
+		// Test files and regular files go to separate outputs
+		if strings.HasSuffix(fileInfo.Name, "_test.go") != testFiles {
+			continue
+		}
+
+		// Each build constraint gets its own output
+		if fileInfo.Constraint != constraint {
 			continue
 		}
 
@@ -398,39 +610,59 @@ func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (str
 
 	// Combine all struct validations with shared context for regexp vars and imports
 	allImports := make(map[string]string)
+	primary := !testFiles && constraint == ""
 	sharedRegexpVars := make(map[string]string)
+	if !primary {
+		maps.Copy(sharedRegexpVars, shared)
+	}
 	var sharedRegexpBuffer []string
+	sharedHelperFuncs := make(map[string]string)
+	var sharedHelperBuffer []string
 	var allMethods []string
-	varCounter := 0
+	var failures GenerationErrors
 
-	// Use "pkg" as the file prefix since this is a package-level file
+	// Use "pkg" as the file prefix since this is a package-level file.
+	// The test file shares the package, so its declarations need a distinct prefix.
 	filePrefix := "pkg"
+	if testFiles {
+		filePrefix = "pkgtest"
+	}
+	if constraint != "" {
+		filePrefix = constraintPrefix(constraint)
+	}
+	declared := declaredFuncs(sortedFiles(pkgInfo))
 
 	for _, structInfo := range needsValidation {
 		// Generate with a combined context
 		ctx := &CodeGenContext{
-			Struct:       structInfo,
-			Imports:      allImports,
-			Buffer:       []string{},
-			Options:      opts,
-			TypesInfo:    pkgInfo.TypesInfo,
-			VarCounter:   varCounter,
-			RegexpVars:   sharedRegexpVars,
-			RegexpBuffer: sharedRegexpBuffer,
-			FilePrefix:   filePrefix,
-			PkgPath:      pkgInfo.PkgPath,
+			Struct:        structInfo,
+			Imports:       allImports,
+			Buffer:        []string{},
+			Options:       opts,
+			TypesInfo:     pkgInfo.TypesInfo,
+			RegexpVars:    sharedRegexpVars,
+			RegexpBuffer:  sharedRegexpBuffer,
+			FilePrefix:    filePrefix,
+			PkgPath:       pkgInfo.PkgPath,
+			PkgName:       pkgInfo.Name,
+			HelperFuncs:   sharedHelperFuncs,
+			HelperBuffer:  sharedHelperBuffer,
+			DeclaredFuncs: declared,
 		}
 
-		ctx.AddImport("fmt", "fmt")
-
-		if err := generateValidateMethod(ctx); err != nil {
+		ctx, failure, err := generateStructMethod(ctx)
+		if err != nil {
 			return "", err
 		}
+		if failure != nil {
+			failures = append(failures, failure)
+		}
 
 		// Update shared state
-		varCounter = ctx.VarCounter
 		sharedRegexpVars = ctx.RegexpVars
 		sharedRegexpBuffer = ctx.RegexpBuffer
+		sharedHelperFuncs = ctx.HelperFuncs
+		sharedHelperBuffer = ctx.HelperBuffer
 
 		// Merge imports
 		for path, alias := range ctx.Imports {
@@ -441,12 +673,23 @@ func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (str
 		allMethods = append(allMethods, strings.Join(ctx.Buffer, "\n"))
 	}
 
+	if primary && shared != nil {
+		maps.Copy(shared, sharedRegexpVars)
+	}
+
 	// Build final source
 	var buf bytes.Buffer
 
 	// Header
-	buf.WriteString("// THIS IS AUTOGENERATED FILES, DO NOT EDIT IT\n\n")
-	buf.WriteString(fmt.Sprintf("package %s\n\n", pkgInfo.Name))
+	buf.WriteString(fileHeader(opts))
+	if constraint != "" {
+		buf.WriteString(fmt.Sprintf("//go:build %s\n\n", constraint))
+	}
+	pkgName := pkgInfo.Name
+	if opts.Subpackage != "" {
+		pkgName = opts.Subpackage
+	}
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
 
 	// Imports
 	if len(allImports) > 0 {
@@ -472,6 +715,12 @@ func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (str
 		buf.WriteString("\n")
 	}
 
+	// Package-level helper functions
+	for _, decl := range sharedHelperBuffer {
+		buf.WriteString(decl)
+		buf.WriteString("\n\n")
+	}
+
 	// Methods
 	for i, method := range allMethods {
 		if i > 0 {
@@ -482,7 +731,7 @@ func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (str
 	}
 
 	// Format
-	formatted, err := format.Source(buf.Bytes())
+	formatted, err := formatSource(filepath.Join(outputDir(pkgInfo.Path, opts), "validation.gen.go"), buf.Bytes())
 	if err != nil {
 		// Collect struct names for better error message
 		structNames := make([]string, 0, len(needsValidation))
@@ -492,24 +741,36 @@ func GeneratePackageValidation(pkgInfo *PackageInfo, opts *GenerateOptions) (str
 		return buf.String(), fmt.Errorf("failed to format generated code for structs [%s] in package %s: %w", strings.Join(structNames, ", "), pkgInfo.Name, err)
 	}
 
+	if len(failures) > 0 {
+		return string(formatted), failures
+	}
 	return string(formatted), nil
 }
 
 // GenerateEmptyValidation generates an empty Validate() method for structs with dive but no own validations
 func GenerateEmptyValidation(structName, pkgName string) string {
-	receiverVar := strings.ToLower(string(structName[0]))
+	receiverVar := defaultReceiver(structName)
 
 	var buf bytes.Buffer
-	buf.WriteString("// THIS IS AUTOGENERATED FILES, DO NOT EDIT IT\n\n")
+	buf.WriteString(fileHeader(nil))
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkgName))
+	buf.WriteString(fmt.Sprintf("// Validate returns nil, as %s has no validate tags.\n", structName))
 	buf.WriteString(fmt.Sprintf("func (%s *%s) Validate() error {\n", receiverVar, structName))
 	buf.WriteString("\treturn nil\n")
 	buf.WriteString("}\n")
 
-	formatted, err := format.Source(buf.Bytes())
+	formatted, err := formatSource("", buf.Bytes())
 	if err != nil {
 		return buf.String()
 	}
 
 	return string(formatted)
 }
+
+// formatSource formats generated code the way goimports does: imports are sorted
+// and grouped, and imports left unused, such as fmt when every rule of a struct has
+// a custom message, are removed. filename locates the code's package, should
+// references to packages the code does not import need to be resolved.
+func formatSource(filename string, src []byte) ([]byte, error) {
+	return imports.Process(filename, src, &imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
+}
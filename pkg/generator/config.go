@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Config is the content of a houp config file, a JSON object such as
+//
+//	{
+//		"messages": {"min": "%s must contain at least %s characters"},
+//		"header": ["Copyright 2024 Example Corp. All rights reserved."]
+//	}
+type Config struct {
+	// Messages are message templates by rule name, see GenerateOptions.MessageTemplates
+	Messages map[string]string `json:"messages"`
+
+	// Header holds extra header lines of generated files, see GenerateOptions.Header
+	Header []string `json:"header"`
+}
+
+// LoadConfig reads and checks the config file at path
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	rules := make([]string, 0, len(cfg.Messages))
+	for rule := range cfg.Messages {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		if !containsString(supportedRules, rule) || rule == "dive" || rule == "omitempty" {
+			return nil, fmt.Errorf("config %s: %q is not a rule with an error message", path, rule)
+		}
+		if err := checkMessageTemplate(cfg.Messages[rule]); err != nil {
+			return nil, fmt.Errorf("config %s: rule %s: %w", path, rule, err)
+		}
+	}
+	if err := checkHeaderOptions(&GenerateOptions{Header: cfg.Header}); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
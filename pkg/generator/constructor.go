@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// constructorName returns the name of the generated constructor of a struct:
+// NewUser for User, and the unexported newUser for user
+func constructorName(structName string) string {
+	if ast.IsExported(structName) {
+		return "New" + structName
+	}
+	return "new" + strings.ToUpper(structName[:1]) + structName[1:]
+}
+
+// declaredFuncs returns the package-level functions, and the methods as
+// "Type.Method", declared in the given files, leaving out the files houp generated
+func declaredFuncs(files []*FileInfo) map[string]bool {
+	funcs := make(map[string]bool)
+	for _, fileInfo := range files {
+		if fileInfo.AST == nil || isGeneratedFileName(filepath.Base(fileInfo.Path)) {
+			continue
+		}
+		for _, decl := range fileInfo.AST.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if fn.Recv == nil {
+				funcs[fn.Name.Name] = true
+			} else if len(fn.Recv.List) == 1 {
+				funcs[embeddedFieldName(fn.Recv.List[0].Type)+"."+fn.Name.Name] = true
+			}
+		}
+	}
+	return funcs
+}
+
+// constructorParam is a struct field set by a generated constructor
+type constructorParam struct {
+	Field string
+	Name  string
+	Type  string
+}
+
+// generateConstructor appends New<Struct>, which takes every field of the struct in
+// declaration order, and returns the struct only if it passes Validate. Generic
+// structs and structs whose package already declares the constructor are skipped.
+func generateConstructor(ctx *CodeGenContext) {
+	typeSpec := ctx.Struct.TypeSpec
+	if typeSpec == nil || typeSpec.TypeParams != nil {
+		return
+	}
+	structType, ok := typeSpec.Type.(*ast.StructType)
+	if !ok {
+		return
+	}
+	name := constructorName(ctx.Struct.Name)
+	if ctx.DeclaredFuncs[name] {
+		return
+	}
+
+	receiverVar := ctx.Receiver()
+	used := map[string]bool{receiverVar: true, "err": true, ctx.Struct.Name: true}
+	var params []constructorParam
+	for _, field := range structType.Fields.List {
+		typeString := fieldTypeString(ctx, field.Type)
+		names := make([]string, 0, len(field.Names))
+		for _, ident := range field.Names {
+			names = append(names, ident.Name)
+		}
+		// An embedded field is named after its type
+		if len(names) == 0 {
+			names = append(names, embeddedFieldName(field.Type))
+		}
+		for _, fieldName := range names {
+			if fieldName == "_" || fieldName == "" {
+				continue
+			}
+			params = append(params, constructorParam{
+				Field: fieldName,
+				Name:  uniqueParamName(paramName(fieldName), used),
+				Type:  typeString,
+			})
+		}
+	}
+
+	signature := make([]string, 0, len(params))
+	for _, p := range params {
+		signature = append(signature, p.Name+" "+p.Type)
+	}
+
+	lines := []string{
+		"",
+		fmt.Sprintf("func %s(%s) (*%s, error) {", name, strings.Join(signature, ", "), ctx.Struct.Name),
+		fmt.Sprintf("\t%s := &%s{", receiverVar, ctx.Struct.Name),
+	}
+	for _, p := range params {
+		lines = append(lines, fmt.Sprintf("\t\t%s: %s,", p.Field, p.Name))
+	}
+	lines = append(lines,
+		"\t}",
+		fmt.Sprintf("\tif err := %s.%s(); err != nil {", receiverVar, ctx.MethodName()),
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\treturn %s, nil", receiverVar),
+		"}")
+	ctx.Buffer = append(ctx.Buffer, lines...)
+}
+
+// fieldTypeString renders a field type for the generated file, qualifying and
+// importing packages through the type information when it is available
+func fieldTypeString(ctx *CodeGenContext, expr ast.Expr) string {
+	if ctx.TypesInfo != nil {
+		if t := ctx.TypesInfo.TypeOf(expr); t != nil {
+			return qualifiedTypeString(ctx, t)
+		}
+	}
+	return types.ExprString(expr)
+}
+
+// embeddedFieldName returns the field name of an embedded type: T, *T, pkg.T or T[P]
+func embeddedFieldName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return embeddedFieldName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.IndexExpr:
+		return embeddedFieldName(t.X)
+	case *ast.IndexListExpr:
+		return embeddedFieldName(t.X)
+	}
+	return ""
+}
+
+// paramName turns a field name into a parameter name: Name -> name, ID -> id,
+// URLPath -> urlPath. Keywords get a Value suffix (Type -> typeValue).
+func paramName(field string) string {
+	runes := []rune(field)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	// Keep the last upper-case letter of an acronym that starts the next word
+	if upper > 1 && upper < len(runes) && unicode.IsLower(runes[upper]) {
+		upper--
+	}
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	name := string(runes)
+	if token.IsKeyword(name) {
+		name += "Value"
+	}
+	return name
+}
+
+// uniqueParamName returns name, or name with a numeric suffix if it is taken
+func uniqueParamName(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
@@ -0,0 +1,222 @@
+package generator
+
+import (
+	"go/types"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// resolveContextValidators marks custom field and struct validators whose signature is
+// func(context.Context, T) error, then marks the structs that need a ValidateContext
+// method: those with such a validator and those that dive into a struct with a
+// ValidateContext method. Validators that cannot be resolved keep the func(T) error form.
+// Validator packages are loaded for env like the package itself, see parsePackage.
+func resolveContextValidators(pkgInfo *PackageInfo, pkg *packages.Package, env *buildEnv) {
+	lookup := validatorLookup(pkgInfo, pkg, env)
+
+	var structs []*StructInfo
+	for _, fileInfo := range sortedFiles(pkgInfo) {
+		structs = append(structs, fileInfo.Structs...)
+	}
+
+	for _, s := range structs {
+		for i := range s.CustomValidators {
+			v := &s.CustomValidators[i]
+			if fn := lookup(v.ImportPath, v.FuncName); fn != nil && isContextValidatorSignature(fn) {
+				v.WithContext = true
+				s.NeedsContext = true
+			}
+		}
+		for _, field := range s.Fields {
+			for _, rule := range flattenRules(field.Rules) {
+				custom, ok := rule.(*CustomRule)
+				if !ok {
+					continue
+				}
+				if fn := lookup(custom.ImportPath, custom.FuncName); fn != nil && isContextValidatorSignature(fn) {
+					custom.WithContext = true
+					s.NeedsContext = true
+				}
+			}
+		}
+	}
+
+	propagateContext(pkgInfo, structs)
+}
+
+// requireContext marks every struct of the package as context-aware, for the
+// --context mode, so all of them get a ValidateContext method and pass their
+// context on to dives and context-aware validators
+func requireContext(pkgInfo *PackageInfo) {
+	var structs []*StructInfo
+	for _, fileInfo := range sortedFiles(pkgInfo) {
+		for _, s := range fileInfo.Structs {
+			s.NeedsContext = true
+			structs = append(structs, s)
+		}
+	}
+	propagateContext(pkgInfo, structs)
+}
+
+// propagateContext marks the dives that reach a context-aware struct. A struct that
+// dives into one passes its context on, which makes it context-aware too; this
+// repeats until no struct changes.
+func propagateContext(pkgInfo *PackageInfo, structs []*StructInfo) {
+	local := make(map[string]*StructInfo, len(structs))
+	for _, s := range structs {
+		local[s.Name] = s
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, s := range structs {
+			for _, field := range s.Fields {
+				for _, rule := range field.Rules {
+					dive, ok := rule.(*DiveRule)
+					if !ok || dive.WithContext {
+						continue
+					}
+					if diveTargetNeedsContext(pkgInfo, field, local) {
+						dive.WithContext = true
+						if !s.NeedsContext {
+							s.NeedsContext = true
+							changed = true
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// validatorLookup returns a function resolving a validator reference to its declaration.
+// Validators outside the current package are loaded from source in one go, like the
+// unused-validator check in stats.
+func validatorLookup(pkgInfo *PackageInfo, pkg *packages.Package, env *buildEnv) func(importPath, funcName string) *types.Func {
+	scopes := make(map[string]*types.Scope)
+	if pkg.Types != nil {
+		scopes[pkg.PkgPath] = pkg.Types.Scope()
+	}
+
+	external := make(map[string]bool)
+	for _, fileInfo := range pkgInfo.Files {
+		for _, s := range fileInfo.Structs {
+			for _, v := range s.CustomValidators {
+				if v.ImportPath != "" && v.ImportPath != pkg.PkgPath {
+					external[v.ImportPath] = true
+				}
+			}
+			for _, field := range s.Fields {
+				for _, rule := range flattenRules(field.Rules) {
+					if custom, ok := rule.(*CustomRule); ok && custom.ImportPath != pkg.PkgPath {
+						external[custom.ImportPath] = true
+					}
+				}
+			}
+		}
+	}
+
+	if len(external) > 0 {
+		paths := make([]string, 0, len(external))
+		for path := range external {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		cfg := &packages.Config{
+			Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+			Dir:  pkgInfo.Path,
+		}
+		if env != nil {
+			cfg.Env, cfg.BuildFlags = env.environ()
+		}
+		// A failed load leaves those validators unresolved, i.e. without context
+		if pkgs, err := packages.Load(cfg, paths...); err == nil {
+			for _, p := range pkgs {
+				if p.Types != nil {
+					scopes[p.PkgPath] = p.Types.Scope()
+				}
+			}
+		}
+	}
+
+	return func(importPath, funcName string) *types.Func {
+		if importPath == "" {
+			importPath = pkg.PkgPath
+		}
+		scope, ok := scopes[importPath]
+		if !ok {
+			return nil
+		}
+		fn, _ := scope.Lookup(funcName).(*types.Func)
+		return fn
+	}
+}
+
+// isContextValidatorSignature reports whether fn has the func(context.Context, T) error shape
+func isContextValidatorSignature(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() != nil {
+		return false
+	}
+	if sig.Params().Len() != 2 || sig.Results().Len() != 1 {
+		return false
+	}
+	return isContextType(sig.Params().At(0).Type()) &&
+		types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// isContextType reports whether t is context.Context
+func isContextType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
+// diveTargetNeedsContext reports whether a dive on field reaches a struct that has, or
+// will be generated with, a ValidateContext method
+func diveTargetNeedsContext(pkgInfo *PackageInfo, field *FieldInfo, local map[string]*StructInfo) bool {
+	if pkgInfo.TypesInfo == nil {
+		return false
+	}
+	t := pkgInfo.TypesInfo.TypeOf(field.Type)
+	if t == nil {
+		return false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		t = u.Elem()
+	case *types.Map:
+		t = u.Elem()
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	if obj := named.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == pkgInfo.PkgPath {
+		if s, ok := local[obj.Name()]; ok && s.NeedsContext {
+			return true
+		}
+	}
+	return hasValidateContextMethod(named)
+}
+
+// hasValidateContextMethod reports whether T or *T has a ValidateContext(context.Context) error method
+func hasValidateContextMethod(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(t), true, nil, "ValidateContext")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 1 && sig.Results().Len() == 1 &&
+		isContextType(sig.Params().At(0).Type()) &&
+		types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
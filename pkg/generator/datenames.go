@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// dateNames are the month and weekday names of a language, in the order of
+// time.Month and time.Weekday (January first, Sunday first)
+type dateNames struct {
+	Months      [12]string
+	ShortMonths [12]string
+	Days        [7]string
+	ShortDays   [7]string
+}
+
+// englishDateNames are the names time.Parse understands
+var englishDateNames = dateNames{
+	Months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+}
+
+// localizedDateNames are the languages accepted by the lang option of datetime,
+// keyed by ISO 639-1 code. Abbreviations are the common forms without a trailing dot.
+// The names are kept here rather than read from golang.org/x/text, which does not
+// export CLDR month and day names, so generated code needs no dependency.
+var localizedDateNames = map[string]dateNames{
+	"de": {
+		Months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		ShortMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		Days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		ShortDays:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"es": {
+		Months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		ShortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		Days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		ShortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	"fr": {
+		Months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortMonths: [12]string{"janv", "févr", "mars", "avr", "mai", "juin", "juil", "août", "sept", "oct", "nov", "déc"},
+		Days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		ShortDays:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+	},
+	"it": {
+		Months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		ShortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		Days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		ShortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	"nl": {
+		Months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		ShortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		Days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		ShortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+	"pt": {
+		Months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		ShortMonths: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		Days:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		ShortDays:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+}
+
+// dateNameLanguages returns the languages of localizedDateNames, sorted
+func dateNameLanguages() []string {
+	langs := make([]string, 0, len(localizedDateNames))
+	for lang := range localizedDateNames {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// layoutNameKinds reports whether any of the layouts uses month names (Jan,
+// January) or weekday names (Mon, Monday)
+func layoutNameKinds(layouts []string) (months, days bool) {
+	for _, layout := range layouts {
+		months = months || strings.Contains(layout, "Jan")
+		days = days || strings.Contains(layout, "Mon")
+	}
+	return months, days
+}
+
+// dateNameElements are the layout elements holding a name, with the names each
+// one reads
+var dateNameElements = []struct {
+	Elem  string
+	Names func(dateNames) []string
+}{
+	{"January", func(n dateNames) []string { return n.Months[:] }},
+	{"Jan", func(n dateNames) []string { return n.ShortMonths[:] }},
+	{"Monday", func(n dateNames) []string { return n.Days[:] }},
+	{"Mon", func(n dateNames) []string { return n.ShortDays[:] }},
+}
+
+// dateNamesVar adds a package-level map from each name element of a layout to a
+// table of the names of lang that element reads, capitalized and in lower case,
+// and returns its name. The tables give the English names time.Parse expects,
+// and map English names to themselves so that English input still fills its
+// element of the layout.
+func dateNamesVar(ctx *CodeGenContext, lang string) string {
+	var elems []string
+	for _, e := range dateNameElements {
+		local, english := e.Names(localizedDateNames[lang]), e.Names(englishDateNames)
+		var lines []string
+		seen := make(map[string]bool)
+		for i, to := range english {
+			var entries []string
+			for _, from := range []string{local[i], to} {
+				for _, key := range []string{capitalize(from), strings.ToLower(from)} {
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					entries = append(entries, strconv.Quote(key)+": "+strconv.Quote(to))
+				}
+			}
+			lines = append(lines, strings.Join(entries, ", "))
+		}
+		elems = append(elems, fmt.Sprintf("%q: {\n\t\t%s,\n\t}", e.Elem, strings.Join(lines, ",\n\t\t")))
+	}
+	return ctx.AddHelperVar(lang+"DateNames", fmt.Sprintf(" = map[string]map[string]string{\n\t%s,\n}", strings.Join(elems, ",\n\t")))
+}
+
+// dateNamesHelper turns the names of value into English by their position: the
+// n-th name is looked up in the table of the n-th name element of layout. A
+// name that several elements read, like Spanish "mar" (martes and marzo) or
+// French "juin" (full and abbreviated), becomes the English name of the
+// element it stands for. Names may contain hyphens (Portuguese
+// "segunda-feira"); words that are not names of the element are kept.
+const dateNamesHelper = `(layout, value string, names map[string]map[string]string) string {
+	var tables []map[string]string
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+		short := len(rest) == 3 || len(rest) > 3 && !('a' <= rest[3] && rest[3] <= 'z')
+		var elem string
+		switch {
+		case strings.HasPrefix(rest, "January"):
+			elem = "January"
+		case strings.HasPrefix(rest, "Monday"):
+			elem = "Monday"
+		case strings.HasPrefix(rest, "Jan") && short:
+			elem = "Jan"
+		case strings.HasPrefix(rest, "Mon") && short:
+			elem = "Mon"
+		default:
+			continue
+		}
+		tables = append(tables, names[elem])
+		i += len(elem) - 1
+	}
+
+	var b strings.Builder
+	inWord := false
+	i := 0
+	for i < len(value) && len(tables) > 0 {
+		r, size := utf8.DecodeRuneInString(value[i:])
+		if !unicode.IsLetter(r) || inWord {
+			inWord = unicode.IsLetter(r)
+			b.WriteString(value[i : i+size])
+			i += size
+			continue
+		}
+		end := i
+		for end < len(value) {
+			c, n := utf8.DecodeRuneInString(value[end:])
+			if !unicode.IsLetter(c) && c != '-' {
+				break
+			}
+			end += n
+		}
+		for word := value[i:end]; ; {
+			if name, ok := tables[0][word]; ok {
+				b.WriteString(name)
+				i += len(word)
+				tables = tables[1:]
+				break
+			}
+			cut := strings.LastIndexByte(word, '-')
+			if cut < 0 {
+				inWord = true
+				break
+			}
+			word = word[:cut]
+		}
+	}
+	b.WriteString(value[i:])
+	return b.String()
+}`
+
+// dateNamesCall returns the expression that turns the names in value into
+// English for layout, a Go expression of the layout, adding the names of lang
+// and the helper
+func dateNamesCall(ctx *CodeGenContext, lang, layout, value string) string {
+	names := dateNamesVar(ctx, lang)
+	ctx.AddImport("strings", "strings")
+	ctx.AddImport("unicode", "unicode")
+	ctx.AddImport("unicode/utf8", "utf8")
+	helper := ctx.AddHelperFunc("translateDateNames", dateNamesHelper)
+	return fmt.Sprintf("%s(%s, %s, %s)", helper, layout, value, names)
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,224 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// houpPkgPath is the runtime package generated code imports for structured errors
+const houpPkgPath = "github.com/n10ty/houp"
+
+// failureContext is the rule whose code is being generated, whose failures are
+// errors of the field. Value is the expression of the validated value, the field or
+// a loop variable, Label names it in messages, and LabelArgs are the values of the
+// label's verbs, e.g. the index of Tags[%d].
+type failureContext struct {
+	Rule      ValidationRule
+	Value     string
+	Label     string
+	LabelArgs []string
+}
+
+// generateRule generates the code of rule for field, whose failures report fail
+func generateRule(ctx *CodeGenContext, rule ValidationRule, field *FieldInfo, fail *failureContext) (string, error) {
+	prev := ctx.Failure
+	ctx.Failure = fail
+	defer func() { ctx.Failure = prev }()
+	return rule.Generate(ctx, field)
+}
+
+// fieldError completes check, a failure of the rule ctx generates code for. With
+// the structured error mode the error becomes the Err of a houp.FieldError. A custom
+// message set in the tag, or a message template of the options, then replaces the
+// message, and with --i18n the error is built by houp.Errorf with a message key.
+// Messages naming the label take the values of its verbs first.
+func fieldError(ctx *CodeGenContext, check checkData) checkData {
+	fail := ctx.Failure
+	if fail == nil {
+		return check
+	}
+	if ctx.Field != nil {
+		if ctx.Options.ErrorMode == "structured" {
+			check.Field = &fieldErrorData{
+				Package:  ctx.AddImport(houpPkgPath, "houp"),
+				Struct:   ctx.Struct.Name,
+				Name:     ctx.Field.Name,
+				JSONName: jsonFieldName(ctx.Field),
+				Rule:     fail.Rule.Name(),
+				Param:    ruleParam(fail.Rule),
+				Value:    fail.Value,
+			}
+		}
+		if call, message, ok := messageCall(ctx, fail); ok {
+			check.Call, check.Message, check.Args = call, message, nil
+		}
+		if len(ctx.Options.Locales) > 0 {
+			check.Call = ctx.AddImport(houpPkgPath, "houp") + ".Errorf"
+			check.Key = messageKey(ctx, fail, check.Message)
+		}
+	}
+	if len(fail.LabelArgs) > 0 && strings.Contains(check.Message, fail.Label) {
+		check.Args = append(append([]string(nil), fail.LabelArgs...), check.Args...)
+	}
+	return check
+}
+
+// messageCall returns the call and message that build the error of the failing rule
+// in place of the built-in message: errors.New with the custom message of the tag,
+// or fmt.Errorf with the message template for the rule. Dives keep wrapping the
+// errors of nested structs, which carry their own messages. The message is escaped
+// for a string literal.
+func messageCall(ctx *CodeGenContext, fail *failureContext) (string, string, bool) {
+	if _, ok := fail.Rule.(*DiveRule); ok {
+		return "", "", false
+	}
+	if message, ok := customMessage(ctx.Field, fail.Rule); ok {
+		// Translatable messages are formats
+		if len(ctx.Options.Locales) > 0 {
+			return "fmt.Errorf", literal(strings.ReplaceAll(message, "%", "%%")), true
+		}
+		return ctx.AddImport("errors", "errors") + ".New", literal(message), true
+	}
+
+	// Only labels naming the field, e.g. Tags[%d], fill a template
+	template, ok := ctx.Options.MessageTemplates[fail.Rule.Name()]
+	if !ok || !strings.HasPrefix(fail.Label, ctx.Field.Name) {
+		return "", "", false
+	}
+	return "fmt.Errorf", literal(renderTemplate(template, fail.Label, ruleParam(fail.Rule))), true
+}
+
+// literal escapes s for a Go string literal, without the quotes
+func literal(s string) string {
+	quoted := strconv.Quote(s)
+	return quoted[1 : len(quoted)-1]
+}
+
+// messageKey returns the key of the translatable message, e.g.
+// "Signup.Tags[].max", of a failure with message. The key is the struct, the label
+// without its verbs and the rule; messages of the same rule that differ get
+// numbered keys.
+func messageKey(ctx *CodeGenContext, fail *failureContext, message string) string {
+	// Labels not naming the field key the message by the field
+	name := labelVerbs.Replace(fail.Label)
+	if !strings.HasPrefix(fail.Label, ctx.Field.Name) {
+		name = ctx.Field.Name
+	}
+	base := ctx.Struct.Name + "." + name + "." + fail.Rule.Name()
+	if ctx.MessageKeys == nil {
+		ctx.MessageKeys = make(map[string]string)
+	}
+	key := base
+	for n := 2; ctx.MessageKeys[key] != "" && ctx.MessageKeys[key] != message; n++ {
+		key = fmt.Sprintf("%s.%d", base, n)
+	}
+	ctx.MessageKeys[key] = message
+	return key
+}
+
+// labelVerbs removes the verbs of dive element labels, e.g. Tags[%d] becomes Tags[]
+var labelVerbs = strings.NewReplacer("%d", "", "%q", "", "%v", "", "%s", "")
+
+// customMessage returns the message the tag of field sets for rule: the one given
+// with rule~message, or else the errmsg tag of the field
+func customMessage(field *FieldInfo, rule ValidationRule) (string, bool) {
+	if message, ok := field.Messages[rule.Name()]; ok {
+		return message, true
+	}
+	return field.Message, field.Message != ""
+}
+
+// renderTemplate fills the %s verbs of a message template with the field label and
+// the rule parameter, in that order. The result is a fmt.Errorf format: the label
+// may hold the verbs of a dive element's index.
+func renderTemplate(template, label, param string) string {
+	values := []string{label, strings.ReplaceAll(param, "%", "%%")}
+	parts := strings.SplitN(template, "%s", len(values)+1)
+
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString(values[i-1])
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// checkMessageTemplate reports an error if template uses verbs other than the %s of
+// the field label and the rule parameter, and %% for a percent sign
+func checkMessageTemplate(template string) error {
+	verbs := 0
+	for i := 0; i < len(template); i++ {
+		if template[i] != '%' {
+			continue
+		}
+		i++
+		switch {
+		case i < len(template) && template[i] == '%':
+		case i < len(template) && template[i] == 's':
+			verbs++
+		default:
+			return fmt.Errorf("message template %q may only use %%s and %%%%", template)
+		}
+	}
+	if verbs > 2 {
+		return fmt.Errorf("message template %q has %d %%s verbs, at most 2 (field, parameter)", template, verbs)
+	}
+	return nil
+}
+
+// jsonFieldName returns the name of field in JSON: the name of its json tag, or
+// its Go name when the tag has none or skips the field
+func jsonFieldName(field *FieldInfo) string {
+	name, _, _ := strings.Cut(field.JSONName, ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// ruleParam returns the parameter of rule as it was written in the tag, or "" for
+// rules without one
+func ruleParam(rule ValidationRule) string {
+	switch r := rule.(type) {
+	case *MinRule:
+		return r.Value
+	case *MaxRule:
+		return r.Value
+	case *LenRule:
+		return r.Value
+	case *GTRule:
+		return r.Value
+	case *GTERule:
+		return r.Value
+	case *LTRule:
+		return r.Value
+	case *LTERule:
+		return r.Value
+	case *EqFieldRule:
+		return r.OtherField
+	case *FieldCompareRule:
+		return r.OtherField
+	case *RequiredWithoutRule:
+		return r.OtherField
+	case *PostcodeRule:
+		return r.CountryField
+	case *OneOfRule:
+		return strings.Join(r.Values, " ")
+	case *SubsetOfRule:
+		return strings.Join(r.Values, " ")
+	case *UniqueRule:
+		return strings.Join(r.FieldNames, "+")
+	case *DateTimeRule:
+		return strings.Join(r.Formats, "|")
+	case *RegexpRule:
+		return r.VarName
+	case *JSONOfRule:
+		return r.TypeName
+	case *CustomRule:
+		return r.FuncName
+	}
+	return ""
+}
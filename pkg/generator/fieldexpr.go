@@ -0,0 +1,329 @@
+package generator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strings"
+)
+
+// FieldExpr is how generated code refers to a field of the struct being validated.
+// Rules build their expressions from it instead of formatting receiver and field
+// names themselves, so pointer fields are dereferenced the same way everywhere.
+type FieldExpr struct {
+	Ref     string   // the field itself, e.g. "u.Price"
+	Type    TypeInfo // resolved type of the field
+	Elem    TypeInfo // type of the value: the pointee of a pointer field, Type otherwise
+	Pointer bool     // the field is a pointer that Value dereferences
+}
+
+// Receiver returns the receiver variable of the generated methods of the current
+// struct: the one of its //validate:receiver= comment or of its own methods, else
+// the --receiver-name option, else one derived from the struct name
+func (ctx *CodeGenContext) Receiver() string {
+	if ctx.Struct.Receiver != "" {
+		return ctx.Struct.Receiver
+	}
+	if ctx.Options != nil && ctx.Options.ReceiverName != "" {
+		return ctx.Options.ReceiverName
+	}
+	return defaultReceiver(ctx.Struct.Name)
+}
+
+// MethodName returns the name of the generated validation method of the current
+// struct, Validate unless renamed
+func (ctx *CodeGenContext) MethodName() string {
+	return methodName(ctx.Struct, ctx.Options)
+}
+
+// ReceiverDecl returns the receiver of the generated methods of the current struct,
+// e.g. "u *User", or "u User" with the value receiver mode
+func (ctx *CodeGenContext) ReceiverDecl() string {
+	if ctx.Options.ReceiverMode == "value" {
+		return ctx.Receiver() + " " + ctx.ReceiverType()
+	}
+	return ctx.Receiver() + " *" + ctx.ReceiverType()
+}
+
+// ReceiverPointer returns a pointer to the receiver, e.g. for struct validators,
+// which take *T in both receiver modes
+func (ctx *CodeGenContext) ReceiverPointer() string {
+	if ctx.Options.ReceiverMode == "value" {
+		return "&" + ctx.Receiver()
+	}
+	return ctx.Receiver()
+}
+
+// ReceiverValue returns the value of the receiver
+func (ctx *CodeGenContext) ReceiverValue() string {
+	if ctx.Options.ReceiverMode == "value" {
+		return ctx.Receiver()
+	}
+	return "*" + ctx.Receiver()
+}
+
+// ReceiverType returns the receiver type of the generated methods of the current
+// struct: its name, followed by its type parameters for generic structs, e.g. "Page[T]"
+func (ctx *CodeGenContext) ReceiverType() string {
+	if ctx.Struct.TypeSpec == nil || ctx.Struct.TypeSpec.TypeParams == nil {
+		return ctx.Struct.Name
+	}
+	params := ctx.Struct.TypeSpec.TypeParams
+	var names []string
+	for _, field := range params.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return ctx.Struct.Name + "[" + strings.Join(names, ", ") + "]"
+}
+
+// FieldRef returns the expression of the value field holds: the field of the
+// receiver, or the loop variable of a dive element
+func (ctx *CodeGenContext) FieldRef(field *FieldInfo) string {
+	if field.Ref != "" {
+		return field.Ref
+	}
+	return ctx.Receiver() + "." + field.Name
+}
+
+// FieldExpr resolves a field of the current struct
+func (ctx *CodeGenContext) FieldExpr(field *FieldInfo) FieldExpr {
+	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+	expr := FieldExpr{
+		Ref:  ctx.FieldRef(field),
+		Type: typeInfo,
+		Elem: typeInfo,
+	}
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		expr.Elem = *typeInfo.Elem
+		expr.Pointer = true
+	}
+	return expr
+}
+
+// Value returns the field's value, dereferenced for pointer fields
+func (e FieldExpr) Value() string {
+	if e.Pointer {
+		return "*" + e.Ref
+	}
+	return e.Ref
+}
+
+// Operand returns the field's value in a form that a selector or method call can
+// follow, e.g. "(*u.Amount).Float64()"
+func (e FieldExpr) Operand() string {
+	if e.Pointer {
+		return "(*" + e.Ref + ")"
+	}
+	return e.Ref
+}
+
+// StringValue returns the field's value as a string expression, converting custom
+// string types and byte slices. It fails for fields that are neither a string, a
+// []byte nor a pointer to one.
+func (e FieldExpr) StringValue(ruleName string) (string, error) {
+	if e.Elem.IsBytes() {
+		return fmt.Sprintf("string(%s)", e.Value()), nil
+	}
+	if e.Elem.Kind != TypeString {
+		return "", fmt.Errorf("%s validation only applicable to string types", ruleName)
+	}
+	if e.Elem.Name != "" && e.Elem.Name != "string" {
+		return fmt.Sprintf("string(%s)", e.Value()), nil
+	}
+	return e.Value(), nil
+}
+
+// numericBoundCheck generates "if value op bound { return error }" for a numeric or
+// json.Number field. json.Number is read through Float64 and floats are guarded
+// against NaN; message completes "field X must be ...". Bounds outside the range of
+// the field's type are rejected, as the comparison would not compile.
+func numericBoundCheck(ctx *CodeGenContext, field *FieldInfo, rule ValidationRule, op, bound, message string) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if expr.Elem.IsCmpNumber() {
+		return cmpBoundCheck(ctx, field, rule, op, bound, message)
+	}
+	if err := checkBoundRange(expr.Elem, bound); err != nil {
+		return "", fmt.Errorf("%s validation on field %s: %w", rule.Name(), field.Name, err)
+	}
+
+	if expr.Elem.Kind == TypeJSONNumber {
+		// Use unique names for the value and its error to avoid redeclaration and shadowing
+		varName := ctx.LocalVarName(field, rule.Name(), field.identName()+"Float")
+		errName := varName + "Err"
+		return renderChecks(ctx, []string{fmt.Sprintf("%s, %s := %s.Float64()", varName, errName, expr.Operand())},
+			checkData{Cond: errName + " != nil", Message: fmt.Sprintf("field %s must be a valid number: %%w", field.Name), Args: []string{errName}},
+			checkData{Cond: fmt.Sprintf("%s%s %s %s", nanGuard(ctx, expr.Elem, varName), varName, op, bound), Message: fmt.Sprintf("field %s must be %s", field.Name, message)},
+		)
+	}
+
+	ref := expr.Value()
+	return renderCheck(ctx, fmt.Sprintf("%s%s %s %s", nanGuard(ctx, expr.Elem, ref), ref, op, bound), fmt.Sprintf("field %s must be %s", field.Name, message))
+}
+
+// cmpBoundCheck generates "if value.Cmp(bound) op 0 { return error }" for a big.Int,
+// big.Float, big.Rat or decimal.Decimal field. big.Rat and decimal.Decimal bounds are
+// exact, e.g. big.NewRat(1, 10) and decimal.New(1, -1) for 0.1; big.Int, big.Rat and
+// decimal.Decimal bounds must fit in an int64.
+func cmpBoundCheck(ctx *CodeGenContext, field *FieldInfo, rule ValidationRule, op, bound, message string) (string, error) {
+	expr := ctx.FieldExpr(field)
+	v, err := parseNumericBound(bound)
+	if err != nil {
+		return "", fmt.Errorf("%s validation on field %s: %w", rule.Name(), field.Name, err)
+	}
+
+	if expr.Elem.IsDecimal() {
+		value, err := decimalBound(ctx, v, bound)
+		if err != nil {
+			return "", fmt.Errorf("%s validation on field %s: %w", rule.Name(), field.Name, err)
+		}
+		return renderCheck(ctx, fmt.Sprintf("%s.Cmp(%s) %s 0", expr.Ref, value, op), fmt.Sprintf("field %s must be %s", field.Name, message))
+	}
+
+	alias := ctx.AddImport("math/big", "big")
+	var value string
+	switch expr.Elem.BigNumber() {
+	case "Int":
+		n, exact := constant.Int64Val(constant.ToInt(v))
+		if !exact {
+			return "", fmt.Errorf("%s validation on field %s: %s does not fit in an int64", rule.Name(), field.Name, bound)
+		}
+		value = fmt.Sprintf("%s.NewInt(%d)", alias, n)
+	case "Float":
+		value = fmt.Sprintf("%s.NewFloat(%s)", alias, bound)
+	case "Rat":
+		num, numExact := constant.Int64Val(constant.Num(v))
+		denom, denomExact := constant.Int64Val(constant.Denom(v))
+		if !numExact || !denomExact {
+			return "", fmt.Errorf("%s validation on field %s: %s does not fit in an int64 fraction", rule.Name(), field.Name, bound)
+		}
+		value = fmt.Sprintf("%s.NewRat(%d, %d)", alias, num, denom)
+	}
+
+	return renderCheck(ctx, fmt.Sprintf("%s.Cmp(%s) %s 0", expr.Ref, value, op), fmt.Sprintf("field %s must be %s", field.Name, message))
+}
+
+// decimalBound returns the decimal.Decimal of bound, whose value is v:
+// decimal.NewFromInt for whole numbers, and decimal.New with the digits and an
+// exponent otherwise, e.g. decimal.New(1005, -1) for 100.5. The exponent is
+// positive for whole numbers too large for an int64, such as 2.5e19. Scientific
+// notation is normalized first, so 1e6 and 1000000 give the same bound.
+func decimalBound(ctx *CodeGenContext, v constant.Value, bound string) (string, error) {
+	ten, zero := constant.MakeInt64(10), constant.MakeInt64(0)
+	tooLarge := func(c constant.Value) bool {
+		return constant.Compare(c, token.GTR, constant.MakeInt64(math.MaxInt64)) ||
+			constant.Compare(c, token.LSS, constant.MakeInt64(math.MinInt64))
+	}
+
+	coef, exp := v, 0
+	for constant.ToInt(coef).Kind() != constant.Int {
+		if tooLarge(coef) {
+			return "", fmt.Errorf("%s does not fit in an int64 with its decimals", bound)
+		}
+		coef = constant.BinaryOp(coef, token.MUL, ten)
+		exp--
+	}
+	coef = constant.ToInt(coef)
+	// Trailing zeros of a whole number too large for an int64 move into the exponent
+	for tooLarge(coef) && constant.Compare(constant.BinaryOp(coef, token.REM, ten), token.EQL, zero) {
+		coef = constant.BinaryOp(coef, token.QUO_ASSIGN, ten)
+		exp++
+	}
+	n, exact := constant.Int64Val(coef)
+	if !exact {
+		return "", fmt.Errorf("%s does not fit in an int64 with its decimals", bound)
+	}
+
+	alias := ctx.AddImport(decimalPkgPath, "decimal")
+	if exp == 0 {
+		return fmt.Sprintf("%s.NewFromInt(%d)", alias, n), nil
+	}
+	return fmt.Sprintf("%s.New(%d, %d)", alias, n, exp), nil
+}
+
+// boundSizes gives the width of int, uint and uintptr when checking bounds: 64 bits
+var boundSizes = types.SizesFor("gc", "amd64")
+
+// checkBoundRange fails if bound, as formatted by formatNumericBound, is outside the
+// range of the integer or float type underlying t, such as 300 for a uint8 or a
+// type Level uint8, or if a float bound other than zero rounds to zero. json.Number
+// is compared as a float64.
+func checkBoundRange(t TypeInfo, bound string) error {
+	var basic *types.Basic
+	switch {
+	case t.Kind == TypeJSONNumber:
+		basic = types.Typ[types.Float64]
+	case t.GoType != nil:
+		basic, _ = t.GoType.Underlying().(*types.Basic)
+	}
+	if basic == nil {
+		return nil
+	}
+	v, err := parseNumericBound(bound)
+	if err != nil {
+		return nil
+	}
+
+	switch {
+	case basic.Info()&types.IsInteger != 0:
+		bits := uint(boundSizes.Sizeof(basic) * 8)
+		one := constant.MakeInt64(1)
+		var lo, hi constant.Value
+		if basic.Info()&types.IsUnsigned != 0 {
+			lo = constant.MakeInt64(0)
+			hi = constant.BinaryOp(constant.Shift(one, token.SHL, bits), token.SUB, one)
+		} else {
+			hi = constant.BinaryOp(constant.Shift(one, token.SHL, bits-1), token.SUB, one)
+			lo = constant.BinaryOp(constant.UnaryOp(token.SUB, hi, 0), token.SUB, one)
+		}
+		if constant.Compare(v, token.LSS, lo) || constant.Compare(v, token.GTR, hi) {
+			return fmt.Errorf("%s overflows %s", bound, basic.Name())
+		}
+	case basic.Info()&types.IsFloat != 0:
+		// Round as the compiler does when converting the constant to the field's type
+		f, _ := constant.Float64Val(v)
+		if basic.Kind() == types.Float32 {
+			f32, _ := constant.Float32Val(v)
+			f = float64(f32)
+		}
+		if math.IsInf(f, 0) {
+			return fmt.Errorf("%s overflows %s", bound, basic.Name())
+		}
+		if f == 0 && constant.Sign(v) != 0 {
+			return fmt.Errorf("%s underflows %s", bound, basic.Name())
+		}
+	}
+	return nil
+}
+
+// timeBoundNow is the bound of gt, gte, lt and lte that compares a time.Time with the
+// current time
+const timeBoundNow = "now"
+
+// validateTimeBound checks the field type of a rule comparing against the current
+// time. Pointers are resolved at generation time.
+func validateTimeBound(ruleName string, fieldType TypeInfo) error {
+	if !fieldType.IsTime() && fieldType.Kind != TypePointer {
+		return fmt.Errorf("%s validation only applicable to time.Time", ruleName)
+	}
+	return nil
+}
+
+// timeBoundCheck generates "if cond { return error }" comparing a time.Time or
+// *time.Time field with time.Now(). cond has a %s for the field's value; message
+// completes "field X must be ...". Only the "now" bound is supported for times.
+func timeBoundCheck(ctx *CodeGenContext, field *FieldInfo, ruleName, bound, cond, message string) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if !expr.Elem.IsTime() {
+		return "", fmt.Errorf("%s validation on field %s: %s=now only applicable to time.Time", ruleName, field.Name, ruleName)
+	}
+	if bound != timeBoundNow {
+		return "", fmt.Errorf("%s validation on field %s: time.Time can only be compared with now, got %q", ruleName, field.Name, bound)
+	}
+
+	ctx.AddImport("time", "time")
+	return renderCheck(ctx, fmt.Sprintf(cond, expr.Operand()), fmt.Sprintf("field %s must be %s", field.Name, message))
+}
@@ -0,0 +1,218 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// generateFreeze appends ValidateAndFreeze to a struct marked //validate:freeze. It
+// deep-copies the struct, validates the copy and returns it, so that hot-reload
+// code can swap in a config that nobody else holds a reference to. Pointers,
+// slices, maps and arrays are copied; interfaces, funcs, channels and structs from
+// other packages (such as time.Time) are copied by assignment.
+func generateFreeze(ctx *CodeGenContext) error {
+	if ctx.Struct.TypeSpec.TypeParams != nil {
+		return fmt.Errorf("freeze does not support generic structs")
+	}
+	if ctx.TypesInfo == nil {
+		return fmt.Errorf("freeze requires type information")
+	}
+	obj, ok := ctx.TypesInfo.Defs[ctx.Struct.TypeSpec.Name].(*types.TypeName)
+	if !ok {
+		return fmt.Errorf("type of %s is unknown", ctx.Struct.Name)
+	}
+
+	c := &cloner{ctx: ctx, funcs: make(map[*types.TypeName]string)}
+	clone := c.structFunc(obj.Type().(*types.Named))
+
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) ValidateAndFreeze() (*%s, error) {", ctx.ReceiverDecl(), ctx.Struct.Name),
+		fmt.Sprintf("\tfrozen := %s(%s)", clone, ctx.ReceiverValue()),
+		fmt.Sprintf("\tif err := frozen.%s(); err != nil {", ctx.MethodName()),
+		"\t\treturn nil, err",
+		"\t}",
+		"\treturn &frozen, nil",
+		"}")
+	return nil
+}
+
+// freezeStub is the ValidateAndFreeze of a struct whose Validate method could not be generated
+func freezeStub(ctx *CodeGenContext) {
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) ValidateAndFreeze() (*%s, error) {", ctx.ReceiverDecl(), ctx.Struct.Name),
+		fmt.Sprintf("\treturn nil, %s.%s()", receiverVar, ctx.MethodName()),
+		"}")
+}
+
+// cloner generates the deep copy helpers of ValidateAndFreeze: one clone function
+// per struct type of the current package, with the copying of pointers, slices and
+// maps inlined
+type cloner struct {
+	ctx   *CodeGenContext
+	funcs map[*types.TypeName]string // clone functions generated or being generated
+}
+
+// structFunc returns the clone function of a struct type declared in the current
+// package, generating it first if needed. Recursive types call their own function.
+func (c *cloner) structFunc(named *types.Named) string {
+	obj := named.Obj()
+	if name, ok := c.funcs[obj]; ok {
+		return name
+	}
+	helper := "clone" + capitalize(obj.Name())
+	name := helper
+	if c.ctx.FilePrefix != "" {
+		name = c.ctx.FilePrefix + "_" + helper
+	}
+	c.funcs[obj] = name
+
+	typeString := qualifiedTypeString(c.ctx, named)
+	body := []string{"\tout := v"}
+	for _, line := range c.copyStruct(named.Underlying().(*types.Struct), "out", "v", 0) {
+		body = append(body, "\t"+line)
+	}
+	body = append(body, "\treturn out", "}")
+	return c.ctx.AddHelperFunc(helper, fmt.Sprintf("(v %s) %s {\n%s", typeString, typeString, strings.Join(body, "\n")))
+}
+
+// copyStruct deep-copies the fields of src into dst, which already holds a shallow copy
+func (c *cloner) copyStruct(s *types.Struct, dst, src string, depth int) []string {
+	var lines []string
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		if f.Name() == "_" {
+			continue
+		}
+		lines = append(lines, c.copyValue(f.Type(), dst+"."+f.Name(), src+"."+f.Name(), depth)...)
+	}
+	return lines
+}
+
+// copyValue returns statements that replace dst, a shallow copy of src, with a deep
+// copy. It returns nothing when assignment already copies the type. depth numbers
+// the loop variables of nested copies.
+func (c *cloner) copyValue(t types.Type, dst, src string, depth int) []string {
+	if !c.needsCopy(t, make(map[*types.Named]bool)) {
+		return nil
+	}
+	if named, ok := t.(*types.Named); ok && c.isLocalStruct(named) {
+		return []string{fmt.Sprintf("%s = %s(%s)", dst, c.structFunc(named), src)}
+	}
+
+	v := fmt.Sprintf("v%d", depth)
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		lines := []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("\t%s := *%s", v, src),
+		}
+		lines = append(lines, indentLines(c.copyValue(u.Elem(), v, v, depth+1))...)
+		return append(lines, fmt.Sprintf("\t%s = &%s", dst, v), "}")
+
+	case *types.Slice:
+		i := fmt.Sprintf("i%d", depth)
+		target, declare := copyTarget(dst, src, depth)
+		lines := []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("\t%s %s make(%s, len(%s))", target, declare, qualifiedTypeString(c.ctx, t), src),
+			fmt.Sprintf("\tcopy(%s, %s)", target, src),
+		}
+		if elem := c.copyValue(u.Elem(), target+"["+i+"]", src+"["+i+"]", depth+1); len(elem) > 0 {
+			lines = append(lines, fmt.Sprintf("\tfor %s := range %s {", i, src))
+			lines = append(lines, indentLines(indentLines(elem))...)
+			lines = append(lines, "\t}")
+		}
+		if target != dst {
+			lines = append(lines, fmt.Sprintf("\t%s = %s", dst, target))
+		}
+		return append(lines, "}")
+
+	case *types.Map:
+		k := fmt.Sprintf("k%d", depth)
+		target, declare := copyTarget(dst, src, depth)
+		lines := []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("\t%s %s make(%s, len(%s))", target, declare, qualifiedTypeString(c.ctx, t), src),
+			fmt.Sprintf("\tfor %s, %s := range %s {", k, v, src),
+		}
+		lines = append(lines, indentLines(indentLines(c.copyValue(u.Elem(), v, v, depth+1)))...)
+		lines = append(lines, fmt.Sprintf("\t\t%s[%s] = %s", target, k, v), "\t}")
+		if target != dst {
+			lines = append(lines, fmt.Sprintf("\t%s = %s", dst, target))
+		}
+		return append(lines, "}")
+
+	case *types.Array:
+		i := fmt.Sprintf("i%d", depth)
+		lines := []string{fmt.Sprintf("for %s := range %s {", i, src)}
+		lines = append(lines, indentLines(c.copyValue(u.Elem(), dst+"["+i+"]", src+"["+i+"]", depth+1))...)
+		return append(lines, "}")
+
+	case *types.Struct:
+		return c.copyStruct(u, dst, src, depth)
+	}
+	return nil
+}
+
+// copyTarget returns the variable a slice or map copy is built in, and the
+// assignment operator to create it with. That is dst itself, unless dst is also
+// the source, as for the values of a map, in which case it is a new variable.
+func copyTarget(dst, src string, depth int) (string, string) {
+	if dst == src {
+		return fmt.Sprintf("c%d", depth), ":="
+	}
+	return dst, "="
+}
+
+// needsCopy reports whether assignment would leave a value of type t sharing memory
+// with the original that copyValue can copy
+func (c *cloner) needsCopy(t types.Type, seen map[*types.Named]bool) bool {
+	if named, ok := t.(*types.Named); ok {
+		// Structs of other packages may hide their state in unexported fields
+		if !c.isLocalType(named) && isStructType(named) {
+			return false
+		}
+		if seen[named] {
+			return true
+		}
+		seen[named] = true
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		// A pointer to another package's struct, such as *time.Location, is shared
+		named, ok := u.Elem().(*types.Named)
+		return !ok || c.isLocalType(named) || !isStructType(named)
+	case *types.Slice, *types.Map:
+		return true
+	case *types.Array:
+		return c.needsCopy(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if c.needsCopy(u.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLocalStruct reports whether named is a non-generic struct type of the current package
+func (c *cloner) isLocalStruct(named *types.Named) bool {
+	return isStructType(named) && c.isLocalType(named) && named.TypeArgs() == nil
+}
+
+// isLocalType reports whether named is declared in the current package
+func (c *cloner) isLocalType(named *types.Named) bool {
+	pkg := named.Obj().Pkg()
+	return pkg != nil && pkg.Path() == c.ctx.PkgPath
+}
+
+// isStructType reports whether the underlying type of t is a struct
+func isStructType(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
@@ -17,9 +17,30 @@ func Generate(pkgPath string, opts *GenerateOptions) error {
 	if opts.UnknownTagMode == "" {
 		opts.UnknownTagMode = "fail"
 	}
+	if opts.CrossPackageMode == "" {
+		opts.CrossPackageMode = "skip"
+	}
+	if opts.ErrorMode == "" {
+		opts.ErrorMode = "fmt"
+	}
+	if opts.ReceiverMode == "" {
+		opts.ReceiverMode = "pointer"
+	}
+	if err := checkSubpackageOptions(opts); err != nil {
+		return err
+	}
+	if err := checkRuntimeOptions(opts); err != nil {
+		return err
+	}
+	if err := checkHeaderOptions(opts); err != nil {
+		return err
+	}
+	if err := checkBuildTags(opts); err != nil {
+		return err
+	}
 
 	// Parse the package
-	pkgInfo, err := ParsePackage(pkgPath)
+	pkgInfo, err := parsePackage(pkgPath, opts.IncludeTests, hostEnv(opts))
 	if err != nil {
 		return fmt.Errorf("failed to parse package: %w", err)
 	}
@@ -28,15 +49,21 @@ func Generate(pkgPath string, opts *GenerateOptions) error {
 		return fmt.Errorf("no Go files found in package %s", pkgPath)
 	}
 
-	// Generate validation code for the entire package
-	code, err := GeneratePackageValidation(pkgInfo, opts)
-	if err != nil {
+	// Generate validation code for the entire package. With KeepGoing, structs
+	// that fail are collected and reported once all files are written.
+	var failures GenerationErrors
+	regexps := make(packageRegexps)
+	code, err := generatePackageFile(pkgInfo, opts, false, "", regexps)
+	if err = collectFailures(err, &failures); err != nil {
 		return fmt.Errorf("failed to generate validation for package %s: %w", pkgInfo.Name, err)
 	}
 
-	if code == "" {
-		fmt.Println("No validation code generated (no structs with validation tags found)")
-		return nil
+	var testCode string
+	if opts.IncludeTests {
+		testCode, err = generatePackageFile(pkgInfo, opts, true, "", regexps)
+		if err = collectFailures(err, &failures); err != nil {
+			return fmt.Errorf("failed to generate test validation for package %s: %w", pkgInfo.Name, err)
+		}
 	}
 
 	// Determine output filename: validation.gen.go in the package directory
@@ -47,9 +74,113 @@ func Generate(pkgPath string, opts *GenerateOptions) error {
 		break
 	}
 
-	outputName := "validation.gen.go"
-	outputPath := filepath.Join(pkgDir, outputName)
+	// Structs in build-constrained files (name_linux.go, //go:build ...) may be declared
+	// once per platform. Each constraint is loaded under a matching configuration and
+	// generated into its own file with the same constraint.
+	constrained, err := generateConstrained(pkgPath, pkgDir, opts, regexps, &failures)
+	if err != nil {
+		return err
+	}
+
+	if code == "" && testCode == "" && len(constrained) == 0 {
+		fmt.Println("No validation code generated (no structs with validation tags found)")
+		return nil
+	}
+
+	// In subpackage mode the files go to a directory of their own
+	outDir := outputDir(pkgDir, opts)
+	if outDir != pkgDir && !opts.DryRun {
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", outDir, err)
+		}
+	}
+
+	if code != "" {
+		if err := writeGeneratedFile(filepath.Join(outDir, "validation.gen.go"), code, opts); err != nil {
+			return err
+		}
+	}
+
+	for _, out := range constrained {
+		if err := writeGeneratedFile(filepath.Join(outDir, constrainedFileName(out.Constraint)), out.Code, opts); err != nil {
+			return err
+		}
+	}
+
+	// Test structs go to a _test.go file so they are only compiled with the tests
+	if testCode != "" {
+		if err := writeGeneratedFile(filepath.Join(outDir, "validation.gen_test.go"), testCode, opts); err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Locales) > 0 {
+		codes := []string{code, testCode}
+		for _, out := range constrained {
+			codes = append(codes, out.Code)
+		}
+		messages, err := extractMessages(codes...)
+		if err != nil {
+			return fmt.Errorf("failed to collect messages of package %s: %w", pkgInfo.Name, err)
+		}
+		if err := writeCatalogs(outDir, messages, opts); err != nil {
+			return err
+		}
+	}
+
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
+
+// constrainedOutput is the generated code for the files sharing one build constraint
+type constrainedOutput struct {
+	Constraint string
+	Code       string
+}
+
+// generateConstrained returns the generated code for each build constraint used by
+// the package's non-test files, ordered by constraint. Constraints whose files have
+// nothing to validate are left out. The files use the regexp variables of the
+// unconstrained file. Struct failures tolerated by KeepGoing are added to failures.
+func generateConstrained(pkgPath, pkgDir string, opts *GenerateOptions, regexps packageRegexps, failures *GenerationErrors) ([]constrainedOutput, error) {
+	constraints, err := scanConstraints(pkgDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read build constraints: %w", err)
+	}
+
+	var generated []constrainedOutput
+	for _, expr := range constraints {
+		// //go:build ignore marks files that are never part of the package
+		if expr == "ignore" {
+			continue
+		}
+
+		env, err := constraintEnv(expr, opts.BuildTags)
+		if err != nil {
+			return nil, err
+		}
+		pkgInfo, err := parsePackage(pkgPath, false, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse package for build constraint %q: %w", expr, err)
+		}
+
+		code, err := generatePackageFile(pkgInfo, opts, false, expr, regexps)
+		if err = collectFailures(err, failures); err != nil {
+			return nil, fmt.Errorf("failed to generate validation for build constraint %q: %w", expr, err)
+		}
+		if code != "" {
+			generated = append(generated, constrainedOutput{Constraint: expr, Code: code})
+		}
+	}
+	return generated, nil
+}
 
+// writeGeneratedFile writes generated code to outputPath, honoring the Overwrite and DryRun options.
+// A file that already holds code is left alone, so its modification time only changes
+// with its content and go generate does not trigger rebuilds of unchanged packages.
+func writeGeneratedFile(outputPath, code string, opts *GenerateOptions) error {
 	// Check if file exists and we shouldn't overwrite
 	if !opts.Overwrite {
 		if _, err := os.Stat(outputPath); err == nil {
@@ -58,6 +189,11 @@ func Generate(pkgPath string, opts *GenerateOptions) error {
 		}
 	}
 
+	if existing, err := os.ReadFile(outputPath); err == nil && string(existing) == code {
+		fmt.Printf("Unchanged: %s\n", outputPath)
+		return nil
+	}
+
 	// Dry run mode
 	if opts.DryRun {
 		fmt.Printf("Would generate: %s\n", outputPath)
@@ -82,7 +218,27 @@ func GenerateForFiles(files []string, opts *GenerateOptions) error {
 	if opts.UnknownTagMode == "" {
 		opts.UnknownTagMode = "fail"
 	}
+	if opts.CrossPackageMode == "" {
+		opts.CrossPackageMode = "skip"
+	}
+	if opts.ErrorMode == "" {
+		opts.ErrorMode = "fmt"
+	}
+	if opts.ReceiverMode == "" {
+		opts.ReceiverMode = "pointer"
+	}
+	if err := checkRuntimeOptions(opts); err != nil {
+		return err
+	}
+	if err := checkHeaderOptions(opts); err != nil {
+		return err
+	}
+	// Files parsed one by one have no import path to import them with
+	if opts.Subpackage != "" {
+		return fmt.Errorf("subpackage %s is only supported when generating whole packages", opts.Subpackage)
+	}
 
+	var failures GenerationErrors
 	for _, filePath := range files {
 		// Parse single file
 		fileInfo, err := ParseFile(filePath)
@@ -120,7 +276,7 @@ func GenerateForFiles(files []string, opts *GenerateOptions) error {
 
 		// Generate validation code
 		code, err := GenerateFileValidation(fileInfo, pkgName, opts, nil, "")
-		if err != nil {
+		if err = collectFailures(err, &failures); err != nil {
 			return fmt.Errorf("failed to generate validation for file %s (package %s): %w", filePath, pkgName, err)
 		}
 
@@ -134,28 +290,14 @@ func GenerateForFiles(files []string, opts *GenerateOptions) error {
 		outputName := baseName + opts.Suffix + ".go"
 		outputPath := filepath.Join(dir, outputName)
 
-		// Check if file exists
-		if !opts.Overwrite {
-			if _, err := os.Stat(outputPath); err == nil {
-				fmt.Printf("Skipping %s (already exists, use --overwrite to replace)\n", outputPath)
-				continue
-			}
+		if err := writeGeneratedFile(outputPath, code, opts); err != nil {
+			return err
 		}
-
-		// Dry run mode
-		if opts.DryRun {
-			fmt.Printf("Would generate: %s\n", outputPath)
-			continue
-		}
-
-		// Write generated code
-		if err := ioutil.WriteFile(outputPath, []byte(code), 0644); err != nil {
-			return fmt.Errorf("failed to write file %s: %w", outputPath, err)
-		}
-
-		fmt.Printf("Generated: %s\n", outputPath)
 	}
 
+	if len(failures) > 0 {
+		return failures
+	}
 	return nil
 }
 
@@ -172,23 +314,16 @@ func DiscoverStructsWithDive(pkgInfo *PackageInfo) map[string]bool {
 						// Extract type name from field
 						typeInfo := ResolveTypeInfo(field.Type, pkgInfo.TypesInfo)
 
-						var typeName string
-						if typeInfo.IsPointer && typeInfo.Elem != nil {
-							typeName = typeInfo.Elem.Name
-						} else if typeInfo.IsSlice && typeInfo.Elem != nil {
-							if typeInfo.Elem.IsPointer && typeInfo.Elem.Elem != nil {
-								typeName = typeInfo.Elem.Elem.Name
-							} else {
-								typeName = typeInfo.Elem.Name
-							}
-						} else {
-							typeName = typeInfo.Name
-						}
+						typeName := diveTargetName(typeInfo, pkgInfo.TypesInfo)
 
 						if typeName != "" {
 							referenced[typeName] = true
 						}
 					}
+					// jsonof calls Validate on a type of the current package
+					if jsonOf, ok := rule.(*JSONOfRule); ok && (jsonOf.ImportPath == "" || jsonOf.ImportPath == pkgInfo.PkgPath) {
+						referenced[jsonOf.TypeName] = true
+					}
 				}
 			}
 		}
@@ -1,12 +1,26 @@
 package generator
 
 import (
+	"errors"
 	"flag"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/n10ty/houp/internal/testutil"
+	"github.com/n10ty/houp/pkg/isodata"
 )
 
 var update = flag.Bool("update", false, "update golden files")
@@ -51,6 +65,226 @@ func TestGenerateEqField(t *testing.T) {
 	testGenerate(t, "eqfield", "request.go")
 }
 
+func TestGenerateISBN(t *testing.T) {
+	testGenerate(t, "isbn", "isbn.go")
+}
+
+func TestGenerateJSONNumber(t *testing.T) {
+	testGenerate(t, "jsonnumber", "jsonnumber.go")
+}
+
+func TestGenerateISO3166Codes(t *testing.T) {
+	testGenerate(t, "iso3166_codes", "country.go")
+}
+
+func TestGenerateISO639(t *testing.T) {
+	testGenerate(t, "iso639", "language.go")
+}
+
+func TestGenerateEqFieldUsing(t *testing.T) {
+	testGenerate(t, "eqfield_using", "eqfield_using.go")
+}
+
+func TestGenerateBCP47(t *testing.T) {
+	testGenerate(t, "bcp47", "locale.go")
+}
+
+func TestGenerateTimezone(t *testing.T) {
+	testGenerate(t, "timezone", "timezone.go")
+}
+
+func TestGenerateDiveUnique(t *testing.T) {
+	testGenerate(t, "dive_unique", "dive_unique.go")
+}
+
+func TestGenerateSemver(t *testing.T) {
+	testGenerate(t, "semver", "release.go")
+}
+
+func TestGenerateOmitEmptyStruct(t *testing.T) {
+	testGenerate(t, "omitempty_struct", "omitempty_struct.go")
+}
+
+func TestGenerateULID(t *testing.T) {
+	testGenerate(t, "ulid", "ulid.go")
+}
+
+func TestGenerateDiveCrossPackage(t *testing.T) {
+	testGenerate(t, "dive_cross_package/api", "response.go")
+}
+
+func TestGenerateBanking(t *testing.T) {
+	testGenerate(t, "banking", "banking.go")
+}
+
+func TestGeneratePostcode(t *testing.T) {
+	testGenerate(t, "postcode", "postcode.go")
+}
+
+func TestGenerateFinite(t *testing.T) {
+	testGenerate(t, "finite", "finite.go")
+}
+
+func TestGenerateCron(t *testing.T) {
+	testGenerate(t, "cron", "cron.go")
+}
+
+func TestGenerateTrim(t *testing.T) {
+	testGenerate(t, "trim", "trim.go")
+}
+
+func TestGenerateRunes(t *testing.T) {
+	testGenerate(t, "runes", "runes.go")
+}
+
+func TestGenerateDuration(t *testing.T) {
+	testGenerate(t, "duration", "duration.go")
+}
+
+func TestGenerateNumericString(t *testing.T) {
+	testGenerate(t, "numericstring", "numericstring.go")
+}
+
+func TestGenerateBoolean(t *testing.T) {
+	testGenerate(t, "boolean", "boolean.go")
+}
+
+func TestGenerateMongoDB(t *testing.T) {
+	testGenerate(t, "mongodb", "mongodb.go")
+}
+
+func TestGenerateContextValidators(t *testing.T) {
+	testGenerate(t, "context_validators", "context_validators.go")
+}
+
+func TestGenerateUnixTS(t *testing.T) {
+	testGenerate(t, "unixts", "unixts.go")
+}
+
+func TestGenerateMultiValueMaps(t *testing.T) {
+	testGenerate(t, "multivalue", "multivalue.go")
+}
+
+func TestGeneratePrintable(t *testing.T) {
+	testGenerate(t, "printable", "printable.go")
+}
+
+func TestGenerateDataURI(t *testing.T) {
+	testGenerate(t, "datauri", "datauri.go")
+}
+
+func TestGenerateHash(t *testing.T) {
+	testGenerate(t, "hash", "hash.go")
+}
+
+func TestGenerateMapFields(t *testing.T) {
+	testGenerate(t, "mapfields", "mapfields.go")
+}
+
+func TestGeneratePagination(t *testing.T) {
+	testGenerate(t, "pagination", "pagination.go")
+}
+
+func TestGenerateDiveMaps(t *testing.T) {
+	testGenerate(t, "dive_maps", "dive_maps.go")
+}
+
+func TestGenerateFreeze(t *testing.T) {
+	testGenerate(t, "freeze", "freeze.go")
+}
+
+func TestGenerateMapKeys(t *testing.T) {
+	testGenerate(t, "map_keys", "map_keys.go")
+}
+
+func TestGenerateJSONOf(t *testing.T) {
+	testGenerate(t, "jsonof", "jsonof.go")
+}
+
+func TestGenerateOneOf(t *testing.T) {
+	testGenerate(t, "oneof", "oneof.go")
+}
+
+func TestGenerateTimeBounds(t *testing.T) {
+	testGenerate(t, "time_bounds", "time_bounds.go")
+}
+
+func TestGenerateFieldCompare(t *testing.T) {
+	testGenerate(t, "field_compare", "field_compare.go")
+}
+
+func TestGenerateInlineStructs(t *testing.T) {
+	testGenerate(t, "inline_structs", "inline_structs.go")
+}
+
+func TestGenerateGenerics(t *testing.T) {
+	testGenerate(t, "generics", "generics.go")
+}
+
+func TestGenerateDiveInterfaces(t *testing.T) {
+	testGenerate(t, "dive_interfaces", "dive_interfaces.go")
+}
+
+func TestGenerateNamedTypes(t *testing.T) {
+	testGenerate(t, "named_types", "named_types.go")
+}
+
+func TestGenerateDefinedTypes(t *testing.T) {
+	testGenerate(t, "defined_types", "defined_types.go")
+}
+
+func TestGenerateBigMath(t *testing.T) {
+	testGenerate(t, "bigmath", "bigmath.go")
+}
+
+func TestGenerateDecimal(t *testing.T) {
+	testGenerate(t, "decimal", "decimal.go")
+}
+
+func TestGenerateGoogleUUID(t *testing.T) {
+	testGenerate(t, "google_uuid", "google_uuid.go")
+}
+
+func TestGenerateBytes(t *testing.T) {
+	testGenerate(t, "bytes", "bytes.go")
+}
+
+func TestGenerateNestedDive(t *testing.T) {
+	testGenerate(t, "nested_dive", "nested_dive.go")
+}
+
+func TestGenerateRawJSON(t *testing.T) {
+	testGenerate(t, "rawjson", "rawjson.go")
+}
+
+func TestGenerateSkipFields(t *testing.T) {
+	testGenerate(t, "skip_fields", "skip_fields.go")
+}
+
+func TestGenerateMultiError(t *testing.T) {
+	testGenerate(t, "multierror", "multierror.go")
+}
+
+func TestGenerateCustomMessages(t *testing.T) {
+	testGenerate(t, "custom_messages", "signup.go")
+}
+
+func TestGenerateValidatable(t *testing.T) {
+	testGenerate(t, "validatable", "validatable.go")
+}
+
+func TestGenerateReceivers(t *testing.T) {
+	testGenerate(t, "receivers", "receivers.go")
+}
+
+func TestGenerateGeo(t *testing.T) {
+	testGenerate(t, "geo", "geo.go")
+}
+
+func TestGenerateNumericBounds(t *testing.T) {
+	testGenerate(t, "numericbounds", "numericbounds.go")
+}
+
 func testGenerate(t *testing.T, testDir, inputFile string) {
 	t.Helper()
 
@@ -83,154 +317,1346 @@ func testGenerate(t *testing.T, testDir, inputFile string) {
 	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
 }
 
-func TestUnknownTagFail(t *testing.T) {
-	// Create a temporary test file with unknown tag
-	tmpDir := t.TempDir()
-	testFile := filepath.Join(tmpDir, "test.go")
-
-	content := `package test
+func TestGenerateIncludeTests(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "include_tests")
+	goldenDir := filepath.Join("../../testdata/golden", "include_tests")
 
-type TestStruct struct {
-	Name string ` + "`" + `validate:"required,unknowntag"` + "`" + `
-}
-`
-	if err := ioutil.WriteFile(testFile, []byte(content), 0644); err != nil {
-		t.Fatalf("failed to write test file: %v", err)
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		IncludeTests:   true,
 	}
 
-	// Create a minimal go.mod for the temp package
-	goMod := filepath.Join(tmpDir, "go.mod")
-	modContent := `module test
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
 
-go 1.20
-`
-	if err := ioutil.WriteFile(goMod, []byte(modContent), 0644); err != nil {
-		t.Fatalf("failed to write go.mod: %v", err)
+	// Regular structs and test-only structs land in separate files
+	for _, name := range []string{"validation.gen.go", "validation.gen_test.go"} {
+		generated, err := ioutil.ReadFile(filepath.Join(inputPath, name))
+		if err != nil {
+			t.Fatalf("failed to read generated file: %v", err)
+		}
+		testutil.CompareWithGolden(t, filepath.Join(goldenDir, name), string(generated), *update)
 	}
+}
+
+func TestGenerateStructuredErrors(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "structured_errors")
+	goldenPath := filepath.Join("../../testdata/golden", "structured_errors", "validation.gen.go")
 
 	opts := &GenerateOptions{
-		Suffix:         "_validate",
 		Overwrite:      true,
-		DryRun:         false,
 		UnknownTagMode: "fail",
+		ErrorMode:      "structured",
 	}
 
-	// Should fail with unknown tag
-	err := Generate(tmpDir, opts)
-	if err == nil {
-		t.Errorf("expected error for unknown tag, got nil")
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
 	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
 }
 
-func TestUnknownTagSkip(t *testing.T) {
-	// Create a temporary test file with unknown tag
-	tmpDir := t.TempDir()
-	testFile := filepath.Join(tmpDir, "test.go")
+func TestGenerateMessageTemplates(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "message_templates")
+	goldenPath := filepath.Join("../../testdata/golden", "message_templates", "validation.gen.go")
 
-	content := `package test
+	cfg, err := LoadConfig(filepath.Join(inputPath, "houp.json"))
+	if err != nil {
+		t.Fatalf("LoadConfig() failed: %v", err)
+	}
+	opts := &GenerateOptions{
+		Overwrite:        true,
+		UnknownTagMode:   "fail",
+		MessageTemplates: cfg.Messages,
+	}
 
-type TestStruct struct {
-	Name string ` + "`" + `validate:"required,unknowntag"` + "`" + `
-	Age  int    ` + "`" + `validate:"min=1"` + "`" + `
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
 }
-`
-	if err := ioutil.WriteFile(testFile, []byte(content), 0644); err != nil {
-		t.Fatalf("failed to write test file: %v", err)
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "invalid JSON", content: `{"messages": `},
+		{name: "unknown key", content: `{"message": {"min": "%s is short"}}`},
+		{name: "unknown rule", content: `{"messages": {"minimum": "%s is short"}}`},
+		{name: "dive", content: `{"messages": {"dive": "%s is invalid"}}`},
+		{name: "unsupported verb", content: `{"messages": {"min": "%s needs %d characters"}}`},
+		{name: "too many verbs", content: `{"messages": {"min": "%s needs %s of %s"}}`},
+		{name: "multi-line header", content: `{"header": ["Copyright\nExample Corp."]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "houp.json")
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+			if _, err := LoadConfig(path); err == nil {
+				t.Errorf("LoadConfig() succeeded, want an error")
+			}
+		})
 	}
+}
 
-	// Create a minimal go.mod for the temp package
-	goMod := filepath.Join(tmpDir, "go.mod")
-	modContent := `module test
+func TestGenerateI18n(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "i18n")
+	goldenDir := filepath.Join("../../testdata/golden", "i18n")
 
-go 1.20
-`
-	if err := ioutil.WriteFile(goMod, []byte(modContent), 0644); err != nil {
-		t.Fatalf("failed to write go.mod: %v", err)
+	// The German catalog in the input directory is translated; generation keeps it
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		Locales:        []string{"en", "de"},
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	for _, name := range []string{"validation.gen.go", "validation.messages.en.json", "validation.messages.de.json"} {
+		generated, err := ioutil.ReadFile(filepath.Join(inputPath, name))
+		if err != nil {
+			t.Fatalf("failed to read generated file: %v", err)
+		}
+		testutil.CompareWithGolden(t, filepath.Join(goldenDir, name), string(generated), *update)
 	}
+}
+
+func TestGenerateValidateFields(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "validate_fields")
+	goldenPath := filepath.Join("../../testdata/golden", "validate_fields", "validation.gen.go")
 
 	opts := &GenerateOptions{
-		Suffix:         "_validate",
 		Overwrite:      true,
-		DryRun:         false,
-		UnknownTagMode: "skip",
+		UnknownTagMode: "fail",
+		ValidateFields: true,
 	}
 
-	// Should succeed and skip unknown tag using Generate
-	if err := Generate(tmpDir, opts); err != nil {
-		t.Fatalf("Generate() with skip mode failed: %v", err)
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
 	}
 
-	// Verify generated file exists
-	genFile := filepath.Join(tmpDir, "validation.gen.go")
-	generated, err := ioutil.ReadFile(genFile)
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
 	if err != nil {
 		t.Fatalf("failed to read generated file: %v", err)
 	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
 
-	// Should contain validation for Age but not unknowntag
-	genStr := string(generated)
-	if !contains(genStr, "if t.Age < 1") {
-		t.Errorf("generated code missing Age validation")
+func TestGenerateValidateExcept(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "validate_except")
+	goldenPath := filepath.Join("../../testdata/golden", "validate_except", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		ValidateExcept: true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
 	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
 }
 
-func TestDryRun(t *testing.T) {
-	inputPath := filepath.Join("../../testdata/input/simple")
+func TestGenerateMaxErrors(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "max_errors")
+	goldenPath := filepath.Join("../../testdata/golden", "max_errors", "validation.gen.go")
 
 	opts := &GenerateOptions{
-		Suffix:         "_validate",
 		Overwrite:      true,
-		DryRun:         true,
 		UnknownTagMode: "fail",
+		MultiError:     true,
+		MaxErrors:      2,
 	}
 
-	// Should succeed without writing files
 	if err := Generate(inputPath, opts); err != nil {
-		t.Fatalf("Generate() in dry-run mode failed: %v", err)
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
 	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
 }
 
-func TestParseValidationRules(t *testing.T) {
-	tests := []struct {
-		name    string
-		tag     string
-		wantLen int
-		wantErr bool
-	}{
-		{
-			name:    "required",
+func TestGenerateContextMode(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "context_mode")
+	goldenPath := filepath.Join("../../testdata/golden", "context_mode", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		Context:        true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateValueReceiver(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "value_receiver")
+	goldenPath := filepath.Join("../../testdata/golden", "value_receiver", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		ReceiverMode:   "value",
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateMethodName(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "method_name")
+	goldenPath := filepath.Join("../../testdata/golden", "method_name", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		MethodName:     "ValidateInput",
+		ValidMethod:    true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateSubpackage(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "subpackage", "models")
+	goldenPath := filepath.Join("../../testdata/golden", "subpackage", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		Subpackage:     "validation",
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	// The models package is left untouched
+	if _, err := os.Stat(filepath.Join(inputPath, "validation.gen.go")); !os.IsNotExist(err) {
+		t.Errorf("validation.gen.go written to the models package: %v", err)
+	}
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation", "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateRuntime(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "runtime_helpers")
+	goldenPath := filepath.Join("../../testdata/golden", "runtime_helpers", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		Runtime:        "github.com/n10ty/houp/runtime",
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateValidMethod(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "valid_method")
+	goldenPath := filepath.Join("../../testdata/golden", "valid_method", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		ValidMethod:    true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateMustValidate(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "must_validate")
+	goldenPath := filepath.Join("../../testdata/golden", "must_validate", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		MustValidate:   true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateConstructors(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "constructors")
+	goldenPath := filepath.Join("../../testdata/golden", "constructors", "validation.gen.go")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+		Constructors:   true,
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(inputPath, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	testutil.CompareWithGolden(t, goldenPath, string(generated), *update)
+}
+
+func TestGenerateCrossPackageStrict(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "dive_cross_package/api")
+
+	// Envelope.Notes dives into models.Note, which has no Validate method
+	opts := &GenerateOptions{
+		DryRun:           true,
+		UnknownTagMode:   "fail",
+		CrossPackageMode: "strict",
+	}
+	err := Generate(inputPath, opts)
+	if err == nil {
+		t.Fatal("Generate() succeeded, want an error for the dive into models.Note")
+	}
+	want := "dive on field Notes reaches github.com/n10ty/houp/testdata/input/dive_cross_package/models.Note, which has no Validate method"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Generate() error = %v, want it to contain %q", err, want)
+	}
+}
+
+func TestParamName(t *testing.T) {
+	tests := map[string]string{
+		"Name":    "name",
+		"ID":      "id",
+		"URLPath": "urlPath",
+		"Type":    "typeValue",
+		"token":   "token",
+		"X":       "x",
+	}
+	for field, want := range tests {
+		if got := paramName(field); got != want {
+			t.Errorf("paramName(%q) = %q, want %q", field, got, want)
+		}
+	}
+}
+
+func TestParsePagination(t *testing.T) {
+	tests := []struct {
+		param   string
+		want    Pagination
+		wantErr bool
+	}{
+		{param: "Page,PerPage", want: Pagination{PageField: "Page", PerPageField: "PerPage"}},
+		{param: "Page, Size, maxPerPage=50", want: Pagination{PageField: "Page", PerPageField: "Size", MaxPerPage: 50}},
+		{param: "Page", wantErr: true},
+		{param: "Page,Page", wantErr: true},
+		{param: "Page,PerPage,maxPerPage=0", wantErr: true},
+		{param: "Page,PerPage,limit=10", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parsePagination(tt.param)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parsePagination(%q) succeeded, want error", tt.param)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsePagination(%q) failed: %v", tt.param, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("parsePagination(%q) = %+v, want %+v", tt.param, *got, tt.want)
+		}
+	}
+}
+
+func TestGenerateBuildConstraints(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input", "buildtags")
+	goldenDir := filepath.Join("../../testdata/golden", "buildtags")
+
+	opts := &GenerateOptions{
+		Overwrite:      true,
+		UnknownTagMode: "fail",
+	}
+
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	// Mount is declared once per platform, so each declaration gets its own file
+	for _, name := range []string{
+		"validation.gen.go",
+		"validation.enterprise.gen.go",
+		"validation.linux.gen.go",
+		"validation.not_linux_not_windows.gen.go",
+		"validation.windows.gen.go",
+	} {
+		generated, err := ioutil.ReadFile(filepath.Join(inputPath, name))
+		if err != nil {
+			t.Fatalf("failed to read generated file: %v", err)
+		}
+		testutil.CompareWithGolden(t, filepath.Join(goldenDir, name), string(generated), *update)
+	}
+}
+
+func TestUnknownTagFail(t *testing.T) {
+	// Create a temporary test file with unknown tag
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+
+	content := `package test
+
+type TestStruct struct {
+	Name string ` + "`" + `validate:"required,unknowntag"` + "`" + `
+}
+`
+	if err := ioutil.WriteFile(testFile, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	// Create a minimal go.mod for the temp package
+	goMod := filepath.Join(tmpDir, "go.mod")
+	modContent := `module test
+
+go 1.20
+`
+	if err := ioutil.WriteFile(goMod, []byte(modContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	opts := &GenerateOptions{
+		Suffix:         "_validate",
+		Overwrite:      true,
+		DryRun:         false,
+		UnknownTagMode: "fail",
+	}
+
+	// Should fail with unknown tag
+	err := Generate(tmpDir, opts)
+	if err == nil {
+		t.Errorf("expected error for unknown tag, got nil")
+	}
+}
+
+func TestInvalidTagFail(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr string
+	}{
+		{
+			name:    "conflicting duplicate rule",
+			tag:     "required,min=3,min=5",
+			wantErr: "TestStruct.Name: duplicate min rule",
+		},
+		{
+			name:    "conflicting custom messages",
+			tag:     "required~name is required,required~name is missing",
+			wantErr: "TestStruct.Name: rule required has conflicting messages",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			testFile := filepath.Join(tmpDir, "test.go")
+
+			content := `package test
+
+type TestStruct struct {
+	Name string ` + "`" + `validate:"` + tt.tag + `"` + "`" + `
+	Age  int    ` + "`" + `validate:"min=1"` + "`" + `
+}
+`
+			if err := ioutil.WriteFile(testFile, []byte(content), 0644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			goMod := filepath.Join(tmpDir, "go.mod")
+			if err := ioutil.WriteFile(goMod, []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+				t.Fatalf("failed to write go.mod: %v", err)
+			}
+
+			// An invalid tag fails generation instead of dropping the field's checks
+			err := Generate(tmpDir, &GenerateOptions{Overwrite: true})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Generate() error = %v, want %q", err, tt.wantErr)
+			}
+			if _, err := os.Stat(filepath.Join(tmpDir, "validation.gen.go")); !os.IsNotExist(err) {
+				t.Errorf("generated file written despite the invalid tag")
+			}
+		})
+	}
+}
+
+func TestUnknownTagSkip(t *testing.T) {
+	// Create a temporary test file with unknown tag
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+
+	content := `package test
+
+type TestStruct struct {
+	Name string ` + "`" + `validate:"required,unknowntag"` + "`" + `
+	Age  int    ` + "`" + `validate:"min=1"` + "`" + `
+}
+`
+	if err := ioutil.WriteFile(testFile, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	// Create a minimal go.mod for the temp package
+	goMod := filepath.Join(tmpDir, "go.mod")
+	modContent := `module test
+
+go 1.20
+`
+	if err := ioutil.WriteFile(goMod, []byte(modContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	opts := &GenerateOptions{
+		Suffix:         "_validate",
+		Overwrite:      true,
+		DryRun:         false,
+		UnknownTagMode: "skip",
+	}
+
+	// Should succeed and skip unknown tag using Generate
+	if err := Generate(tmpDir, opts); err != nil {
+		t.Fatalf("Generate() with skip mode failed: %v", err)
+	}
+
+	// Verify generated file exists
+	genFile := filepath.Join(tmpDir, "validation.gen.go")
+	generated, err := ioutil.ReadFile(genFile)
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	// Should contain validation for Age but not unknowntag
+	genStr := string(generated)
+	if !contains(genStr, "if t.Age < 1") {
+		t.Errorf("generated code missing Age validation")
+	}
+}
+
+func TestReceiverNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		opts    GenerateOptions
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "option",
+			source: "type User struct {\n\tName string `validate:\"required\"`\n}\n",
+			opts:   GenerateOptions{ReceiverName: "self"},
+			want:   "func (self *User) Validate() error {",
+		},
+		{
+			name:   "own methods win over the option",
+			source: "type User struct {\n\tName string `validate:\"required\"`\n}\n\nfunc (usr User) String() string { return usr.Name }\n",
+			opts:   GenerateOptions{ReceiverName: "self"},
+			want:   "func (usr *User) Validate() error {",
+		},
+		{
+			name:   "shadowed own method receiver",
+			source: "type Item struct {\n\tName string `validate:\"required\"`\n}\n\nfunc (i Item) String() string { return i.Name }\n",
+			want:   "func (it *Item) Validate() error {",
+		},
+		{
+			name:   "receiver named like a parsed coordinate",
+			source: "//validate:receiver=v\ntype Venue struct {\n\tLat string `validate:\"latitude\"`\n}\n",
+			want:   "strconv.ParseFloat(v.Lat, 64); err != nil || !(LatFloat",
+		},
+		{
+			name:   "receiver named like a postcode pattern",
+			source: "//validate:receiver=re\ntype Address struct {\n\tCountry  string\n\tPostCode string `validate:\"postcode_iso3166_alpha2=Country\"`\n}\n",
+			want:   "[re.Country]; !ok || !PostCode",
+		},
+		{
+			name:    "shadowed comment receiver",
+			source:  "//validate:receiver=err\ntype User struct {\n\tName string `validate:\"required\"`\n}\n",
+			wantErr: `receiver "err" is used by generated code`,
+		},
+		{
+			name:    "invalid comment receiver",
+			source:  "//validate:receiver=my-user\ntype User struct {\n\tName string `validate:\"required\"`\n}\n",
+			wantErr: `receiver "my-user" is not a valid identifier`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGenerateSource(t, tt.source, tt.opts, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestMethodNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		opts    GenerateOptions
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "option",
+			source: "type User struct {\n\tName string `validate:\"required\"`\n}\n",
+			opts:   GenerateOptions{MethodName: "ValidateInput", MustValidate: true},
+			want:   "func (u *User) MustValidate() {\n\tif err := u.ValidateInput(); err != nil {",
+		},
+		{
+			name:   "comment wins over the option",
+			source: "//validate:method=Check\ntype User struct {\n\tName string `validate:\"required\"`\n}\n",
+			opts:   GenerateOptions{MethodName: "ValidateInput"},
+			want:   "func (u *User) Check() error {",
+		},
+		{
+			name:    "field",
+			source:  "//validate:method=Check\ntype User struct {\n\tCheck string `validate:\"required\"`\n}\n",
+			wantErr: `method name "Check" conflicts with a field of User`,
+		},
+		{
+			name:    "other generated method",
+			source:  "type User struct {\n\tName string `validate:\"required\"`\n}\n",
+			opts:    GenerateOptions{MethodName: "Valid"},
+			wantErr: `method name "Valid" is used by another generated method`,
+		},
+		{
+			name:    "invalid",
+			source:  "//validate:method=check-user\ntype User struct {\n\tName string `validate:\"required\"`\n}\n",
+			wantErr: `method name "check-user" is not a valid identifier`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGenerateSource(t, tt.source, tt.opts, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestSubpackage(t *testing.T) {
+	user := "type User struct {\n\tName string `validate:\"required\"`\n}\n"
+	tests := []struct {
+		name    string
+		source  string
+		opts    GenerateOptions
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "method name",
+			source: "//validate:method=Check\n" + user,
+			opts:   GenerateOptions{Subpackage: "checks"},
+			want:   "package checks\n\nimport (\n\t\"fmt\"\n\t\"test\"\n)\n\n// CheckUser checks the fields of test.User against their validate tags:\n//   - Name: required\n//\n// It returns the error of the first check that fails.\nfunc CheckUser(u *test.User) error {",
+		},
+		{
+			name:    "unexported struct",
+			source:  "type user struct {\n\tName string `validate:\"required\"`\n}\n",
+			opts:    GenerateOptions{Subpackage: "validation"},
+			wantErr: "struct user is unexported, so package validation cannot validate it",
+		},
+		{
+			name:    "unexported struct validator",
+			source:  "//validate:checkUser\n" + user + "\nfunc checkUser(u *User) error { return nil }\n",
+			opts:    GenerateOptions{Subpackage: "validation"},
+			wantErr: "struct validator checkUser is unexported",
+		},
+		{
+			name:    "receiver name",
+			source:  user,
+			opts:    GenerateOptions{Subpackage: "validation", ReceiverName: "test"},
+			wantErr: `receiver name "test" conflicts with the import of package test`,
+		},
+		{
+			name:    "methods",
+			source:  user,
+			opts:    GenerateOptions{Subpackage: "validation", ValidMethod: true},
+			wantErr: "subpackage validation does not support Valid",
+		},
+		{
+			name:    "invalid",
+			source:  user,
+			opts:    GenerateOptions{Subpackage: "my-validation"},
+			wantErr: `subpackage "my-validation" is not a valid package name`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGenerateSource(t, tt.source, tt.opts, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// checkGenerateSource generates for a package holding source and checks that the
+// output contains want, or that generation fails with wantErr
+func checkGenerateSource(t *testing.T, source string, opts GenerateOptions, want, wantErr string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "test.go"), []byte("package test\n\n"+source), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	opts.Overwrite = true
+	opts.UnknownTagMode = "fail"
+	err := Generate(tmpDir, &opts)
+	if wantErr != "" {
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Generate() error = %v, want %q", err, wantErr)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	generated, err := ioutil.ReadFile(filepath.Join(outputDir(tmpDir, &opts), "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	if !strings.Contains(string(generated), want) {
+		t.Errorf("generated code lacks %q:\n%s", want, generated)
+	}
+}
+
+func TestMultiErrorOption(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `package test
+
+type Form struct {
+	Name string ` + "`" + `validate:"required"` + "`" + `
+	Age  int    ` + "`" + `validate:"min=1"` + "`" + `
+}
+
+type Empty struct{}
+
+type Holder struct {
+	Empty Empty ` + "`" + `validate:"dive"` + "`" + `
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "test.go"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	opts := &GenerateOptions{Overwrite: true, UnknownTagMode: "fail", MultiError: true}
+	if err := Generate(tmpDir, opts); err != nil {
+		t.Fatalf("Generate() with MultiError failed: %v", err)
+	}
+	generated, err := ioutil.ReadFile(filepath.Join(tmpDir, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	genStr := string(generated)
+	if got := strings.Count(genStr, "errs = append(errs, err)"); got != 3 {
+		t.Errorf("generated code collects %d errors, want 3 (Name, Age, Holder.Empty):\n%s", got, genStr)
+	}
+	// A struct without checks keeps returning nil
+	if !contains(genStr, "func (e *Empty) Validate() error {\n\treturn nil\n}") {
+		t.Errorf("generated code changed the Validate method of Empty:\n%s", genStr)
+	}
+}
+
+func TestSharedCodeTables(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	content := `package test
+
+type Invoice struct {
+	Currency string   ` + "`" + `validate:"iso4217"` + "`" + `
+	Accepted []string ` + "`" + `validate:"dive,iso4217"` + "`" + `
+	Country  string   ` + "`" + `validate:"iso3166_1_alpha2"` + "`" + `
+}
+
+type Refund struct {
+	Currency string ` + "`" + `validate:"required,iso4217"` + "`" + `
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "test.go"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	if err := Generate(tmpDir, &GenerateOptions{Overwrite: true, UnknownTagMode: "fail"}); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	generated, err := ioutil.ReadFile(filepath.Join(tmpDir, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	genStr := string(generated)
+	// Each table is declared once at package level, whatever the number of fields
+	// and structs checking it
+	for table, uses := range map[string]int{"pkg_iso4217Codes": 3, "pkg_iso3166Alpha2Codes": 1} {
+		if got := strings.Count(genStr, "var "+table+" = map[string]struct{}{"); got != 1 {
+			t.Errorf("generated code declares %s %d times, want 1:\n%s", table, got, genStr)
+		}
+		if got := strings.Count(genStr, table+"["); got != uses {
+			t.Errorf("generated code looks up %s %d times, want %d:\n%s", table, got, uses, genStr)
+		}
+	}
+	if strings.Contains(genStr, ":= map[string]struct{}{") {
+		t.Errorf("generated code builds a code table inside a method:\n%s", genStr)
+	}
+}
+
+func TestKeepGoing(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Broken fails after its email rule added imports; Order dives into it
+	content := `package test
+
+type Broken struct {
+	Email string ` + "`" + `validate:"email"` + "`" + `
+	Name  string ` + "`" + `validate:"required,unknowntag"` + "`" + `
+}
+
+type Order struct {
+	ID    string   ` + "`" + `validate:"required"` + "`" + `
+	Items []Broken ` + "`" + `validate:"dive"` + "`" + `
+}
+
+type Price struct {
+	Amount int ` + "`" + `validate:"min=1,email"` + "`" + `
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "test.go"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	opts := &GenerateOptions{Overwrite: true, UnknownTagMode: "fail"}
+	if err := Generate(tmpDir, opts); err == nil {
+		t.Fatal("Generate() without KeepGoing should fail")
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(tmpDir, "validation.gen.go")); err == nil {
+		t.Fatal("Generate() without KeepGoing should not write a file")
+	}
+
+	opts.KeepGoing = true
+	err := Generate(tmpDir, opts)
+	var failures GenerationErrors
+	if !errors.As(err, &failures) {
+		t.Fatalf("Generate() error = %v, want GenerationErrors", err)
+	}
+	var structs []string
+	for _, f := range failures {
+		structs = append(structs, f.Struct)
+	}
+	if got := strings.Join(structs, ","); got != "Broken,Price" {
+		t.Errorf("failed structs = %s, want Broken,Price", got)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(tmpDir, "validation.gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+	genStr := string(generated)
+	for _, want := range []string{
+		`return fmt.Errorf("houp could not generate validation for Broken")`,
+		`return fmt.Errorf("houp could not generate validation for Price")`,
+		"// Validate always fails: houp could not generate validation for Broken.\n",
+		`if o.ID == "" {`,
+	} {
+		if !strings.Contains(genStr, want) {
+			t.Errorf("generated code missing %q", want)
+		}
+	}
+
+	// The stubs keep the package compiling, without the failed struct's imports
+	if _, err := ParsePackage(tmpDir); err != nil {
+		t.Errorf("generated package does not compile: %v\n%s", err, genStr)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	inputPath := filepath.Join("../../testdata/input/simple")
+
+	opts := &GenerateOptions{
+		Suffix:         "_validate",
+		Overwrite:      true,
+		DryRun:         true,
+		UnknownTagMode: "fail",
+	}
+
+	// Should succeed without writing files
+	if err := Generate(inputPath, opts); err != nil {
+		t.Fatalf("Generate() in dry-run mode failed: %v", err)
+	}
+}
+
+func TestParseValidationRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "required",
 			tag:     "required",
 			wantLen: 1,
 		},
 		{
-			name:    "required with min/max",
-			tag:     "required,min=3,max=50",
-			wantLen: 3,
+			name:    "required with min/max",
+			tag:     "required,min=3,max=50",
+			wantLen: 3,
+		},
+		{
+			name:    "omitempty with validators",
+			tag:     "omitempty,min=1,max=10",
+			wantLen: 3,
+		},
+		{
+			name:    "unique",
+			tag:     "unique",
+			wantLen: 1,
+		},
+		{
+			name:    "unique with field",
+			tag:     "unique=Email",
+			wantLen: 1,
+		},
+		{
+			name:    "dive",
+			tag:     "dive",
+			wantLen: 1,
+		},
+		{
+			name:    "complex combination",
+			tag:     "required,min=1,dive,unique=ID",
+			wantLen: 3, // required, min=1, dive (with unique=ID as element rule)
+		},
+		{
+			name:    "eqfield with using",
+			tag:     "required,eqfield=Email,using=example.com/eq:FoldEqual",
+			wantLen: 2,
+		},
+		{
+			name:    "using without eqfield",
+			tag:     "required,using=example.com/eq:FoldEqual",
+			wantErr: true,
+		},
+		{
+			name:    "identical rules are deduplicated",
+			tag:     "required,required,min=3,min=3",
+			wantLen: 2,
+		},
+		{
+			name:    "conflicting duplicate parameters",
+			tag:     "required,min=3,min=5",
+			wantErr: true,
+		},
+		{
+			name:    "same rule before and after dive",
+			tag:     "min=1,dive,min=3",
+			wantLen: 2,
+		},
+		{
+			name:    "repeated regexp with different patterns",
+			tag:     "regexp=example.com/re:Lower,regexp=example.com/re:Short",
+			wantLen: 2,
+		},
+		{
+			name:    "invalid datetime layout",
+			tag:     "datetime=2006-13-99",
+			wantErr: true,
+		},
+		{
+			name:    "unixts with unit and range",
+			tag:     "required,unixts=ms,from=2020-01-01,to=2030-01-01",
+			wantLen: 2,
+		},
+		{
+			name:    "unixts range without unit",
+			tag:     "unixts,to=2040-01-01",
+			wantLen: 1,
+		},
+		{
+			name:    "unixts with unknown unit",
+			tag:     "unixts=ns",
+			wantErr: true,
+		},
+		{
+			name:    "unixts with empty range",
+			tag:     "unixts,from=2030-01-01,to=2020-01-01",
+			wantErr: true,
+		},
+		{
+			name:    "unixts before epoch",
+			tag:     "unixts,from=1960-01-01",
+			wantErr: true,
+		},
+		{
+			name:    "printable",
+			tag:     "required,printable",
+			wantLen: 2,
+		},
+		{
+			name:    "no control characters",
+			tag:     "no_control_chars",
+			wantLen: 1,
+		},
+		{
+			name:    "data URI",
+			tag:     "required,datauri",
+			wantLen: 2,
+		},
+		{
+			name:    "hash digests",
+			tag:     "omitempty,sha256",
+			wantLen: 2,
+		},
+		{
+			name:    "md5 digest",
+			tag:     "md5",
+			wantLen: 1,
+		},
+		{
+			name:    "exact length",
+			tag:     "required,len=3",
+			wantLen: 2,
+		},
+		{
+			name:    "exact length in runes",
+			tag:     "len=3,runes",
+			wantLen: 1,
+		},
+		{
+			name:    "exact length that is not a number",
+			tag:     "len=abc",
+			wantErr: true,
+		},
+		{
+			name:    "map key rules",
+			tag:     "dive,keys,uuid,endkeys,required",
+			wantLen: 1,
+		},
+		{
+			name:    "map key rules without value rules",
+			tag:     "required,dive,keys,min=2,max=10,endkeys",
+			wantLen: 2,
+		},
+		{
+			name:    "keys without endkeys",
+			tag:     "dive,keys,uuid",
+			wantErr: true,
+		},
+		{
+			name:    "empty keys",
+			tag:     "dive,keys,endkeys,required",
+			wantErr: true,
+		},
+		{
+			name:    "keys without dive",
+			tag:     "keys,uuid,endkeys",
+			wantErr: true,
+		},
+		{
+			name:    "nested dive",
+			tag:     "required,dive,max=3,dive,required",
+			wantLen: 2,
+		},
+		{
+			name:    "keys of a nested dive",
+			tag:     "dive,dive,keys,uuid,endkeys",
+			wantLen: 1,
+		},
+		{
+			name:    "keys after a nested value rule",
+			tag:     "dive,dive,required,keys,uuid,endkeys",
+			wantErr: true,
 		},
 		{
-			name:    "omitempty with validators",
-			tag:     "omitempty,min=1,max=10",
-			wantLen: 3,
+			name:    "keys after a value rule",
+			tag:     "dive,required,keys,uuid,endkeys",
+			wantErr: true,
 		},
 		{
-			name:    "unique",
-			tag:     "unique",
+			name:    "jsonof with a package",
+			tag:     "jsonof=github.com/myorg/events:OrderCreated",
 			wantLen: 1,
 		},
 		{
-			name:    "unique with field",
-			tag:     "unique=Email",
+			name:    "jsonof with a local type",
+			tag:     "omitempty,jsonof=OrderCreated",
+			wantLen: 2,
+		},
+		{
+			name:    "jsonof without a type",
+			tag:     "jsonof=github.com/myorg/events:",
+			wantErr: true,
+		},
+		{
+			name:    "jsonof with an empty package",
+			tag:     "jsonof=:OrderCreated",
+			wantErr: true,
+		},
+		{
+			name:    "oneof",
+			tag:     "required,oneof=draft published archived",
+			wantLen: 2,
+		},
+		{
+			name:    "oneof without values",
+			tag:     "oneof=",
+			wantErr: true,
+		},
+		{
+			name:    "oneof with a repeated value",
+			tag:     "oneof=draft draft",
+			wantErr: true,
+		},
+		{
+			name:    "subsetof",
+			tag:     "subsetof=read write",
 			wantLen: 1,
 		},
 		{
-			name:    "dive",
-			tag:     "dive",
+			name:    "time bound now",
+			tag:     "required,gt=now",
+			wantLen: 2,
+		},
+		{
+			name:    "future and past",
+			tag:     "future,past",
+			wantLen: 2,
+		},
+		{
+			name:    "min does not accept now",
+			tag:     "min=now",
+			wantErr: true,
+		},
+		{
+			name:    "field comparison",
+			tag:     "required,gtfield=StartDate",
+			wantLen: 2,
+		},
+		{
+			name:    "field comparison without field",
+			tag:     "ltefield=",
+			wantErr: true,
+		},
+		{
+			name:    "base64",
+			tag:     "omitempty,base64",
+			wantLen: 2,
+		},
+		{
+			name:    "json",
+			tag:     "required,json",
+			wantLen: 2,
+		},
+		{
+			name:    "custom messages",
+			tag:     "required~Name is mandatory,min=3~Name is too short",
+			wantLen: 2,
+		},
+		{
+			name:    "custom message after an option",
+			tag:     "datetime=2 January 2006,lang=de~Not a date",
 			wantLen: 1,
 		},
 		{
-			name:    "complex combination",
-			tag:     "required,min=1,dive,unique=ID",
-			wantLen: 3, // required, min=1, dive (with unique=ID as element rule)
+			name:    "custom message on dive",
+			tag:     "dive~Invalid,required",
+			wantErr: true,
+		},
+		{
+			name:    "empty custom message",
+			tag:     "required~",
+			wantErr: true,
+		},
+		{
+			name:    "conflicting custom messages",
+			tag:     "required~Missing,dive,required~Blank",
+			wantErr: true,
+		},
+		{
+			name:    "composite unique key",
+			tag:     "unique=Currency+Country",
+			wantLen: 1,
+		},
+		{
+			name:    "composite unique key with an empty field",
+			tag:     "unique=Currency+",
+			wantErr: true,
+		},
+		{
+			name:    "composite unique key with a repeated field",
+			tag:     "unique=Currency+Currency",
+			wantErr: true,
+		},
+		{
+			name:    "datetime with several layouts",
+			tag:     "datetime=2006-01-02|2006-01-02T15:04:05Z07:00",
+			wantLen: 1,
+		},
+		{
+			name:    "datetime with a language",
+			tag:     "required,datetime=2 January 2006,lang=fr",
+			wantLen: 2,
+		},
+		{
+			name:    "datetime with an unsupported language",
+			tag:     "datetime=2 January 2006,lang=xx",
+			wantErr: true,
+		},
+		{
+			name:    "datetime language without names in the layout",
+			tag:     "datetime=2006-01-02,lang=de",
+			wantErr: true,
+		},
+		{
+			name:    "datetime with an empty layout",
+			tag:     "datetime=2006-01-02|",
+			wantErr: true,
+		},
+		{
+			name:    "datetime with an invalid second layout",
+			tag:     "datetime=2006-01-02|2006-13-99",
+			wantErr: true,
+		},
+		{
+			name:    "scientific and underscore bounds",
+			tag:     "gte=1_000,lte=1e6",
+			wantLen: 2,
+		},
+		{
+			name:    "invalid numeric bound",
+			tag:     "max=ten",
+			wantErr: true,
+		},
+		{
+			name:    "missing numeric bound",
+			tag:     "min=",
+			wantErr: true,
+		},
+		{
+			name:    "uuid4 accepting nil",
+			tag:     "required,uuid4=nil",
+			wantLen: 2,
+		},
+		{
+			name:    "postcode without country field",
+			tag:     "postcode_iso3166_alpha2",
+			wantErr: true,
+		},
+		{
+			name:    "trim applies to min and max",
+			tag:     "min=3,max=10,trim",
+			wantLen: 2,
+		},
+		{
+			name:    "trim without length rule",
+			tag:     "required,trim",
+			wantErr: true,
+		},
+		{
+			name:    "runes applies to min and max",
+			tag:     "min=3,max=10,runes",
+			wantLen: 2,
+		},
+		{
+			name:    "runes combined with trim",
+			tag:     "max=10,runes,trim",
+			wantLen: 1,
+		},
+		{
+			name:    "runes without length rule",
+			tag:     "required,runes",
+			wantErr: true,
+		},
+		{
+			name:    "numeric folds its bounds",
+			tag:     "required,numeric,gte=0,lt=1e3",
+			wantLen: 2,
+		},
+		{
+			name:    "uuid with unknown option",
+			tag:     "uuid=v7",
+			wantErr: true,
 		},
 	}
 
@@ -248,6 +1674,466 @@ func TestParseValidationRules(t *testing.T) {
 	}
 }
 
+func TestSanitizeFilenameForVar(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"email_validation.go", "email_validation"},
+		{"/src/models/user_test.go", "user_test"},
+		{"test-file.go", "test_file_31f95b"},
+		{"a-b.go", "a_b_2d363c"},
+		{"a_b.go", "a_b"},
+		{"1st.go", "file_1st_4a99b2"},
+		// Package-level files use the pkg prefixes
+		{"pkg.go", "pkg_efe996"},
+	}
+	for _, tt := range tests {
+		if got := sanitizeFilenameForVar(tt.filename); got != tt.want {
+			t.Errorf("sanitizeFilenameForVar(%q) = %q, want %q", tt.filename, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateForFilesSharedPackage(t *testing.T) {
+	tmpDir := t.TempDir()
+	source := "package test\n\ntype %s struct {\n\tEmail string `validate:\"email\"`\n}\n"
+	// Files whose names sanitize alike, generated one at a time into one package
+	files := map[string]string{"a-b.go": "First", "a_b.go": "Second", "pkg.go": "Third"}
+	for name, structName := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := ioutil.WriteFile(path, []byte(fmt.Sprintf(source, structName)), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		if err := GenerateForFiles([]string{path}, &GenerateOptions{Overwrite: true}); err != nil {
+			t.Fatalf("GenerateForFiles(%s) failed: %v", name, err)
+		}
+	}
+
+	declared := make(map[string]string)
+	for name := range files {
+		output := strings.TrimSuffix(name, ".go") + "_validation.gen.go"
+		generated, err := ioutil.ReadFile(filepath.Join(tmpDir, output))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", output, err)
+		}
+		for _, match := range regexp.MustCompile(`(?m)^var (\w+)`).FindAllStringSubmatch(string(generated), -1) {
+			if other, ok := declared[match[1]]; ok {
+				t.Errorf("%s and %s both declare %s", other, output, match[1])
+			}
+			declared[match[1]] = output
+		}
+	}
+	if len(declared) != len(files) {
+		t.Errorf("declared %v, want one regexp per file", declared)
+	}
+}
+
+func TestFileHeader(t *testing.T) {
+	source := "type User struct {\n\tName string `validate:\"required\"`\n}\n"
+
+	checkGenerateSource(t, source, GenerateOptions{}, "// Code generated by houp. DO NOT EDIT.\n\npackage test\n", "")
+
+	opts := GenerateOptions{
+		Version: "v1.2.3",
+		Command: "houp --errors=structured ./models",
+		Header:  []string{"Copyright 2024 Example Corp.", "", "Licensed under the MIT License."},
+	}
+	want := "// Code generated by houp v1.2.3; DO NOT EDIT.\n" +
+		"// Tag set: " + TagSetVersion() + "\n" +
+		"// Command: houp --errors=structured ./models\n" +
+		"// Copyright 2024 Example Corp.\n" +
+		"//\n" +
+		"// Licensed under the MIT License.\n" +
+		"\n" +
+		"package test\n"
+	checkGenerateSource(t, source, opts, want, "")
+
+	checkGenerateSource(t, source, GenerateOptions{Header: []string{"Copyright\nExample Corp."}}, "", "spans several lines")
+	checkGenerateSource(t, source, GenerateOptions{Command: "houp \\\n ./models"}, "", "spans several lines")
+}
+
+func TestGenerateBuildTagsOption(t *testing.T) {
+	files := map[string]string{
+		"go.mod":             "module test\n\ngo 1.20\n",
+		"settings.go":        "package test\n\ntype Settings struct {\n\tPlan Plan `validate:\"min=1\"`\n}\n",
+		"plan.go":            "//go:build !enterprise\n\npackage test\n\ntype Plan string\n",
+		"plan_enterprise.go": "//go:build enterprise\n\npackage test\n\ntype Plan int\n",
+	}
+	tests := []struct {
+		name string
+		tags []string
+		want string
+	}{
+		{name: "default", want: "len(s.Plan) < 1"},
+		{name: "enterprise", tags: []string{"enterprise"}, want: "s.Plan < 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range files {
+				if err := ioutil.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write %s: %v", name, err)
+				}
+			}
+
+			opts := &GenerateOptions{Overwrite: true, BuildTags: tt.tags}
+			if err := Generate(tmpDir, opts); err != nil {
+				t.Fatalf("Generate() failed: %v", err)
+			}
+			generated, err := ioutil.ReadFile(filepath.Join(tmpDir, "validation.gen.go"))
+			if err != nil {
+				t.Fatalf("failed to read generated file: %v", err)
+			}
+			if !strings.Contains(string(generated), tt.want) {
+				t.Errorf("generated code lacks %q:\n%s", tt.want, generated)
+			}
+		})
+	}
+
+	checkGenerateSource(t, "type User struct{}\n", GenerateOptions{BuildTags: []string{"enterprise edition"}}, "", "is not valid")
+}
+
+func TestGenerateSkipsUnchangedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	write := func(source string) {
+		t.Helper()
+		if err := ioutil.WriteFile(filepath.Join(tmpDir, "test.go"), []byte("package test\n\n"+source), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.20\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+	output := filepath.Join(tmpDir, "validation.gen.go")
+	generate := func() time.Time {
+		t.Helper()
+		if err := Generate(tmpDir, &GenerateOptions{Overwrite: true}); err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		info, err := os.Stat(output)
+		if err != nil {
+			t.Fatalf("failed to stat generated file: %v", err)
+		}
+		return info.ModTime()
+	}
+
+	write("type User struct {\n\tName string `validate:\"required\"`\n}\n")
+	generate()
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(output, past, past); err != nil {
+		t.Fatalf("failed to set modification time: %v", err)
+	}
+
+	if got := generate(); !got.Equal(past) {
+		t.Errorf("unchanged file was rewritten: modification time %v, want %v", got, past)
+	}
+
+	write("type User struct {\n\tName string `validate:\"required,min=2\"`\n}\n")
+	if got := generate(); got.Equal(past) {
+		t.Error("changed file was not rewritten")
+	}
+}
+
+func TestValidateDoc(t *testing.T) {
+	source := "type User struct {\n" +
+		"\tName  string `validate:\"required~name is missing, min=3\"`\n" +
+		"\tEmail string `validate:\"omitempty,email\"`\n" +
+		"\tNotes string\n" +
+		"}\n"
+	want := "// Validate checks the fields of User against their validate tags:\n" +
+		"//   - Name: required, min=3\n" +
+		"//   - Email: omitempty, email\n" +
+		"//\n" +
+		"// It returns the error of the first check that fails.\n" +
+		"func (u *User) Validate() error {"
+	checkGenerateSource(t, source, GenerateOptions{}, want, "")
+	checkGenerateSource(t, source, GenerateOptions{MultiError: true}, "// It returns the errors of every field that fails, joined with errors.Join.\nfunc", "")
+	checkGenerateSource(t, source, GenerateOptions{Context: true}, "// ValidateContext is Validate with ctx passed to the validators and nested structs that take a context.\n", "")
+}
+
+func TestFormatSource(t *testing.T) {
+	src := "package test\n\nimport (\n\t\"github.com/n10ty/houp\"\n\t\"regexp\"\n\t\"fmt\"\n\tdec \"github.com/shopspring/decimal\"\n)\n\n" +
+		"func check(d dec.Decimal) error {\n  if d.IsNegative() { return fmt.Errorf(\"negative\") }\n  return nil\n}\n"
+	want := "package test\n\nimport (\n\t\"fmt\"\n\n\tdec \"github.com/shopspring/decimal\"\n)\n\n" +
+		"func check(d dec.Decimal) error {\n\tif d.IsNegative() {\n\t\treturn fmt.Errorf(\"negative\")\n\t}\n\treturn nil\n}\n"
+
+	got, err := formatSource("", []byte(src))
+	if err != nil {
+		t.Fatalf("formatSource() failed: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("formatSource() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatNumericBound(t *testing.T) {
+	tests := []struct {
+		value   string
+		integer bool
+		want    string
+		wantErr bool
+	}{
+		{value: "10", integer: true, want: "10"},
+		{value: "1_000_000", integer: true, want: "1000000"},
+		{value: "1e6", integer: true, want: "1000000"},
+		{value: "-2.5e2", integer: true, want: "-250"},
+		{value: "0x1F", integer: true, want: "31"},
+		{value: "1.5", integer: true, wantErr: true},
+		{value: "1e-3", integer: true, wantErr: true},
+		{value: "1e-3", integer: false, want: "0.001"},
+		{value: "1e-400", integer: false, wantErr: true},
+		{value: "1_000.25", integer: false, want: "1000.25"},
+		{value: "+5", integer: false, want: "5"},
+		{value: "--5", integer: false, wantErr: true},
+		{value: "1__0", integer: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := formatNumericBound(tt.value, tt.integer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("formatNumericBound(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatNumericBound(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckBoundRange(t *testing.T) {
+	level := types.NewNamed(types.NewTypeName(token.NoPos, nil, "Level", nil), types.Typ[types.Uint8], nil)
+	tests := []struct {
+		name    string
+		typ     types.Type
+		bound   string
+		wantErr bool
+	}{
+		{"uint8 max", types.Typ[types.Uint8], "255", false},
+		{"uint8 overflow", types.Typ[types.Uint8], "256", true},
+		{"uint8 negative", types.Typ[types.Uint8], "-1", true},
+		{"defined uint8 overflow", level, "300", true},
+		{"int8 min", types.Typ[types.Int8], "-128", false},
+		{"int8 underflow", types.Typ[types.Int8], "-129", true},
+		{"int64 max", types.Typ[types.Int64], "9223372036854775807", false},
+		{"int overflow", types.Typ[types.Int], "9223372036854775808", true},
+		{"uint64 max", types.Typ[types.Uint64], "18446744073709551615", false},
+		{"float32 overflow", types.Typ[types.Float32], "1e39", true},
+		{"float32 underflow", types.Typ[types.Float32], "1e-50", true},
+		{"float32 smallest", types.Typ[types.Float32], "1e-45", false},
+		{"float64", types.Typ[types.Float64], "1e300", false},
+		{"float64 overflow", types.Typ[types.Float64], "1e400", true},
+		{"negative float64 overflow", types.Typ[types.Float64], "-1e400", true},
+		{"json.Number overflow", nil, "1e400", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bound, err := formatNumericBound(tt.bound, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			info := TypeInfo{GoType: tt.typ}
+			if tt.typ == nil {
+				info.Kind = TypeJSONNumber
+			}
+			err = checkBoundRange(info, bound)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkBoundRange(%s, %s) error = %v, wantErr %v", tt.typ, tt.bound, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTimeLayout(t *testing.T) {
+	tests := []struct {
+		layout  string
+		wantErr bool
+	}{
+		{layout: "2006-01-02"},
+		{layout: "2006-01-02T15:04:05Z07:00"},
+		{layout: "2006-01-02T15:04:05.000Z07:00"},
+		{layout: "2006-01-02 15:04:05.999999999 -0700 MST"},
+		{layout: "Mon Jan _2 15:04:05 MST 2006"},
+		{layout: "01/02/2006"},
+		{layout: "15:04:05"},
+		{layout: "20060102"},
+		{layout: "2006-002"},
+		{layout: "2006-13-99", wantErr: true},
+		{layout: "2006-01-32", wantErr: true},
+		{layout: "YYYY-MM-DD", wantErr: true},
+		{layout: "2006-01-02 __2", wantErr: false},
+		{layout: "Jan 2 2006 08:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			err := validateTimeLayout(tt.layout)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateTimeLayout(%q) error = %v, wantErr %v", tt.layout, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileNameOSArch(t *testing.T) {
+	tests := []struct {
+		name       string
+		goos, arch string
+	}{
+		{"mount_linux.go", "linux", ""},
+		{"mount_windows_amd64.go", "windows", "amd64"},
+		{"simd_arm64_test.go", "", "arm64"},
+		{"linux.go", "", ""},
+		{"mount.go", "", ""},
+		{"validation.not_linux_not_windows.gen.go", "", ""},
+		{"mount_linux.pb.go", "linux", ""},
+	}
+	for _, tt := range tests {
+		goos, arch := fileNameOSArch(tt.name)
+		if goos != tt.goos || arch != tt.arch {
+			t.Errorf("fileNameOSArch(%q) = %q, %q, want %q, %q", tt.name, goos, arch, tt.goos, tt.arch)
+		}
+	}
+}
+
+func TestConstraintEnv(t *testing.T) {
+	tests := []struct {
+		expr      string
+		buildTags []string
+		goos      string // empty: any value that satisfies expr
+		arch      string
+		tags      string
+		wantErr   bool
+	}{
+		{expr: "windows", goos: "windows"},
+		{expr: "linux", buildTags: []string{"enterprise"}, goos: "linux", tags: "enterprise"},
+		{expr: "!enterprise", buildTags: []string{"enterprise", "fips"}, tags: "fips"},
+		{expr: "windows && arm64", goos: "windows", arch: "arm64"},
+		{expr: "enterprise && (linux || darwin)", tags: "enterprise"},
+		{expr: "!linux && !windows && !darwin", tags: ""},
+		{expr: "unix && !linux"},
+		{expr: "linux && windows", wantErr: true},
+		{expr: "cgo && !cgo", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			env, err := constraintEnv(tt.expr, tt.buildTags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("constraintEnv(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.goos != "" && env.GOOS != tt.goos {
+				t.Errorf("GOOS = %q, want %q", env.GOOS, tt.goos)
+			}
+			if tt.arch != "" && env.GOARCH != tt.arch {
+				t.Errorf("GOARCH = %q, want %q", env.GOARCH, tt.arch)
+			}
+			if got := strings.Join(env.Tags, ","); got != tt.tags {
+				t.Errorf("Tags = %q, want %q", got, tt.tags)
+			}
+
+			expr, _ := constraint.Parse("//go:build " + tt.expr)
+			ok := expr.Eval(func(tag string) bool {
+				if tag == "unix" {
+					return containsString(unixOS, env.GOOS)
+				}
+				return tag == env.GOOS || tag == env.GOARCH || containsString(env.Tags, tag)
+			})
+			if !ok {
+				t.Errorf("environment %+v does not satisfy %q", env, tt.expr)
+			}
+		})
+	}
+}
+
+// TestSupportedRules checks the rule inventory printed by "houp version --json"
+// against the rule names parseValidationRule switches on
+func TestSupportedRules(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "parser.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := SupportedOptions()
+	var cases []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		fn, ok := n.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "parseValidationRule" {
+			return true
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			clause, ok := n.(*ast.CaseClause)
+			if !ok {
+				return true
+			}
+			for _, expr := range clause.List {
+				lit, ok := expr.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				name, _ := strconv.Unquote(lit.Value)
+				if !containsString(options, name) && !containsString(cases, name) {
+					cases = append(cases, name)
+				}
+			}
+			return true
+		})
+		return false
+	})
+	sort.Strings(cases)
+
+	rules := SupportedRules()
+	if !sort.StringsAreSorted(rules) {
+		t.Errorf("SupportedRules() is not sorted: %v", rules)
+	}
+	if strings.Join(rules, ",") != strings.Join(cases, ",") {
+		t.Errorf("SupportedRules() = %v\nparseValidationRule handles %v", rules, cases)
+	}
+}
+
+// TestLocalVarName checks that local variable names do not depend on the order in
+// which fields are generated, so adding a field leaves the others' names alone
+func TestLocalVarName(t *testing.T) {
+	price := &FieldInfo{Name: "Price"}
+	quantity := &FieldInfo{Name: "Quantity"}
+
+	ctx := &CodeGenContext{Struct: &StructInfo{Name: "Order"}}
+	want := ctx.LocalVarName(price, "min", "PriceFloat")
+
+	ctx = &CodeGenContext{Struct: &StructInfo{Name: "Order"}}
+	ctx.LocalVarName(quantity, "min", "QuantityFloat")
+	if got := ctx.LocalVarName(price, "min", "PriceFloat"); got != want {
+		t.Errorf("LocalVarName() after another field = %s, want %s", got, want)
+	}
+	if got := ctx.LocalVarName(price, "min", "PriceFloat"); got != want+"2" {
+		t.Errorf("LocalVarName() repeated = %s, want %s", got, want+"2")
+	}
+	if got := ctx.LocalVarName(price, "max", "PriceFloat"); got == want {
+		t.Errorf("LocalVarName() for another rule = %s, want a different name", got)
+	}
+}
+
+func TestPostcodePatterns(t *testing.T) {
+	alpha2 := make(map[string]bool)
+	for _, code := range iso3166Codes(func(c isodata.Country) string { return c.Alpha2 }) {
+		alpha2[code] = true
+	}
+
+	for country, pattern := range postcodePatterns {
+		if !alpha2[country] {
+			t.Errorf("postcode pattern for unknown country %q", country)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			t.Errorf("postcode pattern for %s does not compile: %v", country, err)
+		}
+	}
+}
+
 func TestTypeInfoIsNumeric(t *testing.T) {
 	tests := []struct {
 		kind TypeKind
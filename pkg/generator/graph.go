@@ -0,0 +1,289 @@
+package generator
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+)
+
+// Graph is the dive dependency graph of one or more packages: which structs run the
+// validation of which other structs, and where nested validation does not run
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is a struct type of the graph
+type GraphNode struct {
+	ID        string `json:"id"`      // "import/path.Name"
+	Package   string `json:"package"` // import path
+	Name      string `json:"name"`
+	Validated bool   `json:"validated"` // has validation rules or a Validate method
+	External  bool   `json:"external"`  // declared outside the analyzed packages
+}
+
+// Kinds of graph edges
+const (
+	EdgeDive   = "dive"   // the field's dive runs the target's validation
+	EdgeJSONOf = "jsonof" // the field holds a JSON payload validated as the target
+	EdgeNested = "nested" // the field holds a validated target without running its validation
+)
+
+// GraphEdge is a field of one struct that holds, or is decoded into, another
+type GraphEdge struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	Field        string `json:"field"`
+	Kind         string `json:"kind"`
+	CrossPackage bool   `json:"cross_package"`
+	Gap          string `json:"gap,omitempty"` // why validation does not reach the target, empty if it does
+}
+
+// BuildGraph builds the dive dependency graph of the given parsed packages. Edges
+// start at structs of these packages and may end in any package. A dive or jsonof
+// into a struct without validation is a gap, as is a field holding a validated
+// struct (directly or in a pointer, slice, array or map) without dive.
+// Types that cannot be resolved are assumed to be validated.
+func BuildGraph(pkgInfos []*PackageInfo) *Graph {
+	b := &graphBuilder{
+		local:     make(map[string]*StructInfo),
+		validates: make(map[string]bool),
+		nodes:     make(map[string]*GraphNode),
+	}
+	for _, pkgInfo := range pkgInfos {
+		handWritten := handWrittenValidateMethods(pkgInfo)
+		for _, fileInfo := range sortedFiles(pkgInfo) {
+			for _, s := range fileInfo.Structs {
+				id := pkgInfo.PkgPath + "." + s.Name
+				b.local[id] = s
+				// NeedsGen is also set on dive targets, which get an empty Validate
+				b.validates[id] = len(s.Fields) > 0 || len(s.CustomValidators) > 0 || s.Pagination != "" || handWritten[s.Name]
+			}
+		}
+	}
+
+	graph := &Graph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	for _, pkgInfo := range pkgInfos {
+		for _, fileInfo := range sortedFiles(pkgInfo) {
+			for _, s := range fileInfo.Structs {
+				graph.Edges = append(graph.Edges, b.structEdges(pkgInfo, s)...)
+			}
+		}
+	}
+
+	// Structs without validation or edges would only clutter the graph
+	for id, s := range b.local {
+		if s.NeedsGen {
+			b.node(id, nil)
+		}
+	}
+	for _, n := range b.nodes {
+		graph.Nodes = append(graph.Nodes, *n)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].ID < graph.Nodes[j].ID })
+	return graph
+}
+
+// graphBuilder holds the state of BuildGraph
+type graphBuilder struct {
+	local     map[string]*StructInfo // structs of the analyzed packages by ID
+	validates map[string]bool        // whether each local struct is validated
+	nodes     map[string]*GraphNode
+}
+
+// structEdges returns the edges leaving a struct, in field order
+func (b *graphBuilder) structEdges(pkgInfo *PackageInfo, s *StructInfo) []GraphEdge {
+	structType, ok := s.TypeSpec.Type.(*ast.StructType)
+	if !ok || pkgInfo.TypesInfo == nil {
+		return nil
+	}
+	from := pkgInfo.PkgPath + "." + s.Name
+	rules := make(map[string][]ValidationRule, len(s.Fields))
+	for _, field := range s.Fields {
+		rules[field.Name] = flattenRules(field.Rules)
+	}
+
+	var edges []GraphEdge
+	for _, field := range structType.Fields.List {
+		if isSkippedField(field) {
+			continue
+		}
+		for _, ident := range field.Names {
+			dived := false
+			for _, rule := range rules[ident.Name] {
+				switch r := rule.(type) {
+				case *DiveRule:
+					dived = true
+				case *JSONOfRule:
+					importPath := r.ImportPath
+					if importPath == "" {
+						importPath = pkgInfo.PkgPath
+					}
+					edges = append(edges, b.edge(from, ident.Name, EdgeJSONOf, importPath+"."+r.TypeName, lookupNamed(pkgInfo, s, importPath, r.TypeName)))
+				}
+			}
+
+			target := structTarget(pkgInfo.TypesInfo.TypeOf(field.Type))
+			if target == nil {
+				continue
+			}
+			to := target.Obj().Pkg().Path() + "." + target.Obj().Name()
+			switch {
+			case dived:
+				edges = append(edges, b.edge(from, ident.Name, EdgeDive, to, target))
+			case b.isValidated(to, target):
+				edges = append(edges, b.edge(from, ident.Name, EdgeNested, to, target))
+			}
+		}
+	}
+	return edges
+}
+
+// edge adds the nodes of an edge and returns it. named is the target type, nil if unknown.
+func (b *graphBuilder) edge(from, field, kind, to string, named *types.Named) GraphEdge {
+	b.node(from, nil)
+	target := b.node(to, named)
+	e := GraphEdge{
+		From:         from,
+		To:           to,
+		Field:        field,
+		Kind:         kind,
+		CrossPackage: b.nodes[from].Package != target.Package,
+	}
+	switch {
+	case kind == EdgeNested:
+		e.Gap = "field is not dived, so " + target.Name + " is not validated"
+	case !target.Validated:
+		e.Gap = target.Name + " has no validation"
+	}
+	return e
+}
+
+// node returns the node of a struct, adding it first if needed
+func (b *graphBuilder) node(id string, named *types.Named) *GraphNode {
+	if n, ok := b.nodes[id]; ok {
+		return n
+	}
+	n := &GraphNode{ID: id, Validated: b.isValidated(id, named)}
+	n.Package, n.Name = splitTypeID(id)
+	_, local := b.local[id]
+	n.External = !local
+	b.nodes[id] = n
+	return n
+}
+
+// isValidated reports whether a struct has validation: rules or a hand-written
+// Validate method for local structs, validate tags or a Validate method for other
+// packages
+func (b *graphBuilder) isValidated(id string, named *types.Named) bool {
+	if validated, ok := b.validates[id]; ok {
+		return validated
+	}
+	if named == nil {
+		return true
+	}
+	return hasValidateTags(named) || types.Implements(named, validatableInterface) || types.Implements(types.NewPointer(named), validatableInterface)
+}
+
+// splitTypeID splits "import/path.Name" into the import path and the type name
+func splitTypeID(id string) (string, string) {
+	for i := len(id) - 1; i >= 0; i-- {
+		if id[i] == '.' {
+			return id[:i], id[i+1:]
+		}
+		if id[i] == '/' {
+			break
+		}
+	}
+	return "", id
+}
+
+// structTarget returns the named struct type held by a field of type t, directly or
+// as the element of pointers, slices, arrays and map values. It returns nil for
+// other types, and the generic type for instantiated ones.
+func structTarget(t types.Type) *types.Named {
+	for t != nil {
+		switch u := t.(type) {
+		case *types.Named:
+			if _, ok := u.Underlying().(*types.Struct); ok && u.Obj().Pkg() != nil {
+				return u.Origin()
+			}
+		case *types.Alias:
+			t = types.Unalias(u)
+			continue
+		}
+		switch u := t.Underlying().(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Array:
+			t = u.Elem()
+		case *types.Map:
+			t = u.Elem()
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// lookupNamed finds a named type in the package of s or in a package it imports,
+// directly or not. It returns nil if the type cannot be found.
+func lookupNamed(pkgInfo *PackageInfo, s *StructInfo, importPath, name string) *types.Named {
+	obj := pkgInfo.TypesInfo.Defs[s.TypeSpec.Name]
+	if obj == nil || obj.Pkg() == nil {
+		return nil
+	}
+	seen := make(map[*types.Package]bool)
+	queue := []*types.Package{obj.Pkg()}
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		if seen[pkg] {
+			continue
+		}
+		seen[pkg] = true
+		if pkg.Path() == importPath {
+			tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok {
+				return nil
+			}
+			named, _ := tn.Type().(*types.Named)
+			return named
+		}
+		queue = append(queue, pkg.Imports()...)
+	}
+	return nil
+}
+
+// handWrittenValidateMethods returns the names of the types of a package that declare
+// a Validate method outside the generated validation.gen.go
+func handWrittenValidateMethods(pkgInfo *PackageInfo) map[string]bool {
+	names := make(map[string]bool)
+	for _, fileInfo := range pkgInfo.Files {
+		if fileInfo.Name == "validation.gen.go" {
+			continue
+		}
+		for _, decl := range fileInfo.AST.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "Validate" || len(fn.Recv.List) == 0 {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			switch r := recv.(type) {
+			case *ast.IndexExpr:
+				recv = r.X
+			case *ast.IndexListExpr:
+				recv = r.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok {
+				names[ident.Name] = true
+			}
+		}
+	}
+	return names
+}
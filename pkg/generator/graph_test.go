@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildGraph(t *testing.T) {
+	pkgInfo, err := ParsePackage(filepath.Join("../../testdata/input", "graph"))
+	if err != nil {
+		t.Fatalf("ParsePackage() failed: %v", err)
+	}
+
+	graph := BuildGraph([]*PackageInfo{pkgInfo})
+
+	const (
+		local  = "github.com/n10ty/houp/testdata/input/graph"
+		models = "github.com/n10ty/houp/testdata/input/dive_cross_package/models"
+	)
+	wantNodes := []GraphNode{
+		{ID: models + ".Error", Package: models, Name: "Error", Validated: true, External: true},
+		{ID: local + ".Customer", Package: local, Name: "Customer", Validated: true},
+		{ID: local + ".Line", Package: local, Name: "Line", Validated: true},
+		{ID: local + ".Notes", Package: local, Name: "Notes"},
+		{ID: local + ".Order", Package: local, Name: "Order", Validated: true},
+	}
+	if diff := cmp.Diff(wantNodes, graph.Nodes); diff != "" {
+		t.Errorf("Nodes mismatch (-want +got):\n%s", diff)
+	}
+
+	wantEdges := []GraphEdge{
+		{From: local + ".Order", To: local + ".Line", Field: "Lines", Kind: EdgeDive},
+		{From: local + ".Order", To: models + ".Error", Field: "Errors", Kind: EdgeDive, CrossPackage: true},
+		{From: local + ".Order", To: local + ".Customer", Field: "Customer", Kind: EdgeNested, Gap: "field is not dived, so Customer is not validated"},
+		{From: local + ".Order", To: local + ".Notes", Field: "Notes", Kind: EdgeDive, Gap: "Notes has no validation"},
+		{From: local + ".Order", To: local + ".Customer", Field: "Payload", Kind: EdgeJSONOf},
+	}
+	if diff := cmp.Diff(wantEdges, graph.Edges); diff != "" {
+		t.Errorf("Edges mismatch (-want +got):\n%s", diff)
+	}
+}
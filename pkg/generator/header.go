@@ -0,0 +1,51 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkHeaderOptions reports an error if a line of the generated file header holds
+// a line break, which would end its comment
+func checkHeaderOptions(opts *GenerateOptions) error {
+	if strings.ContainsAny(opts.Version, "\r\n") {
+		return fmt.Errorf("version %q spans several lines", opts.Version)
+	}
+	if strings.ContainsAny(opts.Command, "\r\n") {
+		return fmt.Errorf("command %q spans several lines", opts.Command)
+	}
+	for _, line := range opts.Header {
+		if strings.ContainsAny(line, "\r\n") {
+			return fmt.Errorf("header line %q spans several lines; give each line separately", line)
+		}
+	}
+	return nil
+}
+
+// fileHeader returns the comment generated files start with. Its first line matches
+// the "// Code generated ... DO NOT EDIT." convention tools use to recognise them.
+func fileHeader(opts *GenerateOptions) string {
+	if opts == nil {
+		opts = &GenerateOptions{}
+	}
+
+	var buf strings.Builder
+	if opts.Version != "" {
+		fmt.Fprintf(&buf, "// Code generated by houp %s; DO NOT EDIT.\n", opts.Version)
+		fmt.Fprintf(&buf, "// Tag set: %s\n", TagSetVersion())
+	} else {
+		buf.WriteString("// Code generated by houp. DO NOT EDIT.\n")
+	}
+	if opts.Command != "" {
+		fmt.Fprintf(&buf, "// Command: %s\n", opts.Command)
+	}
+	for _, line := range opts.Header {
+		if line == "" {
+			buf.WriteString("//\n")
+		} else {
+			fmt.Fprintf(&buf, "// %s\n", line)
+		}
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// inlineStructFunc returns the function that validates the anonymous struct type held
+// by field, generating it first. Anonymous types cannot have methods, so the checks
+// Validate would run go in a helper taking a pointer to the struct, which dive calls
+// like a Validate method. Anonymous structs nested in it get helpers of their own.
+func inlineStructFunc(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	inline := field.Inline
+	name := "validate" + strings.ReplaceAll(inline.Name, ".", "_")
+	if fn, ok := ctx.HelperFuncs[name]; ok {
+		return fn, nil
+	}
+	if ctx.TypesInfo == nil {
+		return "", fmt.Errorf("inline struct %s requires type information", inline.Name)
+	}
+	t := ctx.TypesInfo.TypeOf(inline.TypeSpec.Type)
+	if t == nil {
+		return "", fmt.Errorf("type of inline struct %s is unknown", inline.Name)
+	}
+	return structHelperFunc(ctx, inline, name, t)
+}
+
+// structHelperFunc generates the helper named name that runs the checks of the fields
+// of s on a pointer to a struct of type t, like a Validate method would
+func structHelperFunc(ctx *CodeGenContext, s *StructInfo, name string, t types.Type) (string, error) {
+	// The helper is generated like a Validate method, sharing imports and helpers
+	sub := *ctx
+	sub.Struct = s
+	sub.Buffer = nil
+	sub.LocalVars = nil
+	for _, f := range s.Fields {
+		if err := generateFieldValidation(&sub, f); err != nil {
+			return "", fmt.Errorf("failed to generate validation for field %s: %w", s.Name+"."+f.Name, err)
+		}
+	}
+	ctx.HelperFuncs = sub.HelperFuncs
+	ctx.HelperBuffer = sub.HelperBuffer
+	ctx.RegexpBuffer = sub.RegexpBuffer
+
+	body := fmt.Sprintf("(%s *%s) error {\n%s\n\treturn nil\n}", sub.Receiver(), qualifiedTypeString(ctx, t), strings.Join(sub.Buffer, "\n"))
+	return ctx.AddHelperFunc(name, body), nil
+}
+
+// inlineNeedsDive reports whether field holds an anonymous struct, or a pointer to
+// one, that is validated without a dive tag. Slices and maps of anonymous structs
+// need dive, like those of named structs.
+func inlineNeedsDive(ctx *CodeGenContext, field *FieldInfo) bool {
+	if field.Inline == nil {
+		return false
+	}
+	for _, rule := range field.Rules {
+		if _, ok := rule.(*DiveRule); ok {
+			return false
+		}
+	}
+	return ctx.FieldExpr(field).Elem.Kind == TypeStruct
+}
@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/n10ty/houp/pkg/isodata"
+)
+
+// iso3166Codes returns the non-empty codes selected by pick, in table order.
+// The table is maintained by "houp data update" in the isodata package.
+func iso3166Codes(pick func(isodata.Country) string) []string {
+	codes := make([]string, 0, len(isodata.Countries))
+	for _, c := range isodata.Countries {
+		if code := pick(c); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// formatCodeSetEntries renders codes as map[string]struct{} literal entries,
+// five per line, indented for a package-level variable
+func formatCodeSetEntries(codes []string) string {
+	var sb strings.Builder
+	for i, code := range codes {
+		if i%5 == 0 {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString("\t")
+		} else {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(`"` + code + `": {},`)
+	}
+	return sb.String()
+}
@@ -0,0 +1,16 @@
+package generator
+
+import "github.com/n10ty/houp/pkg/isodata"
+
+// iso639Codes returns the non-empty codes selected by pick, in table order
+func iso639Codes(pick func(isodata.Language) []string) []string {
+	codes := make([]string, 0, len(isodata.Languages))
+	for _, l := range isodata.Languages {
+		for _, code := range pick(l) {
+			if code != "" {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
@@ -0,0 +1,120 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"strings"
+)
+
+// StructError is a struct whose Validate method could not be generated
+type StructError struct {
+	Struct string
+	File   string // path of the file declaring the struct
+	Err    error
+}
+
+func (e *StructError) Error() string {
+	return fmt.Sprintf("%s: struct %s: %v", filepath.Base(e.File), e.Struct, e.Err)
+}
+
+func (e *StructError) Unwrap() error {
+	return e.Err
+}
+
+// GenerationErrors lists the structs that were skipped with KeepGoing. The files
+// are still written; each skipped struct gets a Validate method that returns an
+// error, so that the package keeps compiling.
+type GenerationErrors []*StructError
+
+func (e GenerationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	lines := make([]string, 0, len(e)+1)
+	lines = append(lines, fmt.Sprintf("%d structs could not be generated:", len(e)))
+	for _, se := range e {
+		lines = append(lines, "  "+se.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// collectFailures moves the struct failures of err into failures and returns nil,
+// or returns err unchanged if it is not a GenerationErrors
+func collectFailures(err error, failures *GenerationErrors) error {
+	var ge GenerationErrors
+	if !errors.As(err, &ge) {
+		return err
+	}
+	*failures = append(*failures, ge...)
+	return nil
+}
+
+// generateStructMethod generates the Validate method of ctx.Struct, its
+// ValidateAndFreeze method if it is marked //validate:freeze, and its
+// ValidateFields, ValidateExcept, Valid and MustValidate methods and constructor
+// when the options ask for them. ctx works on copies of the shared imports, regexp
+// vars and helpers, so a struct that fails leaves nothing behind. With KeepGoing,
+// such a struct gets a stub method instead and the failure is returned as a
+// *StructError together with the stub's context.
+func generateStructMethod(ctx *CodeGenContext) (*CodeGenContext, *StructError, error) {
+	shared := *ctx
+	ctx.Imports = maps.Clone(shared.Imports)
+	ctx.RegexpVars = maps.Clone(shared.RegexpVars)
+	ctx.HelperFuncs = maps.Clone(shared.HelperFuncs)
+
+	ctx.AddImport("fmt", "fmt")
+	err := generateValidateMethod(ctx)
+	if err == nil && ctx.Struct.Freeze {
+		err = generateFreeze(ctx)
+	}
+	if err == nil && ctx.Options.ValidateFields {
+		err = generateValidateFields(ctx)
+	}
+	if err == nil && ctx.Options.ValidateExcept {
+		err = generateValidateExcept(ctx)
+	}
+	if err == nil {
+		if ctx.Options.ValidMethod {
+			generateValid(ctx)
+		}
+		if ctx.Options.MustValidate {
+			generateMustValidate(ctx)
+		}
+		if ctx.Options.Constructors {
+			generateConstructor(ctx)
+		}
+		return ctx, nil, nil
+	}
+	if !ctx.Options.KeepGoing {
+		return nil, nil, err
+	}
+
+	stub := &shared
+	stub.Buffer = []string{}
+	stub.AddImport("fmt", "fmt")
+	validateMethodSignature(stub, validateStubDoc(stub))
+	stub.Buffer = append(stub.Buffer,
+		fmt.Sprintf("\treturn fmt.Errorf(\"houp could not generate validation for %s\")", stub.Struct.Name),
+		"}")
+	if stub.Struct.Freeze && stub.Options.Subpackage == "" {
+		freezeStub(stub)
+	}
+	if stub.Options.ValidateFields {
+		validateFieldsStub(stub)
+	}
+	if stub.Options.ValidateExcept {
+		validateExceptStub(stub)
+	}
+	if stub.Options.ValidMethod {
+		generateValid(stub)
+	}
+	if stub.Options.MustValidate {
+		generateMustValidate(stub)
+	}
+	if stub.Options.Constructors {
+		generateConstructor(stub)
+	}
+	return stub, &StructError{Struct: ctx.Struct.Name, File: ctx.Struct.SourceFile, Err: err}, nil
+}
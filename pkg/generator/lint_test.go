@@ -0,0 +1,271 @@
+package generator
+
+import (
+	"fmt"
+	"go/build/constraint"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/analysis/passes/appends"
+	"golang.org/x/tools/go/analysis/passes/assign"
+	"golang.org/x/tools/go/analysis/passes/atomic"
+	"golang.org/x/tools/go/analysis/passes/bools"
+	"golang.org/x/tools/go/analysis/passes/composite"
+	"golang.org/x/tools/go/analysis/passes/copylock"
+	"golang.org/x/tools/go/analysis/passes/defers"
+	"golang.org/x/tools/go/analysis/passes/errorsas"
+	"golang.org/x/tools/go/analysis/passes/ifaceassert"
+	"golang.org/x/tools/go/analysis/passes/loopclosure"
+	"golang.org/x/tools/go/analysis/passes/lostcancel"
+	"golang.org/x/tools/go/analysis/passes/nilfunc"
+	"golang.org/x/tools/go/analysis/passes/nilness"
+	"golang.org/x/tools/go/analysis/passes/printf"
+	"golang.org/x/tools/go/analysis/passes/shadow"
+	"golang.org/x/tools/go/analysis/passes/shift"
+	"golang.org/x/tools/go/analysis/passes/stdmethods"
+	"golang.org/x/tools/go/analysis/passes/stringintconv"
+	"golang.org/x/tools/go/analysis/passes/structtag"
+	"golang.org/x/tools/go/analysis/passes/timeformat"
+	"golang.org/x/tools/go/analysis/passes/unmarshal"
+	"golang.org/x/tools/go/analysis/passes/unreachable"
+	"golang.org/x/tools/go/analysis/passes/unusedresult"
+	"golang.org/x/tools/go/analysis/passes/unusedwrite"
+	"golang.org/x/tools/go/packages"
+)
+
+// goldenOutputDirs maps golden directories whose files are not generated into the
+// input package of the same path
+var goldenOutputDirs = map[string]string{
+	"subpackage": "subpackage/models/validation",
+}
+
+// lintAnalyzers are the go vet analyzers that apply to generated code, and the ones
+// lint configurations commonly add: strict shadowing, nil dereferences and writes
+// that are never read
+func lintAnalyzers() []*analysis.Analyzer {
+	if err := shadow.Analyzer.Flags.Set("strict", "true"); err != nil {
+		panic(err)
+	}
+	return []*analysis.Analyzer{
+		appends.Analyzer, assign.Analyzer, atomic.Analyzer, bools.Analyzer,
+		composite.Analyzer, copylock.Analyzer, defers.Analyzer, errorsas.Analyzer,
+		ifaceassert.Analyzer, loopclosure.Analyzer, lostcancel.Analyzer, nilfunc.Analyzer,
+		printf.Analyzer, shift.Analyzer, stdmethods.Analyzer, stringintconv.Analyzer,
+		structtag.Analyzer, timeformat.Analyzer, unmarshal.Analyzer, unreachable.Analyzer,
+		unusedresult.Analyzer,
+		nilness.Analyzer, shadow.Analyzer, unusedwrite.Analyzer,
+	}
+}
+
+// TestGoldenFilesLint compiles every golden file in place of the file generated from
+// its input package and checks it with the lint analyzers, like a CI job running go
+// vet and golangci-lint on generated code. Constrained files are checked under a
+// build configuration that satisfies their constraint.
+func TestGoldenFilesLint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("loads every golden package")
+	}
+
+	goldenRoot, err := filepath.Abs("../../testdata/golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputRoot, err := filepath.Abs("../../testdata/input")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Golden files are overlaid on the files generated from the input packages. Input
+	// packages are loaded together per module and build constraint, which is much
+	// faster than one load each.
+	overlay := make(map[string][]byte)
+	goldenDirs := make(map[string]string) // overlaid file -> golden directory
+	groups := make(map[lintGroup][]string)
+	err = filepath.WalkDir(goldenRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		dir, err := filepath.Rel(goldenRoot, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		dir = filepath.ToSlash(dir)
+		outDir := dir
+		if mapped, ok := goldenOutputDirs[dir]; ok {
+			outDir = mapped
+		}
+		outDir = filepath.Join(inputRoot, filepath.FromSlash(outDir))
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		file := filepath.Join(outDir, d.Name())
+		overlay[file] = content
+		goldenDirs[file] = dir
+
+		group := lintGroup{Module: moduleRoot(outDir), Constraint: goBuildLine(string(content))}
+		if !containsString(groups[group], outDir) {
+			groups[group] = append(groups[group], outDir)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read golden files: %v", err)
+	}
+
+	problems := make(map[string][]string) // golden directory -> problems
+	analyzers := lintAnalyzers()
+	for group, dirs := range groups {
+		for file, msg := range lintPackages(t, group, dirs, overlay, analyzers) {
+			dir, ok := goldenDirs[file]
+			if !ok {
+				// Errors in input packages or their tests, or without a position
+				t.Errorf("%s", msg)
+				continue
+			}
+			problems[dir] = append(problems[dir], msg)
+		}
+	}
+
+	names := make([]string, 0, len(goldenDirs))
+	for _, dir := range goldenDirs {
+		if !containsString(names, dir) {
+			names = append(names, dir)
+		}
+	}
+	sort.Strings(names)
+	for _, dir := range names {
+		t.Run(dir, func(t *testing.T) {
+			sort.Strings(problems[dir])
+			for _, msg := range problems[dir] {
+				t.Error(msg)
+			}
+		})
+	}
+}
+
+// lintGroup is a set of input packages loaded together
+type lintGroup struct {
+	Module     string // directory of the go.mod
+	Constraint string // build constraint of the golden files, "" for the host configuration
+}
+
+// moduleRoot returns the directory of the go.mod dir belongs to
+func moduleRoot(dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d
+		}
+		if filepath.Dir(d) == d {
+			return dir
+		}
+	}
+}
+
+// goBuildLine returns the expression of the //go:build line of a generated file,
+// or "" if it has none
+func goBuildLine(src string) string {
+	for _, line := range strings.Split(src, "\n") {
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+		if constraint.IsGoBuild(line) {
+			return strings.TrimSpace(strings.TrimPrefix(line, "//go:build"))
+		}
+	}
+	return ""
+}
+
+// lintPackages loads the packages in dirs with their tests, for a build
+// configuration satisfying the constraint of group, and returns their compile
+// errors and the diagnostics of the analyzers as messages by file. Messages without
+// a position are keyed by "".
+func lintPackages(t *testing.T, group lintGroup, dirs []string, overlay map[string][]byte, analyzers []*analysis.Analyzer) map[string]string {
+	t.Helper()
+
+	cfg := &packages.Config{
+		Mode:    packages.LoadAllSyntax,
+		Dir:     group.Module,
+		Tests:   true,
+		Overlay: overlay,
+	}
+	if group.Constraint != "" {
+		env, err := constraintEnv(group.Constraint, nil)
+		if err != nil {
+			t.Fatalf("build constraint %q: %v", group.Constraint, err)
+		}
+		cfg.Env, cfg.BuildFlags = env.environ()
+	}
+	patterns := make([]string, len(dirs))
+	for i, dir := range dirs {
+		rel, err := filepath.Rel(group.Module, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		patterns[i] = "./" + filepath.ToSlash(rel)
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		t.Fatalf("failed to load packages: %v", err)
+	}
+
+	messages := make(map[string]string)
+	report := func(file, msg string) {
+		if group.Constraint != "" {
+			msg += " [" + group.Constraint + "]"
+		}
+		if prev, ok := messages[file]; ok && !strings.Contains(prev, msg) {
+			msg = prev + "\n" + msg
+		}
+		messages[file] = msg
+	}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			file, _, _ := strings.Cut(err.Pos, ":")
+			report(file, pkg.ID+": "+err.Error())
+		}
+	})
+
+	// The analyzers run on the packages that built, so one broken golden file does
+	// not hide the diagnostics of the others
+	var built []*packages.Package
+	for _, pkg := range pkgs {
+		broken := false
+		packages.Visit([]*packages.Package{pkg}, func(dep *packages.Package) bool {
+			broken = broken || len(dep.Errors) > 0
+			return !broken
+		}, nil)
+		if !broken {
+			built = append(built, pkg)
+		}
+	}
+	if len(built) == 0 {
+		return messages
+	}
+
+	graph, err := checker.Analyze(analyzers, built, nil)
+	if err != nil {
+		t.Fatalf("analysis failed: %v", err)
+	}
+	for act := range graph.All() {
+		if !act.IsRoot {
+			continue
+		}
+		if act.Err != nil {
+			t.Errorf("%s: %v", act, act.Err)
+			continue
+		}
+		for _, d := range act.Diagnostics {
+			pos := act.Package.Fset.Position(d.Pos)
+			report(pos.Filename, fmt.Sprintf("%s: %s (%s)", pos, d.Message, act.Analyzer.Name))
+		}
+	}
+	return messages
+}
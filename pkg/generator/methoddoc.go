@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// validateDoc returns the doc comment of the Validate method of ctx.Struct, which
+// lists the checks it runs in order, so that godoc tells API consumers what a valid
+// value looks like:
+//
+//	// Validate checks the fields of User against their validate tags:
+//	//   - Username: required, min=3, max=20
+//	//   - Email: omitempty, email
+//	//
+//	// It returns the error of the first check that fails.
+func validateDoc(ctx *CodeGenContext) []string {
+	s := ctx.Struct
+	name := validateDocName(ctx)
+	typeName := s.Name
+	if ctx.Options.Subpackage != "" {
+		typeName = ctx.LocalRef(s.Name)
+	}
+
+	var items []string
+	if s.Pagination != "" {
+		items = append(items, "pagination="+s.Pagination)
+	}
+	for _, v := range s.CustomValidators {
+		items = append(items, "struct validator "+validatorDocName(ctx, v))
+	}
+	if s.NonStruct {
+		if len(s.Fields) > 0 {
+			items = append(items, tagSummary(s.Fields[0]))
+		}
+	} else {
+		items = append(items, fieldSummaries(s.Fields, "")...)
+	}
+
+	if len(items) == 0 {
+		return []string{fmt.Sprintf("// %s returns nil, as %s has no validate tags.", name, typeName)}
+	}
+
+	var doc []string
+	if s.NonStruct {
+		doc = append(doc, fmt.Sprintf("// %s checks %s against the rules of its //validate: comment:", name, typeName))
+	} else {
+		doc = append(doc, fmt.Sprintf("// %s checks the fields of %s against their validate tags:", name, typeName))
+	}
+	for _, item := range items {
+		doc = append(doc, "//   - "+item)
+	}
+	doc = append(doc, "//")
+	if ctx.Options.MultiError || s.MultiError {
+		doc = append(doc, "// It returns the errors of every field that fails, joined with errors.Join.")
+	} else {
+		doc = append(doc, "// It returns the error of the first check that fails.")
+	}
+	return doc
+}
+
+// validateStubDoc returns the doc comment of the Validate method KeepGoing gives a
+// struct it could not generate
+func validateStubDoc(ctx *CodeGenContext) []string {
+	return []string{fmt.Sprintf("// %s always fails: houp could not generate validation for %s.", validateDocName(ctx), ctx.Struct.Name)}
+}
+
+// validateDocName returns the name of the generated method or, in subpackage mode,
+// function
+func validateDocName(ctx *CodeGenContext) string {
+	if ctx.Options.Subpackage != "" {
+		return validateFunc(ctx.Struct, ctx.Options)
+	}
+	return ctx.MethodName()
+}
+
+// validatorDocName returns a struct validator as the doc comment names it, e.g.
+// validators.CheckUser
+func validatorDocName(ctx *CodeGenContext, v CustomValidator) string {
+	if v.ImportPath == "" || v.ImportPath == ctx.PkgPath {
+		return v.FuncName
+	}
+	return path.Base(v.ImportPath) + "." + v.FuncName
+}
+
+// fieldSummaries returns a "Name: rules" line per validated field, with the fields
+// of anonymous structs named by their path, e.g. "Shipping.Street: required"
+func fieldSummaries(fields []*FieldInfo, prefix string) []string {
+	var lines []string
+	for _, field := range fields {
+		if rules := tagSummary(field); rules != "" {
+			lines = append(lines, prefix+field.Name+": "+rules)
+		}
+		if field.Inline != nil {
+			lines = append(lines, fieldSummaries(field.Inline.Fields, prefix+field.Name+".")...)
+		}
+	}
+	return lines
+}
+
+// tagSummary returns the rules of the validate tag of field without their custom
+// messages, e.g. "required, min=3, max=20"
+func tagSummary(field *FieldInfo) string {
+	tag, _, err := splitRuleMessages(extractTag(field.Tag, "validate"))
+	if err != nil || tag == "" {
+		return ""
+	}
+	parts := strings.Split(tag, ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	return strings.Join(parts, ", ")
+}
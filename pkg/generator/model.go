@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/n10ty/houp/pkg/model"
+)
+
+// validationRuleType is the reflect.Type of ValidationRule, used to find nested rules
+var validationRuleType = reflect.TypeOf((*ValidationRule)(nil)).Elem()
+
+// Model converts a parsed package into its public model. Structs are listed in
+// file name order, then in declaration order; structs without validation are left out.
+func Model(pkgInfo *PackageInfo) *model.Package {
+	pkg := &model.Package{
+		Version: model.Version,
+		Name:    pkgInfo.Name,
+		PkgPath: pkgInfo.PkgPath,
+		Dir:     pkgInfo.Path,
+		Structs: []model.Struct{},
+	}
+
+	for _, fileInfo := range sortedFiles(pkgInfo) {
+		for _, s := range fileInfo.Structs {
+			if !s.NeedsGen {
+				continue
+			}
+			ms := model.Struct{
+				Name:       s.Name,
+				File:       filepath.Base(s.SourceFile),
+				Constraint: fileInfo.Constraint,
+				Skip:       s.Skip || fileInfo.Skip,
+				Pagination: s.Pagination,
+				Freeze:     s.Freeze,
+				Fields:     []model.Field{},
+			}
+			for _, v := range s.CustomValidators {
+				ms.Validators = append(ms.Validators, model.Validator{
+					ImportPath:  v.ImportPath,
+					FuncName:    v.FuncName,
+					WithContext: v.WithContext,
+				})
+			}
+			for _, field := range s.Fields {
+				jsonName, _, _ := strings.Cut(field.JSONName, ",")
+				ms.Fields = append(ms.Fields, model.Field{
+					Name:     field.Name,
+					Type:     field.TypeString,
+					JSONName: jsonName,
+					Tag:      extractTag(field.Tag, "validate"),
+					Rules:    modelRules(field.Rules),
+				})
+			}
+			pkg.Structs = append(pkg.Structs, ms)
+		}
+	}
+	return pkg
+}
+
+func modelRules(rules []ValidationRule) []model.Rule {
+	out := make([]model.Rule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, modelRule(rule))
+	}
+	return out
+}
+
+// modelRule reads the exported fields of a rule struct into Params, and nested
+// []ValidationRule fields into Rules, so new rules need no model code of their own.
+// Nested rules tagged model:"name" are grouped under a pseudo-rule of that name.
+func modelRule(rule ValidationRule) model.Rule {
+	mr := model.Rule{Name: rule.Name()}
+
+	v := reflect.ValueOf(rule)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return mr
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		fv := v.Field(i)
+		if !sf.IsExported() || fv.IsZero() {
+			continue
+		}
+		if sf.Type.Kind() == reflect.Slice && sf.Type.Elem() == validationRuleType {
+			nested := modelRules(fv.Interface().([]ValidationRule))
+			// A model tag groups the rules under a pseudo-rule of that name
+			if name := sf.Tag.Get("model"); name != "" {
+				nested = []model.Rule{{Name: name, Rules: nested}}
+			}
+			mr.Rules = append(mr.Rules, nested...)
+			continue
+		}
+		if mr.Params == nil {
+			mr.Params = make(map[string]any)
+		}
+		mr.Params[snakeCase(sf.Name)] = fv.Interface()
+	}
+	return mr
+}
+
+// snakeCase converts a Go field name such as OtherField or ImportPath to other_field
+// or import_path
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a word at a lower-to-upper change, or at the last upper of an
+			// acronym followed by a lower-case letter (URLPath -> url_path)
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/n10ty/houp/pkg/model"
+)
+
+func TestModel(t *testing.T) {
+	pkgInfo, err := ParsePackage(filepath.Join("../../testdata/input", "stats"))
+	if err != nil {
+		t.Fatalf("ParsePackage() failed: %v", err)
+	}
+
+	pkg := Model(pkgInfo)
+	if pkg.Version != model.Version || pkg.Name != "stats" {
+		t.Errorf("Model() = version %d, name %q", pkg.Version, pkg.Name)
+	}
+
+	// Round-trip through JSON so the test sees what external tools see
+	data, err := json.Marshal(pkg)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+	var got model.Package
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+
+	var names []string
+	for _, s := range got.Structs {
+		names = append(names, s.Name)
+	}
+	if diff := cmp.Diff([]string{"Product", "Category"}, names); diff != "" {
+		t.Errorf("struct names mismatch (-want +got):\n%s", diff)
+	}
+
+	want := []model.Field{
+		{
+			Name: "Name", Type: "string", Tag: "required,min=3,max=50",
+			Rules: []model.Rule{
+				{Name: "required"},
+				{Name: "min", Params: map[string]any{"value": "3"}},
+				{Name: "max", Params: map[string]any{"value": "50"}},
+			},
+		},
+		{
+			Name: "SKU", Type: "string", Tag: "required,github.com/n10ty/houp/testdata/input/stats/checks:ValidateSKU",
+			Rules: []model.Rule{
+				{Name: "required"},
+				{Name: "custom", Params: map[string]any{
+					"import_path": "github.com/n10ty/houp/testdata/input/stats/checks",
+					"func_name":   "ValidateSKU",
+				}},
+			},
+		},
+		{
+			Name: "Price", Type: "float64", Tag: "gt=0",
+			Rules: []model.Rule{{Name: "gt", Params: map[string]any{"value": "0"}}},
+		},
+		{
+			Name: "Tags", Type: "[]string", Tag: "omitempty,dive,min=1",
+			Rules: []model.Rule{
+				{Name: "omitempty"},
+				{Name: "dive", Rules: []model.Rule{{Name: "min", Params: map[string]any{"value": "1"}}}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got.Structs[0].Fields); diff != "" {
+		t.Errorf("Product fields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Value":        "value",
+		"OtherField":   "other_field",
+		"ImportPath":   "import_path",
+		"ElementRules": "element_rules",
+		"URLPath":      "url_path",
+		"AllowNil":     "allow_nil",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+)
+
+// generateNamedTypeMethod generates the Validate method of a named slice or map type.
+// Its rules are generated for a struct with a single field of the underlying type,
+// named after the type, in a helper that Validate calls with the value. This way
+// error messages read "field Emails ..." as they would for a struct field.
+func generateNamedTypeMethod(ctx *CodeGenContext) error {
+	if ctx.Struct.NeedsContext {
+		return fmt.Errorf("validators accepting a context.Context are not supported on named type %s", ctx.Struct.Name)
+	}
+	if ctx.TypesInfo == nil {
+		return fmt.Errorf("named type %s requires type information", ctx.Struct.Name)
+	}
+	t := ctx.TypesInfo.TypeOf(ctx.Struct.TypeSpec.Type)
+	if t == nil {
+		return fmt.Errorf("type of %s is unknown", ctx.Struct.Name)
+	}
+
+	name := ctx.Struct.Name
+	wrapper := types.NewStruct([]*types.Var{types.NewField(token.NoPos, nil, name, t, false)}, nil)
+	fn, err := structHelperFunc(ctx, ctx.Struct, "validate"+name, wrapper)
+	if err != nil {
+		return err
+	}
+
+	validateMethodSignature(ctx, validateDoc(ctx))
+	ctx.Buffer = append(ctx.Buffer,
+		fmt.Sprintf("\treturn %s(&%s{%s: %s})", fn, qualifiedTypeString(ctx, wrapper), name, ctx.ReceiverValue()),
+		"}")
+	return nil
+}
@@ -0,0 +1,120 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// Pagination is a parsed //validate:pagination=Page,PerPage,maxPerPage=N comment.
+// It names the page and page size fields of a list request; maxPerPage is optional.
+type Pagination struct {
+	PageField    string
+	PerPageField string
+	MaxPerPage   int64 // 0 for no upper bound
+}
+
+// parsePagination parses the parameter of a //validate:pagination= comment
+func parsePagination(param string) (*Pagination, error) {
+	parts := strings.Split(param, ",")
+	if len(parts) < 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return nil, fmt.Errorf("expected pagination=Page,PerPage[,maxPerPage=N], got: %s", param)
+	}
+	p := &Pagination{
+		PageField:    strings.TrimSpace(parts[0]),
+		PerPageField: strings.TrimSpace(parts[1]),
+	}
+	if p.PageField == p.PerPageField {
+		return nil, fmt.Errorf("page and page size must be different fields, got %s twice", p.PageField)
+	}
+
+	for _, opt := range parts[2:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch name {
+		case "maxPerPage":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("maxPerPage must be a positive integer, got: %s", value)
+			}
+			p.MaxPerPage = n
+		default:
+			return nil, fmt.Errorf("unknown pagination option %q", opt)
+		}
+	}
+	return p, nil
+}
+
+// generatePagination checks that the page is at least 1, that the page size is
+// between 1 and maxPerPage, and that the offset (page-1)*perPage fits in an int,
+// so handlers can compute it without overflow. Both fields must be integers.
+func generatePagination(ctx *CodeGenContext) error {
+	p, err := parsePagination(ctx.Struct.Pagination)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{p.PageField, p.PerPageField} {
+		if err := checkPaginationField(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	recv := ctx.Receiver()
+	page := recv + "." + p.PageField
+	perPage := recv + "." + p.PerPageField
+	ctx.AddImport("math", "math")
+
+	lines := []string{
+		fmt.Sprintf("\t// pagination=%s", ctx.Struct.Pagination),
+		fmt.Sprintf("\tif %s < 1 {", page),
+		fmt.Sprintf("\t\treturn fmt.Errorf(\"field %s must be at least 1\")", p.PageField),
+		"\t}",
+	}
+	if p.MaxPerPage > 0 {
+		lines = append(lines,
+			fmt.Sprintf("\tif %s < 1 || %s > %d {", perPage, perPage, p.MaxPerPage),
+			fmt.Sprintf("\t\treturn fmt.Errorf(\"field %s must be between 1 and %d\")", p.PerPageField, p.MaxPerPage),
+			"\t}")
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("\tif %s < 1 {", perPage),
+			fmt.Sprintf("\t\treturn fmt.Errorf(\"field %s must be at least 1\")", p.PerPageField),
+			"\t}")
+	}
+	// Both values are positive here, so the uint64 conversions are exact
+	lines = append(lines,
+		fmt.Sprintf("\tif uint64(%s-1) > uint64(math.MaxInt)/uint64(%s) {", page, perPage),
+		fmt.Sprintf("\t\treturn fmt.Errorf(\"fields %s and %s give an offset beyond the int range\")", p.PageField, p.PerPageField),
+		"\t}")
+
+	ctx.Buffer = append(ctx.Buffer, lines...)
+	return nil
+}
+
+// checkPaginationField reports an error unless the struct has an integer field name
+func checkPaginationField(ctx *CodeGenContext, name string) error {
+	structType, ok := ctx.Struct.TypeSpec.Type.(*ast.StructType)
+	if !ok {
+		return fmt.Errorf("%s is not a struct", ctx.Struct.Name)
+	}
+	for _, field := range structType.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name != name {
+				continue
+			}
+			if ctx.TypesInfo == nil {
+				return nil
+			}
+			t := ctx.TypesInfo.TypeOf(field.Type)
+			if t == nil {
+				return nil
+			}
+			if basic, ok := t.Underlying().(*types.Basic); ok && basic.Info()&types.IsInteger != 0 {
+				return nil
+			}
+			return fmt.Errorf("field %s must be an integer, got %s", name, t)
+		}
+	}
+	return fmt.Errorf("struct %s has no field %s", ctx.Struct.Name, name)
+}
@@ -1,6 +1,8 @@
 package generator
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"go/ast"
 	"go/parser"
@@ -8,6 +10,7 @@ import (
 	"go/types"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	"golang.org/x/tools/go/packages"
@@ -15,11 +18,28 @@ import (
 
 // ParsePackage parses all Go files in the given directory
 func ParsePackage(pkgPath string) (*PackageInfo, error) {
+	return parsePackage(pkgPath, false, nil)
+}
+
+// ParsePackageWithTests parses the package in the given directory together with
+// its in-package _test.go files. Files of an external test package (package foo_test)
+// are not included.
+func ParsePackageWithTests(pkgPath string) (*PackageInfo, error) {
+	return parsePackage(pkgPath, true, nil)
+}
+
+// parsePackage loads the package in pkgPath for the host build configuration,
+// or for env when it is set. GOOS and GOARCH left empty in env are the host's.
+func parsePackage(pkgPath string, tests bool, env *buildEnv) (*PackageInfo, error) {
 	// Load package with type information
 	cfg := &packages.Config{
 		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
 			packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports,
-		Dir: pkgPath,
+		Dir:   pkgPath,
+		Tests: tests,
+	}
+	if env != nil {
+		cfg.Env, cfg.BuildFlags = env.environ()
 	}
 
 	// Use pattern "." to load the package in the current directory
@@ -28,6 +48,10 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 		return nil, fmt.Errorf("failed to load package: %w", err)
 	}
 
+	if tests {
+		pkgs = selectTestVariant(pkgs)
+	}
+
 	if len(pkgs) == 0 {
 		return nil, fmt.Errorf("no packages found at %s", pkgPath)
 	}
@@ -47,9 +71,11 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 			// Syntax errors contain phrases like "syntax error", "expected", etc.
 			// Module errors contain "outside main module" which can be ignored
 			// Go version errors contain "requires newer Go version" which can be ignored
+			// Type arguments constrained to Validate "do not satisfy" it before generation
 			errStr := err.Error()
 			if !strings.Contains(errStr, "undefined") &&
 				!strings.Contains(errStr, "has no field or method") &&
+				!strings.Contains(errStr, "does not satisfy") &&
 				!strings.Contains(errStr, "not used") &&
 				!strings.Contains(errStr, "outside main module") &&
 				!strings.Contains(errStr, "requires newer Go version") {
@@ -72,7 +98,6 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 	}
 
 	// Parse each file
-	fset := token.NewFileSet()
 	for i, astFile := range pkg.Syntax {
 		var filename string
 		if i < len(pkg.GoFiles) {
@@ -84,19 +109,23 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 			filename = pkg.Fset.File(astFile.Pos()).Name()
 		}
 
-		// Re-parse the file with ParseComments to ensure we get doc comments
-		astFileWithComments, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		// go/packages parses with ParseComments, so doc comments are available here.
+		// Using its AST (rather than re-parsing) keeps field type expressions keyed
+		// in pkg.TypesInfo.
+		astFileWithComments := astFile
+
+		constraint, err := fileConstraint(filename, astFileWithComments)
 		if err != nil {
-			// If re-parsing fails, use the original AST (without comments)
-			astFileWithComments = astFile
+			return nil, err
 		}
 
 		fileInfo := &FileInfo{
-			Name:    filepath.Base(filename),
-			Path:    filename,
-			AST:     astFileWithComments,
-			Structs: []*StructInfo{},
-			Skip:    hasFileSkipAnnotation(astFileWithComments),
+			Name:       filepath.Base(filename),
+			Path:       filename,
+			AST:        astFileWithComments,
+			Structs:    []*StructInfo{},
+			Skip:       hasFileSkipAnnotation(astFileWithComments),
+			Constraint: constraint,
 		}
 
 		// Extract structs from this file
@@ -123,11 +152,6 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 					continue
 				}
 
-				structType, ok := typeSpec.Type.(*ast.StructType)
-				if !ok {
-					continue
-				}
-
 				// Doc comments can be on either GenDecl or TypeSpec
 				// If there's only one spec in the GenDecl, the comment is on GenDecl
 				// If there are multiple specs, each TypeSpec has its own Doc
@@ -141,7 +165,15 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 					prevDeclPos = typeGenDeclPositions[declIndex-1]
 				}
 
-				structInfo := parseStruct(typeSpec, structType, filename, pkg.TypesInfo, genDecl, astFileWithComments.Comments, prevDeclPos)
+				var structInfo *StructInfo
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					structInfo, err = parseStruct(typeSpec, structType, filename, pkg.TypesInfo, genDecl, astFileWithComments.Comments, prevDeclPos)
+				} else {
+					structInfo, err = parseNamedType(typeSpec, filename, genDecl, astFileWithComments.Comments, prevDeclPos)
+				}
+				if err != nil {
+					return nil, err
+				}
 				if structInfo != nil {
 					fileInfo.Structs = append(fileInfo.Structs, structInfo)
 				}
@@ -162,11 +194,39 @@ func ParsePackage(pkgPath string) (*PackageInfo, error) {
 	// will get empty Validate() methods generated
 	discoverAndMarkDiveStructs(pkgInfo)
 
+	// Find validators that accept a context.Context and the structs that need
+	// a ValidateContext method to pass it through
+	resolveContextValidators(pkgInfo, pkg, env)
+
+	// Generated methods use the receiver name of the methods the package declares
+	resolveReceivers(pkgInfo)
+
 	return pkgInfo, nil
 }
 
-// parseStruct extracts struct information including fields and validation tags
-func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename string, typesInfo *types.Info, genDecl *ast.GenDecl, fileComments []*ast.CommentGroup, prevDeclPos token.Pos) *StructInfo {
+// selectTestVariant picks the package to generate for from a Tests-mode load.
+// go/packages returns the plain package "p", its test variant "p [p.test]" (which
+// also holds the in-package _test.go files), the external "p_test [p.test]" package
+// and the "p.test" binary. The test variant is preferred; without test files only
+// the plain package exists.
+func selectTestVariant(pkgs []*packages.Package) []*packages.Package {
+	var plain []*packages.Package
+	for _, pkg := range pkgs {
+		switch pkg.ID {
+		case pkg.PkgPath + " [" + pkg.PkgPath + ".test]":
+			return []*packages.Package{pkg}
+		case pkg.PkgPath:
+			if !strings.HasSuffix(pkg.PkgPath, ".test") {
+				plain = append(plain, pkg)
+			}
+		}
+	}
+	return plain
+}
+
+// parseStruct extracts struct information including fields and validation tags.
+// It returns an error if a field has an invalid validation tag.
+func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename string, typesInfo *types.Info, genDecl *ast.GenDecl, fileComments []*ast.CommentGroup, prevDeclPos token.Pos) (*StructInfo, error) {
 	structInfo := &StructInfo{
 		Name:             typeSpec.Name.Name,
 		TypeSpec:         typeSpec,
@@ -177,12 +237,42 @@ func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename st
 		Skip:             hasStructSkipAnnotation(typeSpec, genDecl, fileComments, prevDeclPos),
 	}
 
-	// Parse struct-level validation comments
-	if typeSpec.Doc != nil {
-		for _, comment := range typeSpec.Doc.List {
+	// Parse struct-level validation comments. A lone type declaration keeps its
+	// doc comment on the GenDecl rather than the TypeSpec.
+	doc := typeSpec.Doc
+	if doc == nil && genDecl != nil && len(genDecl.Specs) == 1 {
+		doc = genDecl.Doc
+	}
+	if doc != nil {
+		for _, comment := range doc.List {
 			text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+			// //validate:pagination=Page,PerPage,... is checked when generating
+			if param, ok := strings.CutPrefix(text, "validate:pagination="); ok {
+				structInfo.Pagination = strings.TrimSpace(param)
+				structInfo.NeedsGen = true
+				continue
+			}
+			if text == "validate:freeze" {
+				structInfo.Freeze = true
+				structInfo.NeedsGen = true
+				continue
+			}
+			// //validate:method=Name is checked when generating
+			if name, ok := strings.CutPrefix(text, "validate:method="); ok {
+				structInfo.Method = strings.TrimSpace(name)
+				continue
+			}
+			// //validate:receiver=name is checked when generating
+			if name, ok := strings.CutPrefix(text, "validate:receiver="); ok {
+				structInfo.Receiver = strings.TrimSpace(name)
+				continue
+			}
+			if text == "validate:multierror" {
+				structInfo.MultiError = true
+				continue
+			}
 			// Look for //validate:pkg/path:FuncName
-			if strings.HasPrefix(text, "validate:") {
+			if strings.HasPrefix(text, "validate:") && text != "validate:skip" {
 				validatorStr := strings.TrimPrefix(text, "validate:")
 				validatorStr = strings.TrimSpace(validatorStr)
 
@@ -195,8 +285,80 @@ func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename st
 		}
 	}
 
+	if err := parseStructFields(structInfo, structType); err != nil {
+		return nil, err
+	}
+	return structInfo, nil
+}
+
+// parseNamedType parses a named slice or map type whose doc comment lists its rules,
+// e.g. //validate:min=1,dive,email above type Emails []string. The rules are held by
+// a single field named after the type. It returns nil for other types, generic
+// types, and types without rules, and an error if the rules are invalid.
+func parseNamedType(typeSpec *ast.TypeSpec, filename string, genDecl *ast.GenDecl, fileComments []*ast.CommentGroup, prevDeclPos token.Pos) (*StructInfo, error) {
+	if typeSpec.TypeParams != nil || typeSpec.Assign.IsValid() {
+		return nil, nil
+	}
+	switch t := typeSpec.Type.(type) {
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return nil, nil
+		}
+	case *ast.MapType:
+	default:
+		return nil, nil
+	}
+
+	doc := typeSpec.Doc
+	if doc == nil && genDecl != nil && len(genDecl.Specs) == 1 {
+		doc = genDecl.Doc
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	var validateTag string
+	for _, comment := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		if param, ok := strings.CutPrefix(text, "validate:"); ok && text != "validate:skip" {
+			validateTag = strings.TrimSpace(param)
+			break
+		}
+	}
+	if validateTag == "" {
+		return nil, nil
+	}
+	rules, err := parseValidationRules(validateTag)
+	if err != nil {
+		return nil, fmt.Errorf("type %s: %w", typeSpec.Name.Name, err)
+	}
+	_, messages, _ := splitRuleMessages(validateTag)
+
+	name := typeSpec.Name.Name
+	return &StructInfo{
+		Name:     name,
+		TypeSpec: typeSpec,
+		Fields: []*FieldInfo{{
+			Name:       name,
+			Type:       typeSpec.Type,
+			TypeString: types.ExprString(typeSpec.Type),
+			Tag:        fmt.Sprintf("validate:%q", validateTag),
+			Rules:      rules,
+			Messages:   messages,
+		}},
+		NeedsGen:         true,
+		SourceFile:       filepath.Base(filename),
+		CustomValidators: []CustomValidator{},
+		Skip:             hasStructSkipAnnotation(typeSpec, genDecl, fileComments, prevDeclPos),
+		NonStruct:        true,
+	}, nil
+}
+
+// parseStructFields adds the exported fields of structType that have validation
+// tags, or hold an anonymous struct with validated fields, to structInfo. It
+// returns an error naming the field if a validation tag is invalid.
+func parseStructFields(structInfo *StructInfo, structType *ast.StructType) error {
 	if structType.Fields == nil {
-		return structInfo
+		return nil
 	}
 
 	for _, field := range structType.Fields.List {
@@ -207,21 +369,20 @@ func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename st
 
 		fieldName := field.Names[0].Name
 
-		// Skip unexported fields
-		if !ast.IsExported(fieldName) {
+		// Skip unexported fields and fields opting out of validation
+		if !ast.IsExported(fieldName) || isSkippedField(field) {
 			continue
 		}
 
-		var tag string
-		if field.Tag != nil {
-			tag = field.Tag.Value
-			// Remove backticks
-			tag = strings.Trim(tag, "`")
-		}
+		tag := fieldTag(field)
 
 		// Parse validation tag
 		validateTag := extractTag(tag, "validate")
-		if validateTag == "" {
+		inline, err := parseInlineStruct(structInfo, fieldName, field.Type)
+		if err != nil {
+			return err
+		}
+		if validateTag == "" && inline == nil {
 			continue // No validation for this field
 		}
 
@@ -231,22 +392,62 @@ func parseStruct(typeSpec *ast.TypeSpec, structType *ast.StructType, filename st
 			TypeString: types.ExprString(field.Type),
 			Tag:        tag,
 			JSONName:   extractTag(tag, "json"),
+			Inline:     inline,
+			Message:    extractTag(tag, "errmsg"),
 		}
 
 		// Parse validation rules
 		rules, err := parseValidationRules(validateTag)
 		if err != nil {
-			// Skip fields with invalid validation tags for now
-			// Will be handled by validator
-			continue
+			return fmt.Errorf("%s.%s: %w", structInfo.Name, fieldName, err)
 		}
 
 		fieldInfo.Rules = rules
+		_, fieldInfo.Messages, _ = splitRuleMessages(validateTag)
 		structInfo.Fields = append(structInfo.Fields, fieldInfo)
 		structInfo.NeedsGen = true
 	}
+	return nil
+}
 
-	return structInfo
+// parseInlineStruct parses the anonymous struct type of a field, directly or as the
+// element of a pointer, slice or map. It returns nil if the field holds no
+// anonymous struct or its fields have no validation, and an error if a field tag
+// is invalid. The result is named after the path of the field, e.g.
+// "Order.Shipping".
+func parseInlineStruct(parent *StructInfo, fieldName string, expr ast.Expr) (*StructInfo, error) {
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+			continue
+		case *ast.ArrayType:
+			if t.Len != nil {
+				return nil, nil // dive does not loop over arrays of structs
+			}
+			expr = t.Elt
+			continue
+		case *ast.MapType:
+			expr = t.Value
+			continue
+		case *ast.StructType:
+			name := parent.Name + "." + fieldName
+			inline := &StructInfo{
+				Name:       name,
+				TypeSpec:   &ast.TypeSpec{Name: ast.NewIdent(name), Type: t},
+				Fields:     []*FieldInfo{},
+				SourceFile: parent.SourceFile,
+			}
+			if err := parseStructFields(inline, t); err != nil {
+				return nil, err
+			}
+			if !inline.NeedsGen {
+				return nil, nil
+			}
+			return inline, nil
+		}
+		return nil, nil
+	}
 }
 
 // extractTag extracts a specific tag value from struct tag
@@ -261,9 +462,52 @@ func parseValidationRules(validateTag string) ([]ValidationRule, error) {
 		return nil, nil
 	}
 
+	validateTag, _, err := splitRuleMessages(validateTag)
+	if err != nil {
+		return nil, err
+	}
+	return parseDiveParts(mergeRuleOptions(strings.Split(validateTag, ",")))
+}
+
+// splitRuleMessages removes the custom error messages of rule~message parts from a
+// validate tag. It returns the remaining tag and the messages by rule name; a
+// message after an option belongs to the option's rule.
+func splitRuleMessages(validateTag string) (string, map[string]string, error) {
+	if !strings.Contains(validateTag, "~") {
+		return validateTag, nil, nil
+	}
+
 	parts := strings.Split(validateTag, ",")
-	rules := make([]ValidationRule, 0, len(parts))
+	messages := make(map[string]string)
+	var prevName string
+	for i, part := range parts {
+		rule, message, hasMessage := strings.Cut(part, "~")
+		name, _, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if containsString(ruleOptions[prevName], name) {
+			name = prevName
+		}
+		prevName = name
+		if !hasMessage {
+			continue
+		}
+
+		switch {
+		case message == "":
+			return "", nil, fmt.Errorf("rule %s has an empty message", name)
+		case name == "dive" || name == "omitempty" || containsString(stringLengthOptions, name):
+			return "", nil, fmt.Errorf("%s takes no message", name)
+		case messages[name] != "" && messages[name] != message:
+			return "", nil, fmt.Errorf("rule %s has conflicting messages %q and %q", name, messages[name], message)
+		}
+		messages[name] = message
+		parts[i] = rule
+	}
+	return strings.Join(parts, ","), messages, nil
+}
 
+// parseDiveParts parses a list of rule strings that may contain dive. The rules
+// after dive, which may dive again into nested slices, become its element rules.
+func parseDiveParts(parts []string) ([]ValidationRule, error) {
 	// Find the index of 'dive' if present
 	diveIndex := -1
 	for i, part := range parts {
@@ -276,56 +520,248 @@ func parseValidationRules(validateTag string) ([]ValidationRule, error) {
 	// If dive is found, split rules into pre-dive and post-dive
 	if diveIndex >= 0 {
 		// Parse pre-dive rules
-		for i := 0; i < diveIndex; i++ {
-			part := strings.TrimSpace(parts[i])
-			if part == "" {
-				continue
-			}
+		rules, err := parseRuleParts(parts[:diveIndex])
+		if err != nil {
+			return nil, err
+		}
 
-			rule, err := parseValidationRule(part)
-			if err != nil {
+		// Map keys: dive,keys,<key rules>,endkeys,<value rules>
+		keyParts, valueParts, err := splitKeyRules(parts[diveIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+		var keyRules []ValidationRule
+		if len(keyParts) > 0 {
+			if keyRules, err = parseRuleParts(keyParts); err != nil {
 				return nil, err
 			}
-			rules = append(rules, rule)
 		}
 
 		// Parse post-dive rules (rules that apply to each element)
-		var elementRules []ValidationRule
-		for i := diveIndex + 1; i < len(parts); i++ {
-			part := strings.TrimSpace(parts[i])
-			if part == "" {
-				continue
-			}
-
-			rule, err := parseValidationRule(part)
-			if err != nil {
-				return nil, err
-			}
-			elementRules = append(elementRules, rule)
+		elementRules, err := parseDiveParts(valueParts)
+		if err != nil {
+			return nil, err
 		}
 
 		// Add the dive rule with element rules
-		rules = append(rules, &DiveRule{ElementRules: elementRules})
+		rules = append(rules, &DiveRule{ElementRules: elementRules, KeyRules: keyRules})
 
 		return rules, nil
 	}
 
+	if containsString(parts, "keys") || containsString(parts, "endkeys") {
+		return nil, fmt.Errorf("keys must directly follow dive")
+	}
+
 	// No dive tag, parse all rules normally
+	return parseRuleParts(parts)
+}
+
+// splitKeyRules splits the rules after dive into the map key rules between keys and
+// endkeys, and the rules for each value. Without keys, all rules are value rules.
+func splitKeyRules(parts []string) (keyParts, valueParts []string, err error) {
+	if len(parts) == 0 || strings.TrimSpace(parts[0]) != "keys" {
+		// keys after a nested dive belong to that dive
+		ownParts := parts
+		for i, part := range parts {
+			if strings.TrimSpace(part) == "dive" {
+				ownParts = parts[:i]
+				break
+			}
+		}
+		if containsString(ownParts, "keys") || containsString(ownParts, "endkeys") {
+			return nil, nil, fmt.Errorf("keys must directly follow dive")
+		}
+		return nil, parts, nil
+	}
+	for i, part := range parts[1:] {
+		switch strings.TrimSpace(part) {
+		case "endkeys":
+			if i == 0 {
+				return nil, nil, fmt.Errorf("keys needs at least one rule before endkeys")
+			}
+			return parts[1 : i+1], parts[i+2:], nil
+		case "keys", "dive":
+			return nil, nil, fmt.Errorf("%s is not allowed between keys and endkeys", strings.TrimSpace(part))
+		}
+	}
+	return nil, nil, fmt.Errorf("keys without endkeys")
+}
+
+// repeatableRules lists parameterized rules that may appear several times with
+// different parameters on the same field
+var repeatableRules = map[string]bool{
+	"regexp": true,
+}
+
+// parseRuleParts parses a list of rule strings. Identical rules are applied once;
+// a parameterized rule repeated with a different parameter is an error.
+func parseRuleParts(parts []string) ([]ValidationRule, error) {
+	rules := make([]ValidationRule, 0, len(parts))
+	seen := make(map[string]bool)
+	params := make(map[string]string) // rule name -> first parameter
+
+	var lengthOptions []string
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if part == "" {
+		if part == "" || seen[part] {
 			continue
 		}
+		seen[part] = true
+
+		// trim and runes are options of the length rules, not rules of their own
+		if containsString(stringLengthOptions, part) {
+			lengthOptions = append(lengthOptions, part)
+			continue
+		}
+
+		if name, param, ok := strings.Cut(part, "="); ok && !repeatableRules[name] {
+			if prev, exists := params[name]; exists {
+				return nil, fmt.Errorf("duplicate %s rule with conflicting parameters %q and %q", name, prev, param)
+			}
+			params[name] = param
+		}
 
 		rule, err := parseValidationRule(part)
 		if err != nil {
 			return nil, err
 		}
-
 		rules = append(rules, rule)
 	}
 
-	return rules, nil
+	for _, option := range lengthOptions {
+		if err := applyLengthOption(rules, option); err != nil {
+			return nil, err
+		}
+	}
+
+	return foldNumericBounds(rules), nil
+}
+
+// foldNumericBounds moves gt/gte/lt/lte rules into a numeric rule of the same list,
+// so that a numeric string is parsed once and then compared against every bound
+func foldNumericBounds(rules []ValidationRule) []ValidationRule {
+	var numeric *NumericRule
+	for _, rule := range rules {
+		if r, ok := rule.(*NumericRule); ok {
+			numeric = r
+			break
+		}
+	}
+	if numeric == nil {
+		return rules
+	}
+
+	kept := rules[:0]
+	for _, rule := range rules {
+		switch rule.(type) {
+		case *GTRule, *GTERule, *LTRule, *LTERule:
+			numeric.Bounds = append(numeric.Bounds, rule)
+		default:
+			kept = append(kept, rule)
+		}
+	}
+	return kept
+}
+
+// stringLengthOptions are the options that change how min, max and len measure strings
+var stringLengthOptions = []string{"runes", "trim"}
+
+// applyLengthOption sets a string length option on the min, max and len rules of a field:
+// trim measures strings.TrimSpace of the value, runes counts runes instead of bytes
+func applyLengthOption(rules []ValidationRule, option string) error {
+	applied := false
+	for _, rule := range rules {
+		switch r := rule.(type) {
+		case *MinRule:
+			r.Trim = r.Trim || option == "trim"
+			r.Runes = r.Runes || option == "runes"
+			applied = true
+		case *MaxRule:
+			r.Trim = r.Trim || option == "trim"
+			r.Runes = r.Runes || option == "runes"
+			applied = true
+		case *LenRule:
+			r.Trim = r.Trim || option == "trim"
+			r.Runes = r.Runes || option == "runes"
+			applied = true
+		}
+	}
+	if !applied {
+		return fmt.Errorf("%s option requires a min, max or len rule", option)
+	}
+	return nil
+}
+
+// ruleOptions lists the options each rule accepts as separate tag parts
+var ruleOptions = map[string][]string{
+	"datetime": {"lang"},
+	"eqfield":  {"using"},
+	"unixts":   {"from", "to"},
+}
+
+// supportedRules lists every rule name parseValidationRule accepts. Options that
+// modify another rule (see ruleOptions and stringLengthOptions) are listed by
+// SupportedOptions.
+var supportedRules = []string{
+	"base64", "bcp47", "bic", "boolean", "cron", "datauri", "datetime", "dive", "duration",
+	"email", "eqfield", "finite", "future", "gt", "gte", "gtefield", "gtfield", "iban",
+	"isbn", "isbn10", "isbn13", "iso3166_1_alpha2", "iso3166_1_alpha3", "iso3166_1_numeric",
+	"iso4217", "iso639_1", "iso639_2", "json", "jsonof", "latitude", "len", "longitude",
+	"lt", "lte", "ltefield", "ltfield", "max", "md5", "min", "mongodb", "no_control_chars",
+	"numeric", "omitempty", "oneof", "past", "postcode_iso3166_alpha2", "printable",
+	"regexp", "required", "required_without", "semver", "sha1", "sha256", "sha512",
+	"subsetof", "timezone", "ulid", "unique", "unixts", "uuid", "uuid3", "uuid4", "uuid5",
+	"uuid_rfc4122",
+}
+
+// SupportedRules returns the names of the built-in validation rules, sorted
+func SupportedRules() []string {
+	return append([]string(nil), supportedRules...)
+}
+
+// SupportedOptions returns the names of the tag options that modify another rule,
+// such as trim for min/max or using for eqfield, sorted
+func SupportedOptions() []string {
+	options := append([]string(nil), stringLengthOptions...)
+	for _, opts := range ruleOptions {
+		options = append(options, opts...)
+	}
+	sort.Strings(options)
+	return options
+}
+
+// TagSetVersion identifies the built-in rules and options by the first 8 hex digits
+// of a SHA-256 of their names. It changes whenever one is added or removed, so the
+// header of a generated file tells which tags the generator understood.
+func TagSetVersion() string {
+	sum := sha256.Sum256([]byte(strings.Join(supportedRules, ",") + ";" + strings.Join(SupportedOptions(), ",")))
+	return hex.EncodeToString(sum[:4])
+}
+
+// mergeRuleOptions joins option parts (e.g. "using=pkg:Equal") onto the rule they
+// follow, so "eqfield=Other,using=pkg:Equal" is parsed as a single rule. A rule
+// without a parameter gets an empty one first: "unixts,from=2020-01-01" becomes
+// "unixts=,from=2020-01-01".
+func mergeRuleOptions(parts []string) []string {
+	merged := make([]string, 0, len(parts))
+	for _, part := range parts {
+		trimmed := strings.TrimSpace(part)
+		if len(merged) > 0 {
+			prev := strings.TrimSpace(merged[len(merged)-1])
+			ruleName, _, _ := strings.Cut(prev, "=")
+			optName, _, isOption := strings.Cut(trimmed, "=")
+			if isOption && containsString(ruleOptions[ruleName], optName) {
+				if !strings.Contains(prev, "=") {
+					prev += "="
+				}
+				merged[len(merged)-1] = prev + "," + trimmed
+				continue
+			}
+		}
+		merged = append(merged, part)
+	}
+	return merged
 }
 
 // parseValidationRule parses a single validation rule string
@@ -338,6 +774,20 @@ func parseValidationRule(ruleStr string) (ValidationRule, error) {
 		param = parts[1]
 	}
 
+	// Numeric bounds accept any Go number literal; they are normalized at generation time.
+	// gt, lt, gte and lte also accept "now" to compare a time.Time with the current time.
+	switch ruleName {
+	case "gt", "lt", "gte", "lte":
+		if param == timeBoundNow {
+			break
+		}
+		fallthrough
+	case "min", "max", "len":
+		if _, err := parseNumericBound(param); err != nil {
+			return nil, fmt.Errorf("%s rule: %w", ruleName, err)
+		}
+	}
+
 	switch ruleName {
 	case "required":
 		return &RequiredRule{}, nil
@@ -347,16 +797,22 @@ func parseValidationRule(ruleStr string) (ValidationRule, error) {
 		}
 		return &RequiredWithoutRule{OtherField: param}, nil
 	case "eqfield":
-		if param == "" {
-			return nil, fmt.Errorf("eqfield rule requires a field name parameter")
+		return parseEqFieldRule(param)
+	case "gtfield", "gtefield", "ltfield", "ltefield":
+		if !token.IsIdentifier(param) {
+			return nil, fmt.Errorf("%s rule requires a field name parameter", ruleName)
 		}
-		return &EqFieldRule{OtherField: param}, nil
+		return &FieldCompareRule{Op: strings.TrimSuffix(ruleName, "field"), OtherField: param}, nil
+	case "using":
+		return nil, fmt.Errorf("using option must follow an eqfield rule")
 	case "omitempty":
 		return &OmitEmptyRule{}, nil
 	case "min":
 		return &MinRule{Value: param}, nil
 	case "max":
 		return &MaxRule{Value: param}, nil
+	case "len":
+		return &LenRule{Value: param}, nil
 	case "gt":
 		return &GTRule{Value: param}, nil
 	case "lt":
@@ -365,28 +821,106 @@ func parseValidationRule(ruleStr string) (ValidationRule, error) {
 		return &GTERule{Value: param}, nil
 	case "lte":
 		return &LTERule{Value: param}, nil
+	case "future":
+		return &FutureRule{}, nil
+	case "past":
+		return &PastRule{}, nil
 	case "regexp":
 		return parseRegexpRule(param)
 	case "unique":
 		if param == "" {
 			return &UniqueRule{}, nil
 		}
-		return &UniqueRule{FieldName: param}, nil
+		// unique=A+B enforces uniqueness of the combination of fields
+		names := strings.Split(param, "+")
+		seen := make(map[string]bool, len(names))
+		for _, name := range names {
+			if name == "" {
+				return nil, fmt.Errorf("unique rule has an empty field name in %q", param)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("unique rule lists field %s more than once", name)
+			}
+			seen[name] = true
+		}
+		return &UniqueRule{FieldNames: names}, nil
 	case "dive":
 		return &DiveRule{}, nil
 	case "datetime":
-		if param == "" {
-			return nil, fmt.Errorf("datetime rule requires a format parameter")
-		}
-		return &DateTimeRule{Format: param}, nil
-	case "uuid":
-		return &UUIDRule{}, nil
+		return parseDateTimeRule(param)
+	case "semver":
+		return &SemverRule{}, nil
+	case "boolean":
+		return &BooleanRule{}, nil
+	case "duration":
+		return &DurationRule{}, nil
+	case "unixts":
+		return parseUnixTSRule(param)
+	case "timezone":
+		return &TimezoneRule{}, nil
+	case "uuid", "uuid3", "uuid4", "uuid5", "uuid_rfc4122":
+		return parseUUIDRule(ruleName, param)
+	case "ulid":
+		return &ULIDRule{}, nil
 	case "iso4217":
 		return &ISO4217Rule{}, nil
 	case "email":
 		return &EmailRule{}, nil
 	case "iso3166_1_alpha2":
 		return &ISO3166_1_Alpha2Rule{}, nil
+	case "iso3166_1_alpha3":
+		return &ISO3166_1_Alpha3Rule{}, nil
+	case "iso3166_1_numeric":
+		return &ISO3166_1_NumericRule{}, nil
+	case "iso639_1":
+		return &ISO639_1Rule{}, nil
+	case "iso639_2":
+		return &ISO639_2Rule{}, nil
+	case "bcp47":
+		return &BCP47Rule{}, nil
+	case "isbn":
+		return &ISBNRule{}, nil
+	case "isbn10":
+		return &ISBNRule{Version: 10}, nil
+	case "isbn13":
+		return &ISBNRule{Version: 13}, nil
+	case "finite":
+		return &FiniteRule{}, nil
+	case "cron":
+		return &CronRule{}, nil
+	case "numeric":
+		return &NumericRule{}, nil
+	case "iban":
+		return &IBANRule{}, nil
+	case "bic":
+		return &BICRule{}, nil
+	case "mongodb":
+		return &MongoDBRule{}, nil
+	case "md5", "sha1", "sha256", "sha512":
+		return &HashRule{Algorithm: ruleName}, nil
+	case "printable":
+		return &PrintableRule{}, nil
+	case "no_control_chars":
+		return &PrintableRule{ControlOnly: true}, nil
+	case "base64":
+		return &Base64Rule{}, nil
+	case "datauri":
+		return &DataURIRule{}, nil
+	case "json":
+		return &JSONRule{}, nil
+	case "jsonof":
+		return parseJSONOfRule(param)
+	case "oneof", "subsetof":
+		return parseOneOfRule(ruleName, param)
+	case "postcode_iso3166_alpha2":
+		if param == "" {
+			return nil, fmt.Errorf("postcode_iso3166_alpha2 rule requires a country field parameter")
+		}
+		return &PostcodeRule{CountryField: param}, nil
+	case "latitude":
+		return &CoordinateRule{}, nil
+	case "longitude":
+		return &CoordinateRule{Longitude: true}, nil
 	default:
 		// Check if it's a custom validator (contains ':')
 		if strings.Contains(ruleStr, ":") {
@@ -413,6 +947,162 @@ func parseRegexpRule(param string) (ValidationRule, error) {
 	}, nil
 }
 
+// parseOneOfRule parses oneof and subsetof, whose values are separated by spaces.
+// Values are checked against the field type at generation time.
+func parseOneOfRule(ruleName, param string) (ValidationRule, error) {
+	values := strings.Fields(param)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s rule requires space-separated values", ruleName)
+	}
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] {
+			return nil, fmt.Errorf("%s rule lists %s more than once", ruleName, v)
+		}
+		seen[v] = true
+	}
+	if ruleName == "subsetof" {
+		return &SubsetOfRule{Values: values}, nil
+	}
+	return &OneOfRule{Values: values}, nil
+}
+
+// parseJSONOfRule parses jsonof=pkg/path:Type, or jsonof=Type for a type of the
+// current package
+func parseJSONOfRule(param string) (ValidationRule, error) {
+	importPath, typeName, ok := strings.Cut(param, ":")
+	if !ok {
+		importPath, typeName = "", param
+	}
+	if !token.IsIdentifier(typeName) || (ok && importPath == "") {
+		return nil, fmt.Errorf("jsonof rule must be in format pkg/path:Type or Type, got: %s", param)
+	}
+	return &JSONOfRule{ImportPath: importPath, TypeName: typeName}, nil
+}
+
+// parseUUIDRule parses uuid, uuid3, uuid4, uuid5 and uuid_rfc4122, each optionally
+// followed by =nil to also accept the nil UUID (all zeros)
+func parseUUIDRule(ruleName, param string) (ValidationRule, error) {
+	rule := &UUIDRule{}
+	switch ruleName {
+	case "uuid3":
+		rule.Version = 3
+	case "uuid4":
+		rule.Version = 4
+	case "uuid5":
+		rule.Version = 5
+	case "uuid_rfc4122":
+		rule.AnyVersion = true
+	}
+
+	switch param {
+	case "":
+	case "nil":
+		rule.AllowNil = true
+	default:
+		return nil, fmt.Errorf("%s rule accepts only the nil option, got: %s", ruleName, param)
+	}
+
+	return rule, nil
+}
+
+// parseUnixTSRule parses unixts with an optional unit (s or ms) followed by the
+// from=YYYY-MM-DD and to=YYYY-MM-DD range options
+func parseUnixTSRule(param string) (ValidationRule, error) {
+	parts := strings.Split(param, ",")
+	rule := &UnixTSRule{}
+	switch parts[0] {
+	case "", "s":
+	case "ms":
+		rule.Millis = true
+	default:
+		return nil, fmt.Errorf("unixts unit must be s or ms, got: %s", parts[0])
+	}
+
+	for _, opt := range parts[1:] {
+		name, value, _ := strings.Cut(opt, "=")
+		switch name {
+		case "from":
+			rule.From = value
+		case "to":
+			rule.To = value
+		}
+	}
+
+	// Check the range now so that a bad date fails at parse time
+	if _, _, err := rule.bounds(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// parseDateTimeRule parses datetime=layout1|layout2, optionally followed by
+// lang=xx for layouts with month or weekday names in another language
+func parseDateTimeRule(param string) (ValidationRule, error) {
+	param, options, _ := strings.Cut(param, ",")
+	if param == "" {
+		return nil, fmt.Errorf("datetime rule requires a format parameter")
+	}
+	formats := strings.Split(param, "|")
+	seen := make(map[string]bool, len(formats))
+	for _, format := range formats {
+		if format == "" {
+			return nil, fmt.Errorf("datetime rule has an empty format in %q", param)
+		}
+		if seen[format] {
+			return nil, fmt.Errorf("datetime rule lists format %q more than once", format)
+		}
+		seen[format] = true
+		if err := validateTimeLayout(format); err != nil {
+			return nil, err
+		}
+	}
+	rule := &DateTimeRule{Formats: formats}
+
+	for _, opt := range strings.Split(options, ",") {
+		if lang, ok := strings.CutPrefix(opt, "lang="); ok {
+			rule.Lang = lang
+		}
+	}
+	if rule.Lang != "" {
+		if _, ok := localizedDateNames[rule.Lang]; !ok {
+			return nil, fmt.Errorf("datetime lang must be one of %s, got: %s", strings.Join(dateNameLanguages(), ", "), rule.Lang)
+		}
+		if months, days := layoutNameKinds(formats); !months && !days {
+			return nil, fmt.Errorf("datetime lang option requires a layout with month or weekday names (Jan, January, Mon, Monday)")
+		}
+	}
+	return rule, nil
+}
+
+// parseEqFieldRule parses eqfield in two formats:
+// 1. Other - compare with the == operator
+// 2. Other,using=pkg/path:FuncName (or using=FuncName for the same package) - compare
+// with a func(a, b T) bool equality function
+func parseEqFieldRule(param string) (ValidationRule, error) {
+	otherField, using, hasUsing := strings.Cut(param, ",using=")
+	if otherField == "" {
+		return nil, fmt.Errorf("eqfield rule requires a field name parameter")
+	}
+	if !hasUsing {
+		return &EqFieldRule{OtherField: otherField}, nil
+	}
+
+	if using == "" {
+		return nil, fmt.Errorf("eqfield using option requires a function in format pkg/path:FuncName or FuncName")
+	}
+
+	rule := &EqFieldRule{OtherField: otherField, UsingFunc: using}
+	if importPath, funcName, ok := strings.Cut(using, ":"); ok {
+		if importPath == "" || funcName == "" {
+			return nil, fmt.Errorf("eqfield using option must be in format pkg/path:FuncName, got: %s", using)
+		}
+		rule.UsingImportPath = importPath
+		rule.UsingFunc = funcName
+	}
+	return rule, nil
+}
+
 // parseCustomRule parses custom validator in format pkg/path:FuncName
 func parseCustomRule(ruleStr string) (ValidationRule, error) {
 	parts := strings.SplitN(ruleStr, ":", 2)
@@ -459,6 +1149,13 @@ func ResolveTypeInfo(expr ast.Expr, typesInfo *types.Info) TypeInfo {
 		UnderlyingGo: expr,
 	}
 
+	if typesInfo != nil {
+		if t := typesInfo.TypeOf(expr); t != nil {
+			typeInfo.GoType = t
+			typeInfo.HasValidateMethod = typeInfo.Implements(validatableInterface)
+		}
+	}
+
 	switch t := expr.(type) {
 	case *ast.Ident:
 		// Built-in or named type
@@ -506,10 +1203,13 @@ func ResolveTypeInfo(expr ast.Expr, typesInfo *types.Info) TypeInfo {
 			typeInfo.PkgName = pkgIdent.Name
 			typeInfo.Name = t.Sel.Name
 
-			// Check if this is json.Number
-			if typeInfo.PkgName == "json" && typeInfo.Name == "Number" {
+			// Check if this is json.Number or json.RawMessage
+			switch {
+			case typeInfo.PkgName == "json" && typeInfo.Name == "Number":
 				typeInfo.Kind = TypeJSONNumber
-			} else {
+			case typeInfo.PkgName == "json" && typeInfo.Name == "RawMessage":
+				setRawMessage(&typeInfo)
+			default:
 				typeInfo.Kind = TypeStruct // Assume struct for now
 			}
 
@@ -518,10 +1218,13 @@ func ResolveTypeInfo(expr ast.Expr, typesInfo *types.Info) TypeInfo {
 				if obj := typesInfo.Uses[pkgIdent]; obj != nil {
 					if pkgName, ok := obj.(*types.PkgName); ok {
 						typeInfo.PkgPath = pkgName.Imported().Path()
-						// Double-check if it's json.Number via import path
+						// Double-check json.Number and json.RawMessage via import path
 						if typeInfo.PkgPath == "encoding/json" && typeInfo.Name == "Number" {
 							typeInfo.Kind = TypeJSONNumber
 						}
+						if typeInfo.PkgPath == "encoding/json" && typeInfo.Name == "RawMessage" {
+							setRawMessage(&typeInfo)
+						}
 					}
 				}
 			}
@@ -534,9 +1237,53 @@ func ResolveTypeInfo(expr ast.Expr, typesInfo *types.Info) TypeInfo {
 		typeInfo.Kind = TypeInterface
 	}
 
+	// Named map types such as url.Values or http.Header, and types of other packages
+	// defined as numbers or strings, such as time.Duration
+	if typeInfo.GoType != nil && (typeInfo.Kind == TypeUnknown || typeInfo.Kind == TypeStruct) {
+		switch u := typeInfo.GoType.Underlying().(type) {
+		case *types.Map:
+			typeInfo.Kind = TypeMap
+		case *types.Basic:
+			if kind := getTypeKindFromBasic(u.Kind()); kind != TypeUnknown {
+				typeInfo.Kind = kind
+			}
+		}
+	}
+
 	return typeInfo
 }
 
+// setRawMessage makes a json.RawMessage a byte slice, so that required and the
+// length rules check it like []byte
+func setRawMessage(typeInfo *TypeInfo) {
+	typeInfo.Kind = TypeSlice
+	typeInfo.IsSlice = true
+	typeInfo.Elem = &TypeInfo{Name: "byte", Kind: TypeUint8}
+}
+
+// diveTargetName returns the name of the type whose Validate method a dive calls:
+// the field's type, the pointee, or the element or value type of a slice or map
+func diveTargetName(typeInfo TypeInfo, typesInfo *types.Info) string {
+	switch {
+	case typeInfo.IsPointer && typeInfo.Elem != nil:
+		return typeInfo.Elem.Name
+	case typeInfo.IsSlice && typeInfo.Elem != nil:
+		typeInfo = *typeInfo.Elem
+	case typeInfo.Kind == TypeMap:
+		_, valueType, err := mapValueType(typeInfo, typesInfo, "")
+		if err != nil {
+			return ""
+		}
+		typeInfo = valueType
+	default:
+		return typeInfo.Name
+	}
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		return typeInfo.Elem.Name
+	}
+	return typeInfo.Name
+}
+
 // getTypeKind returns the TypeKind for a built-in type name
 func getTypeKind(name string) TypeKind {
 	switch name {
@@ -648,11 +1395,6 @@ func ParseFile(filename string) (*FileInfo, error) {
 				continue
 			}
 
-			structType, ok := typeSpec.Type.(*ast.StructType)
-			if !ok {
-				continue
-			}
-
 			// Doc comments can be on either GenDecl or TypeSpec
 			if typeSpec.Doc == nil && len(genDecl.Specs) == 1 {
 				typeSpec.Doc = genDecl.Doc
@@ -664,7 +1406,15 @@ func ParseFile(filename string) (*FileInfo, error) {
 				prevDeclPos = typeGenDeclPositions[declIndex-1]
 			}
 
-			structInfo := parseStruct(typeSpec, structType, filename, nil, genDecl, astFile.Comments, prevDeclPos)
+			var structInfo *StructInfo
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				structInfo, err = parseStruct(typeSpec, structType, filename, nil, genDecl, astFile.Comments, prevDeclPos)
+			} else {
+				structInfo, err = parseNamedType(typeSpec, filename, genDecl, astFile.Comments, prevDeclPos)
+			}
+			if err != nil {
+				return nil, err
+			}
 			if structInfo != nil {
 				fileInfo.Structs = append(fileInfo.Structs, structInfo)
 			}
@@ -693,27 +1443,26 @@ func discoverAndMarkDiveStructs(pkgInfo *PackageInfo) {
 		for _, structInfo := range fileInfo.Structs {
 			for _, field := range structInfo.Fields {
 				for _, rule := range field.Rules {
-					if _, ok := rule.(*DiveRule); ok {
+					if dive, ok := rule.(*DiveRule); ok {
 						// Extract type name from field
 						typeInfo := ResolveTypeInfo(field.Type, pkgInfo.TypesInfo)
+						markNamedTypeDive(dive, typeInfo, allStructs)
+						typeInfo = nestedDiveType(dive, typeInfo, pkgInfo.TypesInfo)
 
-						var typeName string
-						if typeInfo.IsPointer && typeInfo.Elem != nil {
-							typeName = typeInfo.Elem.Name
-						} else if typeInfo.IsSlice && typeInfo.Elem != nil {
-							if typeInfo.Elem.IsPointer && typeInfo.Elem.Elem != nil {
-								typeName = typeInfo.Elem.Elem.Name
-							} else {
-								typeName = typeInfo.Elem.Name
-							}
-						} else {
-							typeName = typeInfo.Name
-						}
+						typeName := diveTargetName(typeInfo, pkgInfo.TypesInfo)
 
 						if typeName != "" {
 							referencedStructs[typeName] = true
+							if s, ok := allStructs[typeName]; ok {
+								innermostDive(dive).target = s
+							}
 						}
 					}
+					// jsonof calls Validate on a type of the current package
+					if jsonOf, ok := rule.(*JSONOfRule); ok && (jsonOf.ImportPath == "" || jsonOf.ImportPath == pkgInfo.PkgPath) {
+						referencedStructs[jsonOf.TypeName] = true
+						jsonOf.target = allStructs[jsonOf.TypeName]
+					}
 				}
 			}
 		}
@@ -727,6 +1476,88 @@ func discoverAndMarkDiveStructs(pkgInfo *PackageInfo) {
 	}
 }
 
+// innermostDive returns the last dive of dive,dive nesting, which calls the method of
+// the target
+func innermostDive(dive *DiveRule) *DiveRule {
+	for nested := dive.nested(); nested != nil; nested = nested.nested() {
+		dive = nested
+	}
+	return dive
+}
+
+// nestedDiveType follows dive,dive nesting down to the type whose elements the last
+// dive reaches, e.g. []Item for a [][]Item field
+func nestedDiveType(dive *DiveRule, typeInfo TypeInfo, typesInfo *types.Info) TypeInfo {
+	for {
+		nested := dive.nested()
+		if nested == nil {
+			return typeInfo
+		}
+		switch {
+		case typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.IsSlice && typeInfo.Elem.Elem != nil:
+			typeInfo = *typeInfo.Elem.Elem
+		case typeInfo.IsSlice && typeInfo.Elem != nil:
+			typeInfo = *typeInfo.Elem
+		case typeInfo.Kind == TypeMap:
+			_, valueType, err := mapValueType(typeInfo, typesInfo, "")
+			if err != nil {
+				return typeInfo
+			}
+			typeInfo = valueType
+		default:
+			return typeInfo
+		}
+		dive = nested
+	}
+}
+
+// markNamedTypeDive makes a dive without element rules into a field of a named slice
+// or map type with a //validate: comment call the type's Validate method, rather than
+// looping over the elements
+func markNamedTypeDive(dive *DiveRule, typeInfo TypeInfo, allStructs map[string]*StructInfo) {
+	if len(dive.ElementRules) > 0 || len(dive.KeyRules) > 0 {
+		return
+	}
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		typeInfo = *typeInfo.Elem
+	}
+	if _, ok := typeInfo.UnderlyingGo.(*ast.Ident); !ok {
+		return
+	}
+	if s, ok := allStructs[typeInfo.Name]; ok && s.NonStruct {
+		dive.Named = true
+		dive.target = s
+	}
+}
+
+// fieldTag returns the struct tag of a field without its backticks
+func fieldTag(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	return strings.Trim(field.Tag.Value, "`")
+}
+
+// isSkippedField reports whether a field opts out of validation with validate:"-" or
+// a //validate:skip comment above it or at the end of its line. Its tags are not
+// parsed, and anonymous structs it holds are not validated.
+func isSkippedField(field *ast.Field) bool {
+	if extractTag(fieldTag(field), "validate") == "-" {
+		return true
+	}
+	for _, group := range []*ast.CommentGroup{field.Doc, field.Comment} {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			if strings.TrimSpace(strings.TrimPrefix(comment.Text, "//")) == "validate:skip" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // hasFileSkipAnnotation checks if a file has //validate:skip annotation in the package comments
 func hasFileSkipAnnotation(file *ast.File) bool {
 	// Check File.Doc first (comments directly attached to package declaration)
@@ -0,0 +1,201 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// postcodePatterns maps ISO 3166-1 alpha-2 country codes to the format of their postal
+// codes, used by postcode_iso3166_alpha2. Patterns expect upper case and allow a single
+// space where the code is customarily written with one. Countries without a national
+// postal code system are absent, so every value fails for them.
+var postcodePatterns = map[string]string{
+	"AD": `^AD\d{3}$`,
+	"AF": `^\d{4}$`,
+	"AI": `^AI-?2640$`,
+	"AL": `^\d{4}$`,
+	"AM": `^\d{4}$`,
+	"AR": `^[A-Z]?\d{4}(?:[A-Z]{3})?$`,
+	"AS": `^96799(?:-\d{4})?$`,
+	"AT": `^\d{4}$`,
+	"AU": `^\d{4}$`,
+	"AX": `^22\d{3}$`,
+	"AZ": `^(?:AZ ?)?\d{4}$`,
+	"BA": `^\d{5}$`,
+	"BB": `^BB\d{5}$`,
+	"BD": `^\d{4}$`,
+	"BE": `^\d{4}$`,
+	"BG": `^\d{4}$`,
+	"BH": `^\d{3,4}$`,
+	"BL": `^97133$`,
+	"BM": `^[A-Z]{2} ?[A-Z\d]{2}$`,
+	"BN": `^[A-Z]{2} ?\d{4}$`,
+	"BR": `^\d{5}-?\d{3}$`,
+	"BT": `^\d{5}$`,
+	"BY": `^\d{6}$`,
+	"CA": `^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`,
+	"CC": `^6799$`,
+	"CH": `^\d{4}$`,
+	"CL": `^\d{7}$`,
+	"CN": `^\d{6}$`,
+	"CO": `^\d{6}$`,
+	"CR": `^\d{5}$`,
+	"CU": `^\d{5}$`,
+	"CV": `^\d{4}$`,
+	"CX": `^6798$`,
+	"CY": `^\d{4}$`,
+	"CZ": `^\d{3} ?\d{2}$`,
+	"DE": `^\d{5}$`,
+	"DK": `^\d{4}$`,
+	"DO": `^\d{5}$`,
+	"DZ": `^\d{5}$`,
+	"EC": `^\d{6}$`,
+	"EE": `^\d{5}$`,
+	"EG": `^\d{5}$`,
+	"ES": `^\d{5}$`,
+	"ET": `^\d{4}$`,
+	"FI": `^\d{5}$`,
+	"FK": `^FIQQ 1ZZ$`,
+	"FM": `^9694[1-4](?:-\d{4})?$`,
+	"FO": `^\d{3}$`,
+	"FR": `^\d{2} ?\d{3}$`,
+	"GB": `^(?:GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[ABD-HJLNP-UW-Z]{2})$`,
+	"GE": `^\d{4}$`,
+	"GF": `^9[78]3\d{2}$`,
+	"GG": `^GY\d[\dA-Z]? ?\d[ABD-HJLNP-UW-Z]{2}$`,
+	"GI": `^GX11 ?1AA$`,
+	"GL": `^39\d{2}$`,
+	"GN": `^\d{3}$`,
+	"GP": `^9[78][01]\d{2}$`,
+	"GR": `^\d{3} ?\d{2}$`,
+	"GS": `^SIQQ 1ZZ$`,
+	"GT": `^\d{5}$`,
+	"GU": `^969(?:[12]\d|3[12])(?:-\d{4})?$`,
+	"GW": `^\d{4}$`,
+	"HM": `^\d{4}$`,
+	"HR": `^\d{5}$`,
+	"HT": `^\d{4}$`,
+	"HU": `^\d{4}$`,
+	"ID": `^\d{5}$`,
+	"IE": `^(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[\dAC-FHKNPRTV-Y]{4}$`,
+	"IL": `^\d{5}(?:\d{2})?$`,
+	"IM": `^IM\d[\dA-Z]? ?\d[ABD-HJLNP-UW-Z]{2}$`,
+	"IN": `^\d{6}$`,
+	"IO": `^BBND 1ZZ$`,
+	"IQ": `^\d{5}$`,
+	"IR": `^\d{5}-?\d{5}$`,
+	"IS": `^\d{3}$`,
+	"IT": `^\d{5}$`,
+	"JE": `^JE\d[\dA-Z]? ?\d[ABD-HJLNP-UW-Z]{2}$`,
+	"JO": `^\d{5}$`,
+	"JP": `^\d{3}-?\d{4}$`,
+	"KE": `^\d{5}$`,
+	"KG": `^\d{6}$`,
+	"KR": `^\d{5}$`,
+	"KW": `^\d{5}$`,
+	"KY": `^KY\d-\d{4}$`,
+	"KZ": `^\d{6}$`,
+	"LA": `^\d{5}$`,
+	"LB": `^\d{4}(?: ?\d{4})?$`,
+	"LI": `^94(?:8[5-9]|9[0-8])$`,
+	"LK": `^\d{5}$`,
+	"LR": `^\d{4}$`,
+	"LS": `^\d{3}$`,
+	"LT": `^(?:LT-?)?\d{5}$`,
+	"LU": `^(?:L-?)?\d{4}$`,
+	"LV": `^(?:LV-?)?\d{4}$`,
+	"MA": `^\d{5}$`,
+	"MC": `^980\d{2}$`,
+	"MD": `^(?:MD-?)?\d{4}$`,
+	"ME": `^8\d{4}$`,
+	"MF": `^97150$`,
+	"MG": `^\d{3}$`,
+	"MH": `^969[67]\d(?:-\d{4})?$`,
+	"MK": `^\d{4}$`,
+	"MN": `^\d{5}$`,
+	"MP": `^9695[0-2](?:-\d{4})?$`,
+	"MQ": `^9[78]2\d{2}$`,
+	"MT": `^[A-Z]{3} ?\d{2,4}$`,
+	"MU": `^\d{5}$`,
+	"MV": `^\d{5}$`,
+	"MX": `^\d{5}$`,
+	"MY": `^\d{5}$`,
+	"MZ": `^\d{4}$`,
+	"NC": `^988\d{2}$`,
+	"NE": `^\d{4}$`,
+	"NF": `^2899$`,
+	"NG": `^\d{6}$`,
+	"NI": `^\d{5}$`,
+	"NL": `^[1-9]\d{3} ?[A-Z]{2}$`,
+	"NO": `^\d{4}$`,
+	"NP": `^\d{5}$`,
+	"NZ": `^\d{4}$`,
+	"OM": `^\d{3}$`,
+	"PE": `^\d{5}$`,
+	"PF": `^987\d{2}$`,
+	"PG": `^\d{3}$`,
+	"PH": `^\d{4}$`,
+	"PK": `^\d{5}$`,
+	"PL": `^\d{2}-\d{3}$`,
+	"PM": `^97500$`,
+	"PN": `^PCRN 1ZZ$`,
+	"PR": `^00[679]\d{2}(?:-\d{4})?$`,
+	"PT": `^\d{4}-\d{3}$`,
+	"PW": `^96940$`,
+	"RE": `^9[78]4\d{2}$`,
+	"RO": `^\d{6}$`,
+	"RS": `^\d{5}$`,
+	"RU": `^\d{6}$`,
+	"SA": `^\d{5}(?:-?\d{4})?$`,
+	"SD": `^\d{5}$`,
+	"SE": `^\d{3} ?\d{2}$`,
+	"SG": `^\d{6}$`,
+	"SH": `^(?:ASCN|STHL|TDCU) 1ZZ$`,
+	"SI": `^(?:SI-)?\d{4}$`,
+	"SJ": `^\d{4}$`,
+	"SK": `^\d{3} ?\d{2}$`,
+	"SM": `^4789\d$`,
+	"SN": `^\d{5}$`,
+	"SZ": `^[HLMS]\d{3}$`,
+	"TC": `^TKCA 1ZZ$`,
+	"TH": `^\d{5}$`,
+	"TJ": `^\d{6}$`,
+	"TM": `^\d{6}$`,
+	"TN": `^\d{4}$`,
+	"TR": `^\d{5}$`,
+	"TT": `^\d{6}$`,
+	"TW": `^\d{3}(?:\d{2,3})?$`,
+	"TZ": `^\d{5}$`,
+	"UA": `^\d{5}$`,
+	"US": `^\d{5}(?:[ -]\d{4})?$`,
+	"UY": `^\d{5}$`,
+	"UZ": `^\d{6}$`,
+	"VA": `^00120$`,
+	"VC": `^VC\d{4}$`,
+	"VE": `^\d{4}$`,
+	"VI": `^008(?:[0-4]\d|5[01])(?:-\d{4})?$`,
+	"VN": `^\d{6}$`,
+	"WF": `^986\d{2}$`,
+	"YT": `^976\d{2}$`,
+	"ZA": `^\d{4}$`,
+	"ZM": `^\d{5}$`,
+}
+
+// formatPostcodeTable renders postcodePatterns as the body of a package-level
+// map[string]*regexp.Regexp declaration, sorted by country code
+func formatPostcodeTable() string {
+	countries := make([]string, 0, len(postcodePatterns))
+	for country := range postcodePatterns {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+
+	var b strings.Builder
+	b.WriteString(" = map[string]*regexp.Regexp{\n")
+	for _, country := range countries {
+		fmt.Fprintf(&b, "\t%q: regexp.MustCompile(%q),\n", country, postcodePatterns[country])
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// methodIdents are the identifiers generated methods declare or import. A receiver
+// with one of these names would be shadowed, e.g. by the i of a dive loop. Other
+// locals declared by rules are named with LocalVarName and cannot collide.
+var methodIdents = map[string]bool{
+	"i": true, "j": true, "k": true, "elem": true, "key": true, "item": true,
+	"values": true, "layout": true, "field": true, "fields": true, "skip": true,
+	"frozen": true, "err": true, "errs": true, "ctx": true, "ok": true,
+	"fmt": true, "errors": true, "context": true, "regexp": true, "math": true,
+	"time": true, "strings": true, "strconv": true, "reflect": true, "unicode": true,
+	"utf8": true, "json": true, "base64": true, "mime": true, "big": true, "houp": true,
+}
+
+// resolveReceivers gives structs without a //validate:receiver= comment the receiver
+// name of the methods their package already declares on them, so all methods of a
+// type use the same name. Generated files and names generated code would shadow
+// are ignored.
+func resolveReceivers(pkgInfo *PackageInfo) {
+	structs := make(map[string]*StructInfo)
+	for _, fileInfo := range sortedFiles(pkgInfo) {
+		for _, s := range fileInfo.Structs {
+			structs[s.Name] = s
+		}
+	}
+
+	for _, fileInfo := range sortedFiles(pkgInfo) {
+		if fileInfo.AST == nil || isGeneratedFileName(filepath.Base(fileInfo.Path)) {
+			continue
+		}
+		for _, decl := range fileInfo.AST.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 {
+				continue
+			}
+			s, ok := structs[embeddedFieldName(fn.Recv.List[0].Type)]
+			if !ok || s.Receiver != "" {
+				continue
+			}
+			if name := fn.Recv.List[0].Names[0].Name; checkReceiverName(name) == nil {
+				s.Receiver = name
+			}
+		}
+	}
+}
+
+// checkReceiverName reports an error if name cannot be the receiver of generated
+// methods
+func checkReceiverName(name string) error {
+	switch {
+	case !token.IsIdentifier(name) || name == "_":
+		return fmt.Errorf("receiver %q is not a valid identifier", name)
+	case methodIdents[name]:
+		return fmt.Errorf("receiver %q is used by generated code", name)
+	}
+	return nil
+}
+
+// defaultReceiver derives the receiver of the generated methods of the type name
+// from its first letter, lower-cased, e.g. "u" for User. When generated code uses
+// that letter, the prefix grows ("it" for Item); names without letters get "recv".
+func defaultReceiver(name string) string {
+	lower := []rune(strings.ToLower(strings.TrimLeftFunc(name, func(r rune) bool { return !unicode.IsLetter(r) })))
+	for n := 1; n <= len(lower); n++ {
+		if candidate := string(lower[:n]); checkReceiverName(candidate) == nil && !token.IsKeyword(candidate) {
+			return candidate
+		}
+	}
+	return "recv"
+}
+
+// methodName returns the name of the generated validation method of s: the one of
+// its //validate:method= comment, else the MethodName option, else Validate
+func methodName(s *StructInfo, opts *GenerateOptions) string {
+	if s.Method != "" {
+		return s.Method
+	}
+	if opts != nil && opts.MethodName != "" {
+		return opts.MethodName
+	}
+	return "Validate"
+}
+
+// checkMethodName reports an error if name cannot be the generated validation method
+// of s, which gets other methods, such as Valid, and fields of its own
+func checkMethodName(s *StructInfo, name string) error {
+	if !token.IsIdentifier(name) || name == "_" {
+		return fmt.Errorf("method name %q is not a valid identifier", name)
+	}
+	if hasFieldNamed(s, name) || (s.NeedsContext && hasFieldNamed(s, name+"Context")) {
+		return fmt.Errorf("method name %q conflicts with a field of %s", name, s.Name)
+	}
+	switch name {
+	case "Valid", "MustValidate", "ValidateFields", "ValidateExcept", "ValidateAndFreeze", "ValidateContext":
+		return fmt.Errorf("method name %q is used by another generated method", name)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/module"
+)
+
+// runtimeAlias is the name generated code imports the --runtime package as. It is
+// not runtime, so the package can sit next to the standard library's.
+const runtimeAlias = "houpruntime"
+
+// runtimeFuncs maps the helper functions of AddHelperFunc to the functions of the
+// --runtime package that replace them
+var runtimeFuncs = map[string]string{
+	"isISBN10":          "IsISBN10",
+	"isISBN13":          "IsISBN13",
+	"isCron":            "IsCron",
+	"isIBAN":            "IsIBAN",
+	"isPrintable":       "IsPrintable",
+	"hasNoControlChars": "HasNoControlChars",
+	"isDataURI":         "IsDataURI",
+}
+
+// runtimeVars maps the helper variables of AddHelperVar to the variables of the
+// --runtime package that replace them
+var runtimeVars = map[string]string{
+	"iso4217Codes":        "ISO4217Codes",
+	"iso3166Alpha2Codes":  "ISO3166Alpha2Codes",
+	"iso3166Alpha3Codes":  "ISO3166Alpha3Codes",
+	"iso3166NumericCodes": "ISO3166NumericCodes",
+	"iso6391Codes":        "ISO6391Codes",
+	"iso6392Codes":        "ISO6392Codes",
+}
+
+// runtimeRegexps maps the fixed patterns of AddRegexpVar to the regexps of the
+// --runtime package compiled from them
+var runtimeRegexps = map[string]string{
+	emailPattern:  "EmailRegexp",
+	ulidPattern:   "ULIDRegexp",
+	bicPattern:    "BICRegexp",
+	semverPattern: "SemverRegexp",
+	bcp47Pattern:  "BCP47Regexp",
+}
+
+// checkRuntimeOptions reports an error if opts.Runtime is not an import path
+func checkRuntimeOptions(opts *GenerateOptions) error {
+	if opts.Runtime == "" {
+		return nil
+	}
+	if err := module.CheckImportPath(opts.Runtime); err != nil {
+		return fmt.Errorf("runtime package: %w", err)
+	}
+	return nil
+}
+
+// runtimeRef returns a reference to name, exported by the --runtime package, or ""
+// without a runtime package or name
+func (ctx *CodeGenContext) runtimeRef(name string) string {
+	if name == "" || ctx.Options == nil || ctx.Options.Runtime == "" {
+		return ""
+	}
+	return ctx.AddImport(ctx.Options.Runtime, runtimeAlias) + "." + name
+}
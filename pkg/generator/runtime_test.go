@@ -0,0 +1,133 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/n10ty/houp/pkg/isodata"
+	houpruntime "github.com/n10ty/houp/runtime"
+)
+
+// The runtime package must do what the code it replaces does
+
+func TestRuntimeRegexps(t *testing.T) {
+	exported := map[string]*regexp.Regexp{
+		"EmailRegexp":  houpruntime.EmailRegexp,
+		"ULIDRegexp":   houpruntime.ULIDRegexp,
+		"BICRegexp":    houpruntime.BICRegexp,
+		"SemverRegexp": houpruntime.SemverRegexp,
+		"BCP47Regexp":  houpruntime.BCP47Regexp,
+	}
+	for pattern, name := range runtimeRegexps {
+		re, ok := exported[name]
+		if !ok {
+			t.Errorf("runtime package lacks %s", name)
+			continue
+		}
+		if re.String() != pattern {
+			t.Errorf("runtime %s = %q, want %q", name, re.String(), pattern)
+		}
+	}
+}
+
+func TestRuntimeTables(t *testing.T) {
+	exported := map[string]map[string]struct{}{
+		"ISO4217Codes":        houpruntime.ISO4217Codes,
+		"ISO3166Alpha2Codes":  houpruntime.ISO3166Alpha2Codes,
+		"ISO3166Alpha3Codes":  houpruntime.ISO3166Alpha3Codes,
+		"ISO3166NumericCodes": houpruntime.ISO3166NumericCodes,
+		"ISO6391Codes":        houpruntime.ISO6391Codes,
+		"ISO6392Codes":        houpruntime.ISO6392Codes,
+	}
+	// The codes the generator writes into each table
+	generated := map[string][]string{
+		"iso4217Codes":        isodata.Currencies,
+		"iso3166Alpha2Codes":  iso3166Codes(func(c isodata.Country) string { return c.Alpha2 }),
+		"iso3166Alpha3Codes":  iso3166Codes(func(c isodata.Country) string { return c.Alpha3 }),
+		"iso3166NumericCodes": iso3166Codes(func(c isodata.Country) string { return c.Numeric }),
+		"iso6391Codes":        iso639Codes(func(l isodata.Language) []string { return []string{l.Alpha2} }),
+		"iso6392Codes":        iso639Codes(func(l isodata.Language) []string { return []string{l.Alpha3B, l.Alpha3T} }),
+	}
+	for table, name := range runtimeVars {
+		codes, ok := generated[table]
+		if !ok {
+			t.Errorf("no generated codes known for table %s", table)
+			continue
+		}
+		want := append([]string(nil), codes...)
+		sort.Strings(want)
+		var got []string
+		for code := range exported[name] {
+			got = append(got, code)
+		}
+		sort.Strings(got)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("runtime %s mismatch (-want +got):\n%s", name, diff)
+		}
+	}
+}
+
+func TestRuntimeFuncs(t *testing.T) {
+	helpers := map[string]string{
+		"isISBN10":          isbn10Helper,
+		"isISBN13":          isbn13Helper,
+		"isCron":            cronHelper,
+		"isIBAN":            ibanHelper,
+		"isPrintable":       printableHelper,
+		"hasNoControlChars": noControlCharsHelper,
+		"isDataURI":         dataURIHelper,
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join("../../runtime", "checks.go"), nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse runtime package: %v", err)
+	}
+	funcs := make(map[string]*ast.FuncDecl)
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			funcs[fn.Name.Name] = fn
+		}
+	}
+
+	for helper, name := range runtimeFuncs {
+		body, ok := helpers[helper]
+		if !ok {
+			t.Errorf("no source known for helper %s", helper)
+			continue
+		}
+		fn, ok := funcs[name]
+		if !ok {
+			t.Errorf("runtime package lacks %s", name)
+			continue
+		}
+		// Compare the declaration with the comments in its body, but not its doc
+		fn.Doc = nil
+		var got bytes.Buffer
+		if err := format.Node(&got, fset, &printer.CommentedNode{Node: fn, Comments: file.Comments}); err != nil {
+			t.Fatalf("failed to print %s: %v", name, err)
+		}
+		want, err := format.Source([]byte("func " + name + body))
+		if err != nil {
+			t.Fatalf("failed to format helper %s: %v", helper, err)
+		}
+		if diff := cmp.Diff(string(want), got.String()); diff != "" {
+			t.Errorf("runtime %s differs from helper %s (-want +got):\n%s", name, helper, diff)
+		}
+	}
+}
+
+func TestRuntimeOptions(t *testing.T) {
+	source := "type Account struct {\n\tIBAN string `validate:\"iban\"`\n}\n"
+	checkGenerateSource(t, source, GenerateOptions{Runtime: "github.com/n10ty/houp/runtime"}, "houpruntime.IsIBAN(a.IBAN)", "")
+	checkGenerateSource(t, source, GenerateOptions{Runtime: "not a path"}, "", "runtime package")
+}
@@ -0,0 +1,268 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// StatsReport summarizes validation rule usage across one or more packages
+type StatsReport struct {
+	Packages         int            `json:"packages"`
+	Structs          int            `json:"structs"`
+	Fields           int            `json:"fields"`
+	RuleCounts       map[string]int `json:"rule_counts"`  // rule name -> number of uses
+	Combinations     map[string]int `json:"combinations"` // "required,min,max" -> number of fields
+	LargestStructs   []StructStats  `json:"largest_structs"`
+	UnusedValidators []string       `json:"unused_validators"` // "pkg/path:FuncName"
+}
+
+// StructStats describes the validation footprint of a single struct
+type StructStats struct {
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	Fields  int    `json:"fields"` // fields with validation tags
+	Rules   int    `json:"rules"`  // total number of rules, including dive element rules
+}
+
+// RuleCount is a rule name (or combination) paired with its usage count
+type RuleCount struct {
+	Name  string
+	Count int
+}
+
+// ResolvePackageDirs expands package patterns (e.g. "./...") into package directories
+func ResolvePackageDirs(patterns []string) ([]string, error) {
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedFiles}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve packages %v: %w", patterns, err)
+	}
+
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) == 0 {
+			continue
+		}
+		dir := filepath.Dir(pkg.GoFiles[0])
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// CollectStats builds a StatsReport for the given parsed packages.
+// Unused custom validators are looked up in every package referenced by a
+// custom field rule or struct-level validator; any package-level function with
+// a single parameter returning error that no tag references is reported.
+func CollectStats(pkgInfos []*PackageInfo) (*StatsReport, error) {
+	report := &StatsReport{
+		Packages:     len(pkgInfos),
+		RuleCounts:   make(map[string]int),
+		Combinations: make(map[string]int),
+	}
+
+	// import path -> set of referenced function names
+	usedValidators := make(map[string]map[string]bool)
+	markUsed := func(importPath, funcName string) {
+		if usedValidators[importPath] == nil {
+			usedValidators[importPath] = make(map[string]bool)
+		}
+		usedValidators[importPath][funcName] = true
+	}
+
+	var loadDir string
+	for _, pkgInfo := range pkgInfos {
+		if loadDir == "" {
+			loadDir = pkgInfo.Path
+		}
+
+		for _, fileInfo := range sortedFiles(pkgInfo) {
+			for _, structInfo := range fileInfo.Structs {
+				if len(structInfo.Fields) == 0 && len(structInfo.CustomValidators) == 0 {
+					continue
+				}
+
+				report.Structs++
+				structStats := StructStats{
+					Package: pkgInfo.PkgPath,
+					Name:    structInfo.Name,
+					Fields:  len(structInfo.Fields),
+				}
+
+				for _, validator := range structInfo.CustomValidators {
+					importPath := validator.ImportPath
+					if importPath == "" {
+						importPath = pkgInfo.PkgPath
+					}
+					markUsed(importPath, validator.FuncName)
+				}
+
+				for _, field := range structInfo.Fields {
+					report.Fields++
+
+					names := ruleNames(field.Rules)
+					structStats.Rules += len(names)
+					for _, name := range names {
+						report.RuleCounts[name]++
+					}
+					if len(names) > 1 {
+						report.Combinations[strings.Join(names, ",")]++
+					}
+
+					for _, rule := range flattenRules(field.Rules) {
+						if custom, ok := rule.(*CustomRule); ok {
+							markUsed(custom.ImportPath, custom.FuncName)
+						}
+					}
+				}
+
+				report.LargestStructs = append(report.LargestStructs, structStats)
+			}
+		}
+	}
+
+	sort.SliceStable(report.LargestStructs, func(i, j int) bool {
+		a, b := report.LargestStructs[i], report.LargestStructs[j]
+		if a.Rules != b.Rules {
+			return a.Rules > b.Rules
+		}
+		if a.Fields != b.Fields {
+			return a.Fields > b.Fields
+		}
+		return a.Package+"."+a.Name < b.Package+"."+b.Name
+	})
+
+	unused, err := findUnusedValidators(usedValidators, loadDir)
+	if err != nil {
+		return nil, err
+	}
+	report.UnusedValidators = unused
+
+	return report, nil
+}
+
+// SortedRuleCounts returns the entries of a count map ordered by count (descending) and name
+func SortedRuleCounts(counts map[string]int) []RuleCount {
+	result := make([]RuleCount, 0, len(counts))
+	for name, count := range counts {
+		result = append(result, RuleCount{Name: name, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// ruleNames returns the names of all rules on a field in tag order,
+// including rules that follow a dive tag
+func ruleNames(rules []ValidationRule) []string {
+	flat := flattenRules(rules)
+	names := make([]string, 0, len(flat))
+	for _, rule := range flat {
+		names = append(names, rule.Name())
+	}
+	return names
+}
+
+// flattenRules expands dive element rules and bounds folded into numeric into a single rule list
+func flattenRules(rules []ValidationRule) []ValidationRule {
+	var result []ValidationRule
+	for _, rule := range rules {
+		result = append(result, rule)
+		switch r := rule.(type) {
+		case *DiveRule:
+			result = append(result, flattenRules(r.KeyRules)...)
+			result = append(result, flattenRules(r.ElementRules)...)
+		case *NumericRule:
+			result = append(result, r.Bounds...)
+		}
+	}
+	return result
+}
+
+// findUnusedValidators loads every package that hosts a referenced validator and
+// returns validator-shaped functions in those packages that are never referenced
+func findUnusedValidators(used map[string]map[string]bool, dir string) ([]string, error) {
+	if len(used) == 0 {
+		return nil, nil
+	}
+
+	paths := make([]string, 0, len(used))
+	for path := range used {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	cfg := &packages.Config{
+		// NeedSyntax makes go/packages type-check root packages from source,
+		// so validator packages that do not compile yet can still be inspected
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, paths...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load validator packages: %w", err)
+	}
+
+	var unused []string
+	for _, pkg := range pkgs {
+		if pkg.Types == nil {
+			continue
+		}
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			fn, ok := scope.Lookup(name).(*types.Func)
+			if !ok || !isValidatorSignature(fn) {
+				continue
+			}
+			if !used[pkg.PkgPath][name] {
+				unused = append(unused, pkg.PkgPath+":"+name)
+			}
+		}
+	}
+
+	sort.Strings(unused)
+	return unused, nil
+}
+
+// isValidatorSignature reports whether fn has the func(T) error shape used by custom validators
+func isValidatorSignature(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() != nil {
+		return false
+	}
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// sortedFiles returns the files of a package ordered by name for deterministic output
+func sortedFiles(pkgInfo *PackageInfo) []*FileInfo {
+	names := make([]string, 0, len(pkgInfo.Files))
+	for name := range pkgInfo.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make([]*FileInfo, 0, len(names))
+	for _, name := range names {
+		files = append(files, pkgInfo.Files[name])
+	}
+	return files
+}
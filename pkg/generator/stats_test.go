@@ -0,0 +1,53 @@
+package generator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCollectStats(t *testing.T) {
+	pkgInfo, err := ParsePackage(filepath.Join("../../testdata/input", "stats"))
+	if err != nil {
+		t.Fatalf("ParsePackage() failed: %v", err)
+	}
+
+	report, err := CollectStats([]*PackageInfo{pkgInfo})
+	if err != nil {
+		t.Fatalf("CollectStats() failed: %v", err)
+	}
+
+	if report.Structs != 2 {
+		t.Errorf("Structs = %d, want 2", report.Structs)
+	}
+	if report.Fields != 5 {
+		t.Errorf("Fields = %d, want 5", report.Fields)
+	}
+
+	wantRules := map[string]int{
+		"required":  3,
+		"min":       3,
+		"max":       2,
+		"gt":        1,
+		"omitempty": 1,
+		"dive":      1,
+		"custom":    1,
+	}
+	if diff := cmp.Diff(wantRules, report.RuleCounts); diff != "" {
+		t.Errorf("RuleCounts mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := report.Combinations["required,min,max"]; got != 2 {
+		t.Errorf("Combinations[required,min,max] = %d, want 2", got)
+	}
+
+	if len(report.LargestStructs) == 0 || report.LargestStructs[0].Name != "Product" {
+		t.Errorf("LargestStructs[0] = %+v, want Product", report.LargestStructs)
+	}
+
+	wantUnused := []string{"github.com/n10ty/houp/testdata/input/stats/checks:ValidateBarcode"}
+	if diff := cmp.Diff(wantUnused, report.UnusedValidators); diff != "" {
+		t.Errorf("UnusedValidators mismatch (-want +got):\n%s", diff)
+	}
+}
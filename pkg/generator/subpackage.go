@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+)
+
+// checkSubpackageOptions reports an error if opts.Subpackage is not a package name
+// or is combined with options that generate methods, which a package cannot declare
+// on the types of another
+func checkSubpackageOptions(opts *GenerateOptions) error {
+	if opts.Subpackage == "" {
+		return nil
+	}
+	if !token.IsIdentifier(opts.Subpackage) || opts.Subpackage == "_" {
+		return fmt.Errorf("subpackage %q is not a valid package name", opts.Subpackage)
+	}
+	conflicts := []struct {
+		set  bool
+		name string
+	}{
+		{opts.ValidateFields, "ValidateFields"},
+		{opts.ValidateExcept, "ValidateExcept"},
+		{opts.ValidMethod, "Valid"},
+		{opts.MustValidate, "MustValidate"},
+		{opts.Constructors, "constructors"},
+		{opts.ReceiverMode == "value", "value receivers"},
+		{opts.IncludeTests, "test files"},
+	}
+	for _, c := range conflicts {
+		if c.set {
+			return fmt.Errorf("subpackage %s does not support %s", opts.Subpackage, c.name)
+		}
+	}
+	return nil
+}
+
+// outputDir returns the directory generated files of the package in pkgDir are
+// written to: the subpackage's directory in subpackage mode, else pkgDir
+func outputDir(pkgDir string, opts *GenerateOptions) string {
+	if opts.Subpackage == "" {
+		return pkgDir
+	}
+	return filepath.Join(pkgDir, opts.Subpackage)
+}
+
+// validateFunc returns the function that validates s in subpackage mode: its
+// method name followed by its name, e.g. ValidateUser
+func validateFunc(s *StructInfo, opts *GenerateOptions) string {
+	return methodName(s, opts) + s.Name
+}
+
+// LocalRef returns a reference to name, declared in the package being validated,
+// qualified with the package's import in subpackage mode
+func (ctx *CodeGenContext) LocalRef(name string) string {
+	if q := ctx.localQualifier(); q != "" {
+		return q + "." + name
+	}
+	return name
+}
+
+// localQualifier returns the alias the generated code imports the package being
+// validated with, or "" if the code is part of that package
+func (ctx *CodeGenContext) localQualifier() string {
+	if ctx.Options == nil || ctx.Options.Subpackage == "" {
+		return ""
+	}
+	return ctx.AddImport(ctx.PkgPath, ctx.PkgName)
+}
+
+// validateFuncSignature opens the validation function of the current struct in
+// subpackage mode, with doc as its doc comment. Structs with context-aware
+// validators get a Context variant taking the context first, which the function
+// runs with a background context.
+func validateFuncSignature(ctx *CodeGenContext, doc []string) {
+	fn := validateFunc(ctx.Struct, ctx.Options)
+	param := ctx.Receiver() + " *" + ctx.LocalRef(ctx.Struct.Name)
+	ctx.Buffer = append(ctx.Buffer, doc...)
+	if ctx.Struct.NeedsContext {
+		ctx.AddImport("context", "context")
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("func %s(%s) error {", fn, param),
+			fmt.Sprintf("\treturn %sContext(context.Background(), %s)", fn, ctx.Receiver()),
+			"}",
+			"",
+			fmt.Sprintf("// %sContext is %s with ctx passed to the validators and nested structs that take a context.", fn, fn),
+			fmt.Sprintf("func %sContext(ctx context.Context, %s) error {", fn, param))
+	} else {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("func %s(%s) error {", fn, param))
+	}
+}
+
+// checkSubpackageStruct reports an error if the current struct cannot be validated
+// from another package. Unexported fields are never validated.
+func checkSubpackageStruct(ctx *CodeGenContext) error {
+	s := ctx.Struct
+	pkg := ctx.Options.Subpackage
+	if !ast.IsExported(s.Name) {
+		return fmt.Errorf("struct %s is unexported, so package %s cannot validate it", s.Name, pkg)
+	}
+	if s.TypeSpec != nil && s.TypeSpec.TypeParams != nil {
+		return fmt.Errorf("generic struct %s is not supported with subpackage %s", s.Name, pkg)
+	}
+	if s.Freeze {
+		return fmt.Errorf("//validate:freeze is not supported with subpackage %s", pkg)
+	}
+	if ctx.Receiver() == ctx.PkgName {
+		return fmt.Errorf("receiver name %q conflicts with the import of package %s", ctx.Receiver(), ctx.PkgName)
+	}
+	for _, v := range s.CustomValidators {
+		local := v.ImportPath == "" || v.ImportPath == ctx.PkgPath
+		if local && !ast.IsExported(v.FuncName) {
+			return fmt.Errorf("struct validator %s is unexported, so package %s cannot call it", v.FuncName, pkg)
+		}
+	}
+	return nil
+}
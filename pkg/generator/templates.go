@@ -0,0 +1,261 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// codeTemplates lay out the code rules generate: the checks that fail validation
+// and the loops and guards around them. Lines in the bodies of loops and branches
+// are generated unindented and indented to their depth with indent, so the rules
+// never need to know how deep they are nested.
+var codeTemplates = template.Must(template.New("code").Funcs(template.FuncMap{
+	"indent": func(levels int, lines []string) string {
+		return indentCode(strings.Join(lines, "\n"), levels)
+	},
+}).Parse(`
+{{- define "fail"}}return {{with .Field}}&{{.Package}}.FieldError{Struct: {{printf "%q" .Struct}}, Field: {{printf "%q" .Name}}, JSONName: {{printf "%q" .JSONName}}, Rule: {{printf "%q" .Rule}}, Param: {{printf "%q" .Param}}, Value: {{.Value}}, Err: {{end -}}
+{{or .Call "fmt.Errorf"}}({{with .Key}}{{printf "%q" .}}, {{end}}"{{.Message}}"{{range .Args}}, {{.}}{{end}}){{if .Field}}}{{end}}
+{{- end}}
+
+{{- define "check"}}	if {{.Cond}} {
+		{{template "fail" .}}
+	}
+{{- end}}
+
+{{- define "checks"}}
+{{- range .Setup}}	{{.}}
+{{end}}
+{{- range $i, $check := .Checks}}
+{{- if $i}}
+{{end}}
+{{- template "check" $check}}
+{{- end}}
+{{- end}}
+
+{{- define "branch"}}	if {{.Cond}} {
+{{indent 2 .Lines}}
+	}
+{{- with .ElseLines}} else {{with $.ElseCond}}if {{.}} {{end}}{
+{{indent 2 .}}
+	}
+{{- end}}
+{{- end}}
+
+{{- define "anyLayout"}}	{{.Matched}} := false
+	for _, layout := range []string{ {{- .Layouts}}} {
+		if _, err := time.Parse(layout, {{.Value}}); err == nil {
+			{{.Matched}} = true
+			break
+		}
+	}
+{{template "check" .Check}}
+{{- end}}
+
+{{- define "unique"}}
+{{- with .KeyFields}}	type {{$.KeyType}} struct {
+{{- range .}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}
+{{end}}	{{.Map}} := make(map[{{.KeyType}}]bool, len({{.Ref}}))
+	for i, item := range {{.Ref}} {
+{{- if .SkipNil}}
+		if item == nil {
+			continue
+		}
+{{- end}}
+{{- if ne .Key .Seen}}
+		{{.Seen}} := {{.Key}}
+{{- end}}
+		if {{.Map}}[{{.Seen}}] {
+			{{template "fail" .Failure}}
+		}
+		{{.Map}}[{{.Seen}}] = true
+	}
+{{- end}}
+
+{{- define "elementValidate"}}	for {{.Index}} := range {{.Ref}} {
+{{- if .SkipNil}}
+		if {{.ElemRef}} == nil {
+			continue
+		}
+{{- end}}
+		if err := {{.Call}}; err != nil {
+			{{template "fail" .Failure}}
+		}
+	}
+{{- end}}
+
+{{- define "sliceElements"}}	for {{.Index}}, {{.Elem}} := range {{.Ref}} {
+{{- if .SkipNil}}
+		if {{.Elem}} == nil {
+			continue
+		}
+{{- end}}
+{{indent 2 .Lines}}
+	}
+{{- end}}
+
+{{- define "multiValueMap"}}	for key{{if .Values}}, values{{end}} := range {{.Ref}} {
+{{- with .KeyLines}}
+{{indent 2 .}}
+{{- end}}
+{{- with .Values}}
+		for i, elem := range values {
+{{indent 3 .}}
+		}
+{{- end}}
+	}
+{{- end}}
+
+{{- define "mapValues"}}	for key{{if .Elem}}, elem{{end}} := range {{.Ref}} {
+{{indent 2 .Lines}}
+	}
+{{- end}}
+
+{{- define "nilGuard"}}	if {{.Ref}} != nil {
+{{indent 1 .Lines}}
+	}
+{{- end}}
+`))
+
+// checkData fills the check template: validation fails with Message, a fmt.Errorf
+// format taking Args, if Cond holds. The fail template renders the failure alone.
+// Rules leave the other fields to fieldError, which fills them for the field.
+type checkData struct {
+	Cond, Message string
+	Args          []string
+
+	// Call builds the error in place of fmt.Errorf, and Key is the message key
+	// houp.Errorf takes before the format
+	Call, Key string
+	// Field wraps the error in a houp.FieldError
+	Field *fieldErrorData
+}
+
+// fieldErrorData fills the houp.FieldError of a failure in the structured error
+// mode. Package is the alias of the houp import.
+type fieldErrorData struct {
+	Package, Struct, Name, JSONName, Rule, Param, Value string
+}
+
+// checksData fills the checks template: the Setup statements, then Checks in order
+type checksData struct {
+	Setup  []string
+	Checks []checkData
+}
+
+// branchData fills the branch template: Lines run if Cond holds, otherwise ElseLines
+// run if ElseCond holds or is empty
+type branchData struct {
+	Cond, ElseCond   string
+	Lines, ElseLines []string
+}
+
+// anyLayoutData fills the anyLayout template: Value is parsed with each of Layouts,
+// a list of quoted layouts, and Matched records whether one fits before Check runs
+type anyLayoutData struct {
+	Matched, Layouts, Value string
+	Check                   checkData
+}
+
+// uniqueData fills the unique template: a loop over the slice Ref recording each
+// element's Key in the map Map, failing on the first key seen twice. Key is stored
+// in the variable Seen unless they are equal. KeyFields declare KeyType, the struct
+// of a composite key.
+type uniqueData struct {
+	Map, KeyType, Ref, Key, Seen string
+	KeyFields                    []uniqueKeyField
+	SkipNil                      bool
+	Failure                      checkData
+}
+
+// uniqueKeyField is a field of the struct of a composite unique key
+type uniqueKeyField struct {
+	Name, Type string
+}
+
+// elementValidateData fills the elementValidate template: a loop over the slice Ref
+// calling Validate() on each element ElemRef, skipping nil elements if SkipNil.
+// Failure wraps the err of the call.
+type elementValidateData struct {
+	Index, Ref, ElemRef, Call string
+	SkipNil                   bool
+	Failure                   checkData
+}
+
+// sliceElementsData fills the sliceElements template: a loop over the slice Ref
+// running Lines for each element Elem, skipping nil elements if SkipNil
+type sliceElementsData struct {
+	Index, Elem, Ref string
+	SkipNil          bool
+	Lines            []string
+}
+
+// multiValueMapData fills the multiValueMap template: a loop over the url.Values
+// style map Ref running KeyLines for each key and Values for each of its values
+type multiValueMapData struct {
+	Ref              string
+	KeyLines, Values []string
+}
+
+// mapValuesData fills the mapValues template: a loop over the map Ref running Lines,
+// which read key and, if Elem, the value elem
+type mapValuesData struct {
+	Ref   string
+	Elem  bool
+	Lines []string
+}
+
+// nilGuardData fills the nilGuard template: Lines run if the pointer Ref is not nil
+type nilGuardData struct {
+	Ref   string
+	Lines []string
+}
+
+// renderCode executes the code template name with data
+func renderCode(name string, data any) (string, error) {
+	var code strings.Builder
+	if err := codeTemplates.ExecuteTemplate(&code, name, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return code.String(), nil
+}
+
+// renderCheck renders a check failing with message, a fmt.Errorf format taking
+// args, if cond holds
+func renderCheck(ctx *CodeGenContext, cond, message string, args ...string) (string, error) {
+	return renderCode("check", fieldError(ctx, checkData{Cond: cond, Message: message, Args: args}))
+}
+
+// renderChecks renders the setup statements followed by checks
+func renderChecks(ctx *CodeGenContext, setup []string, checks ...checkData) (string, error) {
+	data := checksData{Setup: setup, Checks: make([]checkData, len(checks))}
+	for i, check := range checks {
+		data.Checks[i] = fieldError(ctx, check)
+	}
+	return renderCode("checks", data)
+}
+
+// renderFail renders the statement failing validation with message, a fmt.Errorf
+// format taking args
+func renderFail(ctx *CodeGenContext, message string, args ...string) (string, error) {
+	return renderCode("fail", fieldError(ctx, checkData{Message: message, Args: args}))
+}
+
+// codeLines splits code rendered for the body of Validate into unindented lines, to
+// be laid out in the body of a loop or branch
+func codeLines(code string) []string {
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "\t")
+	}
+	return lines
+}
+
+// guardNil wraps code, which reads through the pointer ref, in a nil check
+func guardNil(ref, code string) (string, error) {
+	return renderCode("nilGuard", nilGuardData{Ref: ref, Lines: strings.Split(code, "\n")})
+}
@@ -0,0 +1,291 @@
+package generator
+
+import (
+	"go/ast"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderCode(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		data any
+		want string
+	}{
+		{
+			name: "check",
+			tmpl: "check",
+			data: checkData{Cond: "err := u.Run(); err != nil", Message: "field Tags[%d] failed: %w", Args: []string{"i", "err"}},
+			want: `	if err := u.Run(); err != nil {
+		return fmt.Errorf("field Tags[%d] failed: %w", i, err)
+	}`,
+		},
+		{
+			name: "field error",
+			tmpl: "fail",
+			data: checkData{
+				Message: "field Tags[%d] must be at most 8 characters",
+				Args:    []string{"i"},
+				Call:    "houp.Errorf",
+				Key:     "User.Tags[].max",
+				Field:   &fieldErrorData{Package: "houp", Struct: "User", Name: "Tags", JSONName: "tags", Rule: "max", Param: "8", Value: "elem"},
+			},
+			want: `return &houp.FieldError{Struct: "User", Field: "Tags", JSONName: "tags", Rule: "max", Param: "8", Value: elem, Err: houp.Errorf("User.Tags[].max", "field Tags[%d] must be at most 8 characters", i)}`,
+		},
+		{
+			name: "checks with setup",
+			tmpl: "checks",
+			data: checksData{
+				Setup:  []string{"v, vErr := strconv.Atoi(u.Age)"},
+				Checks: []checkData{{Cond: "vErr != nil", Message: "field Age must be a number"}, {Cond: "v < 18", Message: "field Age must be at least 18"}},
+			},
+			want: `	v, vErr := strconv.Atoi(u.Age)
+	if vErr != nil {
+		return fmt.Errorf("field Age must be a number")
+	}
+	if v < 18 {
+		return fmt.Errorf("field Age must be at least 18")
+	}`,
+		},
+		{
+			name: "branch with else if",
+			tmpl: "branch",
+			data: branchData{
+				Cond:      "u.A != nil && u.B != nil",
+				Lines:     []string{"if *u.A != *u.B {", "\treturn errA", "}"},
+				ElseCond:  "(u.A == nil) != (u.B == nil)",
+				ElseLines: []string{"return errB"},
+			},
+			want: `	if u.A != nil && u.B != nil {
+		if *u.A != *u.B {
+			return errA
+		}
+	} else if (u.A == nil) != (u.B == nil) {
+		return errB
+	}`,
+		},
+		{
+			name: "branch without else",
+			tmpl: "branch",
+			data: branchData{Cond: "u.A != nil", Lines: []string{"check(*u.A)"}},
+			want: `	if u.A != nil {
+		check(*u.A)
+	}`,
+		},
+		{
+			name: "any layout",
+			tmpl: "anyLayout",
+			data: anyLayoutData{
+				Matched: "matched",
+				Layouts: `"2006-01-02", "02.01.2006"`,
+				Value:   "u.Date",
+				Check:   checkData{Cond: "!matched", Message: "field Date must be a date"},
+			},
+			want: `	matched := false
+	for _, layout := range []string{"2006-01-02", "02.01.2006"} {
+		if _, err := time.Parse(layout, u.Date); err == nil {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return fmt.Errorf("field Date must be a date")
+	}`,
+		},
+		{
+			name: "unique scalar",
+			tmpl: "unique",
+			data: uniqueData{
+				Map: "seenTags", KeyType: "string", Ref: "u.Tags", Key: "item", Seen: "item",
+				Failure: checkData{Message: "field Tags has duplicate value at index %d", Args: []string{"i"}},
+			},
+			want: `	seenTags := make(map[string]bool, len(u.Tags))
+	for i, item := range u.Tags {
+		if seenTags[item] {
+			return fmt.Errorf("field Tags has duplicate value at index %d", i)
+		}
+		seenTags[item] = true
+	}`,
+		},
+		{
+			name: "unique composite key",
+			tmpl: "unique",
+			data: uniqueData{
+				Map: "seenItemsAB", KeyType: "seenItemsABKey", Ref: "u.Items", Key: "seenItemsABKey{item.A, item.B}", Seen: "key",
+				KeyFields: []uniqueKeyField{{Name: "A", Type: "int"}, {Name: "B", Type: "string"}},
+				SkipNil:   true,
+				Failure:   checkData{Message: "field Items has duplicate A+B at index %d", Args: []string{"i"}},
+			},
+			want: `	type seenItemsABKey struct {
+		A int
+		B string
+	}
+	seenItemsAB := make(map[seenItemsABKey]bool, len(u.Items))
+	for i, item := range u.Items {
+		if item == nil {
+			continue
+		}
+		key := seenItemsABKey{item.A, item.B}
+		if seenItemsAB[key] {
+			return fmt.Errorf("field Items has duplicate A+B at index %d", i)
+		}
+		seenItemsAB[key] = true
+	}`,
+		},
+		{
+			name: "element validate",
+			tmpl: "elementValidate",
+			data: elementValidateData{Index: "i", Ref: "u.Items", ElemRef: "u.Items[i]", Call: "u.Items[i].Validate()", SkipNil: true,
+				Failure: checkData{Message: "field Items[%d] validation failed: %w", Args: []string{"i", "err"}}},
+			want: `	for i := range u.Items {
+		if u.Items[i] == nil {
+			continue
+		}
+		if err := u.Items[i].Validate(); err != nil {
+			return fmt.Errorf("field Items[%d] validation failed: %w", i, err)
+		}
+	}`,
+		},
+		{
+			name: "slice elements",
+			tmpl: "sliceElements",
+			data: sliceElementsData{Index: "j", Elem: "elem2", Ref: "elem", Lines: []string{"if elem2 == \"\" {", "\treturn err", "}"}},
+			want: `	for j, elem2 := range elem {
+		if elem2 == "" {
+			return err
+		}
+	}`,
+		},
+		{
+			name: "multi-value map keys only",
+			tmpl: "multiValueMap",
+			data: multiValueMapData{Ref: "r.Query", KeyLines: []string{"check(key)"}},
+			want: `	for key := range r.Query {
+		check(key)
+	}`,
+		},
+		{
+			name: "multi-value map values",
+			tmpl: "multiValueMap",
+			data: multiValueMapData{Ref: "r.Query", Values: []string{"check(elem)"}},
+			want: `	for key, values := range r.Query {
+		for i, elem := range values {
+			check(elem)
+		}
+	}`,
+		},
+		{
+			name: "map values",
+			tmpl: "mapValues",
+			data: mapValuesData{Ref: "c.Labels", Elem: true, Lines: []string{"check(key, elem)"}},
+			want: `	for key, elem := range c.Labels {
+		check(key, elem)
+	}`,
+		},
+		{
+			name: "nil guard keeps blank lines empty",
+			tmpl: "nilGuard",
+			data: nilGuardData{Ref: "c.Labels", Lines: []string{"\tfirst()", "", "\tsecond()"}},
+			want: "\tif c.Labels != nil {\n\t\tfirst()\n\n\t\tsecond()\n\t}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderCode(tt.tmpl, tt.data)
+			if err != nil {
+				t.Fatalf("renderCode() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("renderCode() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderCodeUnknownTemplate(t *testing.T) {
+	if _, err := renderCode("missing", nil); err == nil {
+		t.Error("renderCode() error = nil, want error for unknown template")
+	}
+}
+
+func TestLoopVarRuleLines(t *testing.T) {
+	// A receiver named like the loop variable used to be rewritten into it
+	ctx := &CodeGenContext{Struct: &StructInfo{Name: "Elem", Receiver: "elem"}}
+	rules := []ValidationRule{&RequiredRule{}}
+
+	lines, err := loopVarRuleLines(ctx, rules, "elem", ast.NewIdent("string"), "Tags[%d]", "i")
+	if err != nil {
+		t.Fatalf("loopVarRuleLines() failed: %v", err)
+	}
+	code := strings.Join(lines, "\n")
+	if !strings.Contains(code, `elem == ""`) {
+		t.Errorf("loopVarRuleLines() does not check the loop variable:\n%s", code)
+	}
+	if strings.Contains(code, "elem.elem") {
+		t.Errorf("loopVarRuleLines() reads the loop variable through the receiver:\n%s", code)
+	}
+	if !strings.Contains(code, `"field Tags[%d] is required", i`) {
+		t.Errorf("loopVarRuleLines() does not name the element:\n%s", code)
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	field := &FieldInfo{Name: "Tags", JSONName: "tags", Messages: map[string]string{"min": "too short"}}
+	tests := []struct {
+		name    string
+		options GenerateOptions
+		rule    ValidationRule
+		want    string
+	}{
+		{
+			name: "built-in message",
+			rule: &MaxRule{Value: "8"},
+			want: `return fmt.Errorf("field Tags[%d] must be at most 8", i)`,
+		},
+		{
+			name:    "structured",
+			options: GenerateOptions{ErrorMode: "structured"},
+			rule:    &MaxRule{Value: "8"},
+			want:    `return &houp.FieldError{Struct: "User", Field: "Tags", JSONName: "tags", Rule: "max", Param: "8", Value: elem, Err: fmt.Errorf("field Tags[%d] must be at most 8", i)}`,
+		},
+		{
+			name: "custom message",
+			rule: &MinRule{Value: "2"},
+			want: `return errors.New("too short")`,
+		},
+		{
+			name:    "message template",
+			options: GenerateOptions{MessageTemplates: map[string]string{"max": "%s: at most %s"}},
+			rule:    &MaxRule{Value: "8"},
+			want:    `return fmt.Errorf("Tags[%d]: at most 8", i)`,
+		},
+		{
+			name:    "translatable",
+			options: GenerateOptions{Locales: []string{"en"}},
+			rule:    &MaxRule{Value: "8"},
+			want:    `return houp.Errorf("User.Tags[].max", "field Tags[%d] must be at most 8", i)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &CodeGenContext{
+				Struct:  &StructInfo{Name: "User"},
+				Field:   field,
+				Imports: make(map[string]string),
+				Options: &tt.options,
+				Failure: &failureContext{Rule: tt.rule, Value: "elem", Label: "Tags[%d]", LabelArgs: []string{"i"}},
+			}
+			got, err := renderFail(ctx, "field Tags[%d] must be at most 8")
+			if err != nil {
+				t.Fatalf("renderFail() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("renderFail() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"path/filepath"
+	"reflect"
 	"strings"
 )
 
@@ -15,9 +17,20 @@ type GenerateOptions struct {
 	// Suffix for generated files (default: "_validate")
 	Suffix string
 
-	// Whether to collect all validation errors or return on first error
+	// MultiError makes Validate check every field and return all failures joined
+	// with errors.Join, instead of returning the first one. Structs can opt in
+	// one by one with a //validate:multierror comment.
 	MultiError bool
 
+	// Context gives every validated struct a ValidateContext(ctx context.Context)
+	// method, which passes ctx to context-aware validators and dives, with Validate
+	// as a wrapper using context.Background()
+	Context bool
+
+	// MaxErrors makes multi-error Validate methods return once they have collected
+	// that many failures. 0 collects them all.
+	MaxErrors int
+
 	// Whether to overwrite existing files
 	Overwrite bool
 
@@ -28,6 +41,102 @@ type GenerateOptions struct {
 	// "fail" - exit with error (default)
 	// "skip" - log warning and continue
 	UnknownTagMode string
+
+	// CrossPackageMode determines behavior when a dive reaches a type of another
+	// package that has no Validate method
+	// "skip" - leave the type unvalidated (default)
+	// "strict" - fail generation
+	CrossPackageMode string
+
+	// ErrorMode determines the errors generated Validate methods return
+	// "fmt" - fmt.Errorf messages (default)
+	// "structured" - *houp.FieldError values carrying the struct, field, rule,
+	// parameter and value, with the same messages
+	ErrorMode string
+
+	// ReceiverMode determines the receiver of generated methods
+	// "pointer" - func (u *User) Validate() error (default)
+	// "value" - func (u User) Validate() error, for types passed by value
+	ReceiverMode string
+
+	// MethodName names the generated validation method of every struct without a
+	// //validate:method= comment, e.g. "ValidateInput" next to a handwritten
+	// Validate. Empty means "Validate".
+	MethodName string
+
+	// ReceiverName is the receiver variable of generated methods of every struct
+	// without a //validate:receiver= comment or methods of its own. Empty derives
+	// it from the struct name.
+	ReceiverName string
+
+	// MessageTemplates replaces the built-in error messages of rules, by rule name.
+	// In a template the first %s is the field and the second the rule parameter,
+	// e.g. "%s must contain at least %s characters" for min. See LoadConfig.
+	MessageTemplates map[string]string
+
+	// Locales makes generated code return translatable errors, see houp.Errorf, and
+	// writes a validation.messages.<locale>.json catalog per locale. The first locale
+	// is the one of the built-in messages; the others keep their translations.
+	Locales []string
+
+	// IncludeTests also generates Validate methods for structs declared in the
+	// package's _test.go files, written to validation.gen_test.go
+	IncludeTests bool
+
+	// KeepGoing generates every struct it can instead of stopping at the first
+	// struct that fails. Failed structs get a Validate method that returns an
+	// error, and Generate reports them all as GenerationErrors.
+	KeepGoing bool
+
+	// ValidateFields also generates a ValidateFields(fields ...string) error method
+	// for each validated struct, which checks only the listed fields
+	ValidateFields bool
+
+	// ValidateExcept also generates a ValidateExcept(fields ...string) error method
+	// for each validated struct, which checks every field but the listed ones
+	ValidateExcept bool
+
+	// ValidMethod also generates a Valid() bool method for each validated struct,
+	// which reports whether Validate returns nil
+	ValidMethod bool
+
+	// MustValidate also generates a MustValidate() method for each validated struct,
+	// which panics with the error of Validate
+	MustValidate bool
+
+	// Constructors also generates a New<Struct> function for each validated struct
+	// that takes every field in declaration order and returns the struct only if it
+	// passes Validate
+	Constructors bool
+
+	// Subpackage generates free functions, e.g. ValidateUser(u *models.User) error,
+	// instead of methods, into a package of that name in a subdirectory of the
+	// package, for code bases that keep generated methods off their domain types.
+	// Validated structs and their struct validators must be exported.
+	Subpackage string
+
+	// Runtime is the import path of a helper package, such as
+	// github.com/n10ty/houp/runtime, whose ISO code tables, pattern regexps and
+	// check functions generated code uses instead of declaring its own
+	Runtime string
+
+	// Version is the houp version, e.g. "v0.1.0", stamped into the header of
+	// generated files together with the TagSetVersion. Empty leaves both out.
+	Version string
+
+	// Command is the command line recorded in the header of generated files, e.g.
+	// "houp --errors=structured ./models". Empty leaves it out.
+	Command string
+
+	// Header holds extra lines, such as a copyright notice, written as comments
+	// below the generated-code header of every file
+	Header []string
+
+	// BuildTags are the build tags the package is loaded with, as with go build
+	// -tags; GOOS and GOARCH come from the environment. Files of other build
+	// configurations are still generated into their own constrained files, loaded
+	// with these tags where their constraint does not decide them.
+	BuildTags []string
 }
 
 // PackageInfo represents a parsed Go package
@@ -41,11 +150,12 @@ type PackageInfo struct {
 
 // FileInfo represents a single Go source file
 type FileInfo struct {
-	Name    string
-	Path    string
-	AST     *ast.File
-	Structs []*StructInfo
-	Skip    bool // true if file has //validate:skip comment
+	Name       string
+	Path       string
+	AST        *ast.File
+	Structs    []*StructInfo
+	Skip       bool   // true if file has //validate:skip comment
+	Constraint string // build constraint as a //go:build expression, empty if built everywhere
 }
 
 // StructInfo represents a struct with validation requirements
@@ -57,6 +167,13 @@ type StructInfo struct {
 	SourceFile       string
 	CustomValidators []CustomValidator // struct-level custom validators from //validate: comments
 	Skip             bool              // true if struct has //validate:skip comment
+	NeedsContext     bool              // true if a validator, directly or through dive, accepts a context.Context
+	Pagination       string            // parameter of a //validate:pagination= comment
+	Freeze           bool              // true if struct has //validate:freeze comment
+	MultiError       bool              // true if struct has //validate:multierror comment
+	Receiver         string            // receiver of generated methods, from a //validate:receiver= comment or the type's own methods
+	Method           string            // name of the generated method, from a //validate:method= comment
+	NonStruct        bool              // a named slice or map type, with a single field holding the rules of its //validate: comment
 }
 
 // FieldInfo represents a struct field with validation metadata
@@ -66,7 +183,24 @@ type FieldInfo struct {
 	TypeString string // string representation of the type
 	Tag        string // full struct tag
 	Rules      []ValidationRule
-	JSONName   string // extracted from json tag
+	JSONName   string            // extracted from json tag
+	Inline     *StructInfo       // validated fields of an anonymous struct type, nil for other types
+	Messages   map[string]string // custom error messages by rule name, from rule~message
+	Message    string            // custom error message for every rule, from the errmsg tag
+
+	// Ref is the expression the value is read from, empty for <receiver>.<Name>.
+	// Rules on dive elements read a loop variable, and Name is then the label
+	// error messages use, e.g. "Tags[%d]".
+	Ref string
+}
+
+// identName returns the name the identifiers rules declare for the field are
+// derived from: the field name, or the loop variable of a dive element
+func (f *FieldInfo) identName() string {
+	if f.Ref != "" {
+		return f.Ref
+	}
+	return f.Name
 }
 
 // ValidationRule represents a single validation constraint
@@ -93,6 +227,53 @@ type TypeInfo struct {
 	IsSlice      bool
 	IsStruct     bool
 	UnderlyingGo ast.Expr // original AST expression
+
+	GoType            types.Type // type checker's view of the expression, nil without type information
+	HasValidateMethod bool       // the type or a pointer to it has a Validate() error method
+}
+
+// validatableInterface is interface{ Validate() error }, implemented by every type houp generates code for
+var validatableInterface = newMethodInterface("Validate", types.Universe.Lookup("error").Type())
+
+// isZeroerInterface is interface{ IsZero() bool }, implemented by value types such as time.Time
+var isZeroerInterface = newMethodInterface("IsZero", types.Typ[types.Bool])
+
+// newMethodInterface builds an interface with a single niladic method returning result
+func newMethodInterface(name string, result types.Type) *types.Interface {
+	sig := types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(types.NewVar(token.NoPos, nil, "", result)), false)
+	return types.NewInterfaceType([]*types.Func{types.NewFunc(token.NoPos, nil, name, sig)}, nil).Complete()
+}
+
+// Implements reports whether the type, or a pointer to it, implements iface.
+// Pointer methods count because generated code calls methods on addressable fields.
+// It is false when type information is unavailable.
+func (t TypeInfo) Implements(iface *types.Interface) bool {
+	if t.GoType == nil {
+		return false
+	}
+	if types.Implements(t.GoType, iface) {
+		return true
+	}
+	if _, isPtr := t.GoType.Underlying().(*types.Pointer); isPtr {
+		return false
+	}
+	return types.Implements(types.NewPointer(t.GoType), iface)
+}
+
+// hasValidateTags reports whether t is a struct type with a validate tag on one of
+// its fields. houp generates a Validate method for such a type, so a dive from
+// another package can call it before that package has been generated.
+func hasValidateTags(t types.Type) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if tag := reflect.StructTag(st.Tag(i)).Get("validate"); tag != "" && tag != "-" {
+			return true
+		}
+	}
+	return false
 }
 
 // TypeKind represents the kind of type
@@ -128,6 +309,109 @@ func (t TypeInfo) IsNumeric() bool {
 	return (t.Kind >= TypeInt && t.Kind <= TypeFloat64) || t.Kind == TypeJSONNumber
 }
 
+// IsTime reports whether the type is time.Time
+func (t TypeInfo) IsTime() bool {
+	if t.GoType != nil {
+		named, ok := t.GoType.(*types.Named)
+		return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+	}
+	return t.PkgName == "time" && t.Name == "Time"
+}
+
+// BigNumber returns "Int", "Float" or "Rat" for the number types of math/big, and ""
+// for other types
+func (t TypeInfo) BigNumber() string {
+	pkgPath, name := "", t.Name
+	if t.GoType != nil {
+		named, ok := t.GoType.(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return ""
+		}
+		pkgPath, name = named.Obj().Pkg().Path(), named.Obj().Name()
+	} else if t.PkgName == "big" {
+		pkgPath = "math/big"
+	}
+	if pkgPath != "math/big" {
+		return ""
+	}
+	switch name {
+	case "Int", "Float", "Rat":
+		return name
+	}
+	return ""
+}
+
+// IsDecimal reports whether the type is decimal.Decimal of github.com/shopspring/decimal
+func (t TypeInfo) IsDecimal() bool {
+	if t.GoType != nil {
+		named, ok := t.GoType.(*types.Named)
+		return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == decimalPkgPath && named.Obj().Name() == "Decimal"
+	}
+	return t.PkgName == "decimal" && t.Name == "Decimal"
+}
+
+// decimalPkgPath is the import path of decimal.Decimal
+const decimalPkgPath = "github.com/shopspring/decimal"
+
+// IsUUID reports whether the type is uuid.UUID of github.com/google/uuid
+func (t TypeInfo) IsUUID() bool {
+	if t.GoType != nil {
+		named, ok := t.GoType.(*types.Named)
+		return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == uuidPkgPath && named.Obj().Name() == "UUID"
+	}
+	return t.PkgName == "uuid" && t.Name == "UUID"
+}
+
+// uuidPkgPath is the import path of uuid.UUID
+const uuidPkgPath = "github.com/google/uuid"
+
+// IsCmpNumber reports whether numeric bounds on the type are checked with its Cmp
+// method: for the math/big numbers and decimal.Decimal
+func (t TypeInfo) IsCmpNumber() bool {
+	return t.BigNumber() != "" || t.IsDecimal()
+}
+
+// IsValueType reports whether the type is a struct or array type that rules check as
+// a value, rather than by calling its Validate method: time.Time, uuid.UUID and the
+// types of IsCmpNumber
+func (t TypeInfo) IsValueType() bool {
+	return t.IsTime() || t.IsUUID() || t.IsCmpNumber()
+}
+
+// IsBytes reports whether the type is a byte slice, which length rules measure in
+// bytes and string rules check after conversion to string
+func (t TypeInfo) IsBytes() bool {
+	return t.IsSlice && t.Elem != nil && t.Elem.Kind == TypeUint8
+}
+
+// IntegerBounds reports whether numeric bounds on the type must be whole numbers:
+// for integers and big.Int
+func (t TypeInfo) IntegerBounds() bool {
+	return t.IsInteger() || t.BigNumber() == "Int"
+}
+
+// IsMultiValueMap reports whether the type is a map from a string key to []string,
+// such as url.Values or http.Header
+func (t TypeInfo) IsMultiValueMap() bool {
+	if t.GoType == nil {
+		return false
+	}
+	m, ok := t.GoType.Underlying().(*types.Map)
+	if !ok {
+		return false
+	}
+	key, ok := m.Key().Underlying().(*types.Basic)
+	if !ok || key.Kind() != types.String {
+		return false
+	}
+	values, ok := m.Elem().Underlying().(*types.Slice)
+	if !ok {
+		return false
+	}
+	elem, ok := values.Elem().(*types.Basic)
+	return ok && elem.Kind() == types.String
+}
+
 // IsInteger returns true if the type is an integer type
 func (t TypeInfo) IsInteger() bool {
 	return t.Kind >= TypeInt && t.Kind <= TypeUint64
@@ -140,16 +424,23 @@ func (t TypeInfo) IsFloat() bool {
 
 // CodeGenContext holds context for code generation
 type CodeGenContext struct {
-	Struct       *StructInfo
-	Imports      map[string]string // import path -> alias
-	Buffer       []string          // lines of generated code
-	Options      *GenerateOptions
-	VarCounter   int               // counter for generating unique variable names
-	TypesInfo    *types.Info       // type information for resolving underlying types
-	RegexpVars   map[string]string // pattern -> variable name for package-level regexp vars
-	RegexpBuffer []string          // lines of package-level regexp variable declarations
-	FilePrefix   string            // prefix for file-unique variable names (e.g., sanitized filename)
-	PkgPath      string            // current package import path
+	Struct        *StructInfo
+	Field         *FieldInfo        // field whose rules are being generated
+	Imports       map[string]string // import path -> alias
+	Buffer        []string          // lines of generated code
+	Options       *GenerateOptions
+	LocalVars     map[string]bool   // local variable names already used in the current method
+	TypesInfo     *types.Info       // type information for resolving underlying types
+	RegexpVars    map[string]string // pattern -> variable name for package-level regexp vars
+	RegexpBuffer  []string          // lines of package-level regexp variable declarations
+	FilePrefix    string            // prefix for file-unique variable names (e.g., sanitized filename)
+	PkgPath       string            // current package import path
+	PkgName       string            // current package name
+	HelperFuncs   map[string]string // helper name -> generated function name for package-level helpers
+	HelperBuffer  []string          // package-level helper function declarations
+	DeclaredFuncs map[string]bool   // package-level functions and "Type.Method" methods declared outside generated files
+	MessageKeys   map[string]string // message key -> format of the struct's translatable errors
+	Failure       *failureContext   // rule whose code is being generated, for the errors of its checks
 }
 
 // AddImport adds an import to the context and returns the alias to use
@@ -181,16 +472,34 @@ func (ctx *CodeGenContext) AddImport(pkgPath, preferredAlias string) string {
 	return alias
 }
 
-// UniqueVarName generates a unique variable name
-func (ctx *CodeGenContext) UniqueVarName(prefix string) string {
-	ctx.VarCounter++
-	return prefix + string(rune('0'+ctx.VarCounter))
+// LocalVarName returns a name for a local variable declared by a rule. The suffix is a
+// hash of the struct, field and rule rather than a running counter, so adding or removing
+// a field does not rename the variables of the others. A rule that needs several
+// variables on the same field gets a numbered suffix for the repeats.
+func (ctx *CodeGenContext) LocalVarName(field *FieldInfo, rule, prefix string) string {
+	if ctx.LocalVars == nil {
+		ctx.LocalVars = make(map[string]bool)
+	}
+
+	hash := sha256.Sum256([]byte(ctx.Struct.Name + "." + field.identName() + "." + rule))
+	base := prefix + hex.EncodeToString(hash[:])[:6]
+
+	varName := base
+	for n := 2; ctx.LocalVars[varName]; n++ {
+		varName = fmt.Sprintf("%s%d", base, n)
+	}
+	ctx.LocalVars[varName] = true
+	return varName
 }
 
 // AddRegexpVar adds a package-level regexp variable and returns its name.
 // If the pattern already exists, returns the existing variable name.
 // Uses a hash of the pattern and file prefix to ensure unique names across different generated files.
+// Patterns the --runtime package declares are referenced there instead.
 func (ctx *CodeGenContext) AddRegexpVar(pattern, prefix string) string {
+	if ref := ctx.runtimeRef(runtimeRegexps[pattern]); ref != "" {
+		return ref
+	}
 	if ctx.RegexpVars == nil {
 		ctx.RegexpVars = make(map[string]string)
 	}
@@ -222,6 +531,60 @@ func (ctx *CodeGenContext) AddRegexpVar(pattern, prefix string) string {
 	return varName
 }
 
+// AddHelperFunc adds a package-level helper function and returns its name.
+// The body is a function literal without the "func" keyword and name, e.g. "(s string) bool { ... }".
+// Helpers are emitted once per generated file and prefixed like regexp variables to avoid collisions,
+// unless the --runtime package exports them.
+func (ctx *CodeGenContext) AddHelperFunc(name, body string) string {
+	if ref := ctx.runtimeRef(runtimeFuncs[name]); ref != "" {
+		return ref
+	}
+	if ctx.HelperFuncs == nil {
+		ctx.HelperFuncs = make(map[string]string)
+	}
+
+	if funcName, exists := ctx.HelperFuncs[name]; exists {
+		return funcName
+	}
+
+	funcName := name
+	if ctx.FilePrefix != "" {
+		funcName = fmt.Sprintf("%s_%s", ctx.FilePrefix, name)
+	}
+
+	ctx.HelperFuncs[name] = funcName
+	ctx.HelperBuffer = append(ctx.HelperBuffer, fmt.Sprintf("func %s%s", funcName, body))
+
+	return funcName
+}
+
+// AddHelperVar adds a package-level variable (such as a lookup table) once per generated file
+// and returns its name. body is everything after the name, e.g. " = map[string]int{...}".
+// Variables share the helper namespace and buffer with AddHelperFunc, and the --runtime package
+// the same way.
+func (ctx *CodeGenContext) AddHelperVar(name, body string) string {
+	if ref := ctx.runtimeRef(runtimeVars[name]); ref != "" {
+		return ref
+	}
+	if ctx.HelperFuncs == nil {
+		ctx.HelperFuncs = make(map[string]string)
+	}
+
+	if varName, exists := ctx.HelperFuncs[name]; exists {
+		return varName
+	}
+
+	varName := name
+	if ctx.FilePrefix != "" {
+		varName = fmt.Sprintf("%s_%s", ctx.FilePrefix, name)
+	}
+
+	ctx.HelperFuncs[name] = varName
+	ctx.HelperBuffer = append(ctx.HelperBuffer, fmt.Sprintf("var %s%s", varName, body))
+
+	return varName
+}
+
 // Import represents an import statement
 type Import struct {
 	Path  string
@@ -230,44 +593,41 @@ type Import struct {
 
 // CustomValidator represents a struct-level custom validator function
 type CustomValidator struct {
-	ImportPath string // e.g., "github.com/a/b"
-	FuncName   string // e.g., "ValidateUser"
+	ImportPath  string // e.g., "github.com/a/b"
+	FuncName    string // e.g., "ValidateUser"
+	WithContext bool   // func(context.Context, *T) error
 }
 
-// sanitizeFilenameForVar converts a filename to a valid Go variable prefix
-// e.g., "email_validation.go" -> "email"
-// e.g., "test-file.go" -> "testFile"
+// sanitizeFilenameForVar converts a filename to the prefix of the package-level
+// declarations generated for the file in per-file mode, e.g. "email_validation.go" ->
+// "email_validation". Files generated separately into one package must not redeclare
+// each other's variables, so distinct files get distinct prefixes: names that do not
+// carry over as they are, and names that could clash with the "pkg" prefixes of
+// package-level files, get a hash of the filename, e.g. "test-file.go" -> "test_file_31f95b".
 func sanitizeFilenameForVar(filename string) string {
 	// Remove extension
 	base := filepath.Base(filename)
 	name := strings.TrimSuffix(base, filepath.Ext(base))
 
-	// Remove common suffixes
-	name = strings.TrimSuffix(name, "_validation")
-	name = strings.TrimSuffix(name, "_validate")
-	name = strings.TrimSuffix(name, "_test")
-
-	// Replace non-alphanumeric characters with underscores
+	// Replace characters that cannot appear in identifiers with underscores
 	var result strings.Builder
-	for i, r := range name {
-		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
 			result.WriteRune(r)
-		} else if i > 0 { // Don't start with underscore
+		} else {
 			result.WriteRune('_')
 		}
 	}
-
 	prefix := result.String()
 
-	// Ensure it doesn't start with a number
-	if len(prefix) > 0 && prefix[0] >= '0' && prefix[0] <= '9' {
+	// Ensure it starts with a letter
+	if prefix == "" || (prefix[0] >= '0' && prefix[0] <= '9') || prefix[0] == '_' {
 		prefix = "file_" + prefix
 	}
 
-	// Use a default if empty
-	if prefix == "" {
-		prefix = "file"
+	if prefix != name || strings.HasPrefix(prefix, "pkg") {
+		hash := sha256.Sum256([]byte(base))
+		prefix += "_" + hex.EncodeToString(hash[:])[:6]
 	}
-
 	return prefix
 }
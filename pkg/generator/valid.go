@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+)
+
+// generateValid appends Valid, which reports whether the struct passes Validate,
+// for call sites that only need a boolean. Structs that declare a Valid method or
+// field themselves are skipped.
+func generateValid(ctx *CodeGenContext) {
+	if ctx.DeclaredFuncs[ctx.Struct.Name+".Valid"] || hasFieldNamed(ctx.Struct, "Valid") {
+		return
+	}
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) Valid() bool {", ctx.ReceiverDecl()),
+		fmt.Sprintf("\treturn %s.%s() == nil", receiverVar, ctx.MethodName()),
+		"}")
+}
+
+// generateMustValidate appends MustValidate, which panics with the error of
+// Validate, for test fixtures and configuration checked at startup. Structs that
+// declare a MustValidate method or field themselves are skipped.
+func generateMustValidate(ctx *CodeGenContext) {
+	if ctx.DeclaredFuncs[ctx.Struct.Name+".MustValidate"] || hasFieldNamed(ctx.Struct, "MustValidate") {
+		return
+	}
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) MustValidate() {", ctx.ReceiverDecl()),
+		fmt.Sprintf("\tif err := %s.%s(); err != nil {", receiverVar, ctx.MethodName()),
+		"\t\tpanic(err)",
+		"\t}",
+		"}")
+}
+
+// hasFieldNamed reports whether the struct type of s declares a field called name
+func hasFieldNamed(s *StructInfo, name string) bool {
+	if s.TypeSpec == nil {
+		return false
+	}
+	structType, ok := s.TypeSpec.Type.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return false
+	}
+	for _, field := range structType.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
+		if len(field.Names) == 0 && embeddedFieldName(field.Type) == name {
+			return true
+		}
+	}
+	return false
+}
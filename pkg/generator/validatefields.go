@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// generateValidateFields appends ValidateFields, which runs the checks of the
+// listed fields only, e.g. for PATCH requests that update some fields. Fields are
+// named as in Go. Listing a field without rules is allowed; an unknown name is an
+// error. Struct validators are not run, as they check the struct as a whole.
+func generateValidateFields(ctx *CodeGenContext) error {
+	if ctx.Struct.NonStruct || ctx.DeclaredFuncs[ctx.Struct.Name+".ValidateFields"] {
+		return nil
+	}
+
+	cases, err := fieldCases(ctx)
+	if err != nil {
+		return err
+	}
+
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer, "")
+	if ctx.Struct.NeedsContext {
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("func (%s) ValidateFields(fields ...string) error {", ctx.ReceiverDecl()),
+			fmt.Sprintf("\treturn %s.ValidateFieldsContext(context.Background(), fields...)", receiverVar),
+			"}",
+			"",
+			fmt.Sprintf("func (%s) ValidateFieldsContext(ctx context.Context, fields ...string) error {", ctx.ReceiverDecl()))
+	} else {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("func (%s) ValidateFields(fields ...string) error {", ctx.ReceiverDecl()))
+	}
+	ctx.Buffer = append(ctx.Buffer,
+		"\tfor _, field := range fields {",
+		"\t\tswitch field {")
+	ctx.Buffer = append(ctx.Buffer, cases...)
+	ctx.Buffer = append(ctx.Buffer,
+		"\t\tdefault:",
+		fmt.Sprintf("\t\t\treturn fmt.Errorf(\"%s has no field %%s\", field)", ctx.Struct.Name),
+		"\t\t}",
+		"\t}",
+		"\treturn nil",
+		"}")
+	return nil
+}
+
+// validateFieldsStub appends the ValidateFields method of a struct that failed to
+// generate with KeepGoing. It returns the error of the stub Validate.
+func validateFieldsStub(ctx *CodeGenContext) {
+	if ctx.Struct.NonStruct || ctx.DeclaredFuncs[ctx.Struct.Name+".ValidateFields"] {
+		return
+	}
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) ValidateFields(fields ...string) error {", ctx.ReceiverDecl()),
+		fmt.Sprintf("\treturn %s.%s()", receiverVar, ctx.MethodName()),
+		"}")
+	if ctx.Struct.NeedsContext {
+		ctx.Buffer = append(ctx.Buffer,
+			"",
+			fmt.Sprintf("func (%s) ValidateFieldsContext(ctx context.Context, fields ...string) error {", ctx.ReceiverDecl()),
+			fmt.Sprintf("\treturn %s.%sContext(ctx)", receiverVar, ctx.MethodName()),
+			"}")
+	}
+}
+
+// generateValidateExcept appends ValidateExcept, which runs the checks of every
+// field but the listed ones, e.g. IDs and timestamps the server fills in. Unknown
+// names are an error, so a renamed field is not silently validated again. Like
+// ValidateFields it leaves out struct validators; with --multi-error it returns the
+// failures of all checked fields.
+func generateValidateExcept(ctx *CodeGenContext) error {
+	if ctx.Struct.NonStruct || ctx.DeclaredFuncs[ctx.Struct.Name+".ValidateExcept"] {
+		return nil
+	}
+
+	multi := ctx.Options.MultiError || ctx.Struct.MultiError
+	checks, err := fieldChecks(ctx, multi)
+	if err != nil {
+		return err
+	}
+
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer, "")
+	if ctx.Struct.NeedsContext {
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("func (%s) ValidateExcept(fields ...string) error {", ctx.ReceiverDecl()),
+			fmt.Sprintf("\treturn %s.ValidateExceptContext(context.Background(), fields...)", receiverVar),
+			"}",
+			"",
+			fmt.Sprintf("func (%s) ValidateExceptContext(ctx context.Context, fields ...string) error {", ctx.ReceiverDecl()))
+	} else {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("func (%s) ValidateExcept(fields ...string) error {", ctx.ReceiverDecl()))
+	}
+
+	var names []string
+	for _, name := range exportedFieldNames(ctx.Struct) {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	if len(checks) > 0 {
+		ctx.Buffer = append(ctx.Buffer, "\tskip := make(map[string]bool, len(fields))")
+	}
+	ctx.Buffer = append(ctx.Buffer,
+		"\tfor _, field := range fields {",
+		"\t\tswitch field {")
+	if len(names) > 0 {
+		ctx.Buffer = append(ctx.Buffer, fmt.Sprintf("\t\tcase %s:", strings.Join(names, ", ")))
+		if len(checks) > 0 {
+			ctx.Buffer = append(ctx.Buffer, "\t\t\tskip[field] = true")
+		}
+	}
+	ctx.Buffer = append(ctx.Buffer,
+		"\t\tdefault:",
+		fmt.Sprintf("\t\t\treturn fmt.Errorf(\"%s has no field %%s\", field)", ctx.Struct.Name),
+		"\t\t}",
+		"\t}")
+
+	if multi && len(checks) > 0 {
+		ctx.AddImport("errors", "errors")
+		ctx.Buffer = append(ctx.Buffer, "\tvar errs []error")
+	}
+	for _, check := range checks {
+		ctx.Buffer = append(ctx.Buffer,
+			fmt.Sprintf("\tif !skip[%q] {", check.Name),
+			indentCode(check.Code, 1),
+			"\t}")
+	}
+	if multi && len(checks) > 0 {
+		ctx.Buffer = append(ctx.Buffer, "\treturn errors.Join(errs...)")
+	} else {
+		ctx.Buffer = append(ctx.Buffer, "\treturn nil")
+	}
+	ctx.Buffer = append(ctx.Buffer, "}")
+	return nil
+}
+
+// validateExceptStub appends the ValidateExcept method of a struct that failed to
+// generate with KeepGoing. It returns the error of the stub Validate.
+func validateExceptStub(ctx *CodeGenContext) {
+	if ctx.Struct.NonStruct || ctx.DeclaredFuncs[ctx.Struct.Name+".ValidateExcept"] {
+		return
+	}
+	receiverVar := ctx.Receiver()
+	ctx.Buffer = append(ctx.Buffer,
+		"",
+		fmt.Sprintf("func (%s) ValidateExcept(fields ...string) error {", ctx.ReceiverDecl()),
+		fmt.Sprintf("\treturn %s.%s()", receiverVar, ctx.MethodName()),
+		"}")
+	if ctx.Struct.NeedsContext {
+		ctx.Buffer = append(ctx.Buffer,
+			"",
+			fmt.Sprintf("func (%s) ValidateExceptContext(ctx context.Context, fields ...string) error {", ctx.ReceiverDecl()),
+			fmt.Sprintf("\treturn %s.%sContext(ctx)", receiverVar, ctx.MethodName()),
+			"}")
+	}
+}
+
+// fieldCases returns the switch cases that validate each field of the struct on its
+// own, followed by a case for the exported fields without rules
+func fieldCases(ctx *CodeGenContext) ([]string, error) {
+	checks, err := fieldChecks(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var cases []string
+	validated := make(map[string]bool)
+	for _, check := range checks {
+		validated[check.Name] = true
+		cases = append(cases, fmt.Sprintf("\t\tcase %q:", check.Name), indentCode(check.Code, 2))
+	}
+
+	var others []string
+	for _, name := range exportedFieldNames(ctx.Struct) {
+		if !validated[name] {
+			others = append(others, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(others) > 0 {
+		cases = append(cases, fmt.Sprintf("\t\tcase %s:", strings.Join(others, ", ")))
+	}
+	return cases, nil
+}
+
+// fieldCheck is the validation code of one field, indented for a method body
+type fieldCheck struct {
+	Name string
+	Code string
+}
+
+// fieldChecks generates the checks of each field with rules again, as for a method
+// of their own. With collect, each field's checks append their error to errs, as in
+// a multi-error Validate.
+func fieldChecks(ctx *CodeGenContext, collect bool) ([]fieldCheck, error) {
+	buffer := ctx.Buffer
+	defer func() { ctx.Buffer = buffer }()
+	ctx.LocalVars = nil
+
+	var checks []fieldCheck
+	for _, field := range ctx.Struct.Fields {
+		ctx.Buffer = nil
+		if err := generateFieldValidation(ctx, field); err != nil {
+			return nil, fmt.Errorf("failed to generate validation for field %s: %w", field.Name, err)
+		}
+		if collect && !collectErrors(ctx, 0) {
+			continue
+		}
+		if len(ctx.Buffer) == 0 {
+			continue
+		}
+		checks = append(checks, fieldCheck{Name: field.Name, Code: strings.Join(ctx.Buffer, "\n")})
+	}
+	return checks, nil
+}
+
+// exportedFieldNames returns the names of the exported fields of the struct type
+// of s in declaration order, including embedded ones
+func exportedFieldNames(s *StructInfo) []string {
+	if s.TypeSpec == nil {
+		return nil
+	}
+	structType, ok := s.TypeSpec.Type.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return nil
+	}
+	var names []string
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			if name := embeddedFieldName(field.Type); ast.IsExported(name) {
+				names = append(names, name)
+			}
+			continue
+		}
+		for _, ident := range field.Names {
+			if ast.IsExported(ident.Name) {
+				names = append(names, ident.Name)
+			}
+		}
+	}
+	return names
+}
@@ -2,9 +2,15 @@ package generator
 
 import (
 	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
 	"go/types"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/n10ty/houp/pkg/isodata"
 )
 
 // RequiredRule validates that a field is not a zero value
@@ -19,51 +25,42 @@ func (r *RequiredRule) Validate(fieldType TypeInfo) error {
 
 func (r *RequiredRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	ref := ctx.FieldRef(field)
 
 	// Generate appropriate check based on type
-	if typeInfo.IsPointer {
-		return fmt.Sprintf(`	if %s.%s == nil {
-		return fmt.Errorf("field %s is required")
-	}`, receiverVar, field.Name, field.Name), nil
-	}
-
-	if typeInfo.IsSlice {
-		return fmt.Sprintf(`	if %s.%s == nil || len(%s.%s) == 0 {
-		return fmt.Errorf("field %s is required")
-	}`, receiverVar, field.Name, receiverVar, field.Name, field.Name), nil
-	}
-
-	switch typeInfo.Kind {
-	case TypeString:
-		return fmt.Sprintf(`	if %s.%s == "" {
-		return fmt.Errorf("field %s is required")
-	}`, receiverVar, field.Name, field.Name), nil
-
-	case TypeInt, TypeInt8, TypeInt16, TypeInt32, TypeInt64,
-		TypeUint, TypeUint8, TypeUint16, TypeUint32, TypeUint64:
-		return fmt.Sprintf(`	if %s.%s == 0 {
-		return fmt.Errorf("field %s is required")
-	}`, receiverVar, field.Name, field.Name), nil
-
-	case TypeFloat32, TypeFloat64:
-		return fmt.Sprintf(`	if %s.%s == 0 {
-		return fmt.Errorf("field %s is required")
-	}`, receiverVar, field.Name, field.Name), nil
-
-	case TypeBool:
+	var cond string
+	switch {
+	case typeInfo.IsPointer:
+		cond = ref + " == nil"
+	case typeInfo.IsSlice:
+		cond = fmt.Sprintf("%s == nil || len(%s) == 0", ref, ref)
+	case typeInfo.Kind == TypeMap:
+		cond = fmt.Sprintf("len(%s) == 0", ref)
+	case typeInfo.IsTime() || typeInfo.IsDecimal():
+		cond = ref + ".IsZero()"
+	case typeInfo.IsUUID():
+		cond = fmt.Sprintf("%s == %s.Nil", ref, ctx.AddImport(uuidPkgPath, "uuid"))
+	case typeInfo.Kind == TypeString:
+		cond = ref + ` == ""`
+	case typeInfo.Kind >= TypeInt && typeInfo.Kind <= TypeFloat64:
+		cond = ref + " == 0"
+	case typeInfo.Kind == TypeBool:
 		// For bool, required doesn't make much sense, but check for explicit false
-		return fmt.Sprintf(`	// field %s: required validation skipped for bool type`, field.Name), nil
-
+		return fmt.Sprintf("\t// field %s: required validation skipped for bool type", field.Name), nil
 	default:
 		// For structs and other types, we can't easily check zero value
-		return fmt.Sprintf(`	// field %s: required validation not implemented for this type`, field.Name), nil
+		return fmt.Sprintf("\t// field %s: required validation not implemented for this type", field.Name), nil
 	}
+	return renderCheck(ctx, cond, fmt.Sprintf("field %s is required", field.Name))
 }
 
-// EqFieldRule validates that a field equals another field
+// EqFieldRule validates that a field equals another field.
+// When UsingFunc is set, equality is decided by calling UsingFunc(field, other)
+// instead of the == operator.
 type EqFieldRule struct {
-	OtherField string
+	OtherField      string
+	UsingImportPath string // empty for a function in the same package
+	UsingFunc       string
 }
 
 func (r *EqFieldRule) Name() string { return "eqfield" }
@@ -75,7 +72,7 @@ func (r *EqFieldRule) Validate(fieldType TypeInfo) error {
 
 func (r *EqFieldRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	receiverVar := ctx.Receiver()
 
 	// Find the other field to get its type
 	var otherFieldInfo *FieldInfo
@@ -97,50 +94,135 @@ func (r *EqFieldRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, e
 	}
 
 	// Build field references
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
+	fieldRef := ctx.FieldRef(field)
 	otherFieldRef := fmt.Sprintf("%s.%s", receiverVar, r.OtherField)
 
+	// notEqual builds the inequality condition for two (dereferenced) values
+	notEqual := func(a, b string) string {
+		return fmt.Sprintf("%s != %s", a, b)
+	}
+	// time.Time compares with Equal, since == also compares the location and monotonic reading
+	if ctx.FieldExpr(field).Elem.IsTime() {
+		notEqual = func(a, b string) string {
+			if strings.HasPrefix(a, "*") {
+				a = "(" + a + ")"
+			}
+			return fmt.Sprintf("!%s.Equal(%s)", a, b)
+		}
+	}
+	if r.UsingFunc != "" {
+		var funcRef string
+		if r.UsingImportPath != "" && r.UsingImportPath != ctx.PkgPath {
+			parts := strings.Split(r.UsingImportPath, "/")
+			alias := ctx.AddImport(r.UsingImportPath, parts[len(parts)-1])
+			funcRef = alias + "." + r.UsingFunc
+		} else {
+			funcRef = ctx.LocalRef(r.UsingFunc)
+		}
+		notEqual = func(a, b string) string {
+			return fmt.Sprintf("!%s(%s, %s)", funcRef, a, b)
+		}
+	}
+
+	message := fmt.Sprintf("field %s must equal field %s", field.Name, r.OtherField)
+
+	// derefCheck compares dereferenced values while guard holds, and fails with
+	// elseMessage otherwise if elseCond holds or is empty
+	derefCheck := func(guard, cond, elseCond, elseMessage string) (string, error) {
+		check, err := renderCheck(ctx, cond, message)
+		if err != nil {
+			return "", err
+		}
+		failure, err := renderFail(ctx, elseMessage)
+		if err != nil {
+			return "", err
+		}
+		return renderCode("branch", branchData{
+			Cond:      guard,
+			Lines:     codeLines(check),
+			ElseCond:  elseCond,
+			ElseLines: []string{failure},
+		})
+	}
+
 	// Handle pointer types - need to compare dereferenced values
 	if typeInfo.IsPointer && otherFieldTypeInfo.IsPointer {
 		// Both pointers - check if both non-nil and equal, or handle nil mismatch
-		return fmt.Sprintf(`	if %s != nil && %s != nil {
-		if *%s != *%s {
-			return fmt.Errorf("field %s must equal field %s")
-		}
-	} else if (%s == nil) != (%s == nil) {
-		return fmt.Errorf("field %s must equal field %s")
-	}`, fieldRef, otherFieldRef, fieldRef, otherFieldRef, field.Name, r.OtherField,
-			fieldRef, otherFieldRef, field.Name, r.OtherField), nil
+		return derefCheck(fmt.Sprintf("%s != nil && %s != nil", fieldRef, otherFieldRef),
+			notEqual("*"+fieldRef, "*"+otherFieldRef),
+			fmt.Sprintf("(%s == nil) != (%s == nil)", fieldRef, otherFieldRef), message)
 	}
 
 	if typeInfo.IsPointer && !otherFieldTypeInfo.IsPointer {
 		// Current field is pointer, other is not
-		return fmt.Sprintf(`	if %s != nil {
-		if *%s != %s {
-			return fmt.Errorf("field %s must equal field %s")
-		}
-	} else {
-		return fmt.Errorf("field %s must equal field %s (pointer is nil)")
-	}`, fieldRef, fieldRef, otherFieldRef, field.Name, r.OtherField,
-			field.Name, r.OtherField), nil
+		return derefCheck(fieldRef+" != nil", notEqual("*"+fieldRef, otherFieldRef), "", message+" (pointer is nil)")
 	}
 
 	if !typeInfo.IsPointer && otherFieldTypeInfo.IsPointer {
 		// Other field is pointer, current is not
-		return fmt.Sprintf(`	if %s != nil {
-		if %s != *%s {
-			return fmt.Errorf("field %s must equal field %s")
-		}
-	} else {
-		return fmt.Errorf("field %s must equal field %s (comparison field is nil)")
-	}`, otherFieldRef, fieldRef, otherFieldRef, field.Name, r.OtherField,
-			field.Name, r.OtherField), nil
+		return derefCheck(otherFieldRef+" != nil", notEqual(fieldRef, "*"+otherFieldRef), "", message+" (comparison field is nil)")
 	}
 
 	// Neither is a pointer - simple comparison
-	return fmt.Sprintf(`	if %s != %s {
-		return fmt.Errorf("field %s must equal field %s")
-	}`, fieldRef, otherFieldRef, field.Name, r.OtherField), nil
+	return renderCheck(ctx, notEqual(fieldRef, otherFieldRef), message)
+}
+
+// FieldCompareRule orders a field against another field of the struct: gtfield,
+// gtefield, ltfield and ltefield. Numbers are compared with operators and time.Time
+// with After and Before. Like other rules, a pointer field is dereferenced, so it
+// needs omitempty or required; the check is skipped while the other field is nil.
+type FieldCompareRule struct {
+	Op         string // "gt", "gte", "lt" or "lte"
+	OtherField string
+}
+
+func (r *FieldCompareRule) Name() string { return r.Op + "field" }
+
+func (r *FieldCompareRule) Validate(fieldType TypeInfo) error {
+	if !fieldType.IsNumeric() && !fieldType.IsTime() && fieldType.Kind != TypePointer {
+		return fmt.Errorf("%s validation only applicable to numeric and time.Time types", r.Name())
+	}
+	return nil
+}
+
+func (r *FieldCompareRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	otherType := structFieldType(ctx.Struct, r.OtherField)
+	if otherType == nil {
+		return "", fmt.Errorf("%s=%s on field %s: struct %s has no field %s", r.Name(), r.OtherField, field.Name, ctx.Struct.Name, r.OtherField)
+	}
+	expr := ctx.FieldExpr(field)
+	other := ctx.FieldExpr(&FieldInfo{Name: r.OtherField, Type: otherType})
+
+	// Each operator fails on the opposite comparison: gt fails unless the field is after or greater
+	var op, numberMessage, timeCond, timeMessage string
+	switch r.Op {
+	case "gt":
+		op, numberMessage, timeCond, timeMessage = "<=", "greater than", "!%s.After(%s)", "after"
+	case "gte":
+		op, numberMessage, timeCond, timeMessage = "<", "at least", "%s.Before(%s)", "not before"
+	case "lt":
+		op, numberMessage, timeCond, timeMessage = ">=", "less than", "!%s.Before(%s)", "before"
+	case "lte":
+		op, numberMessage, timeCond, timeMessage = ">", "at most", "%s.After(%s)", "not after"
+	default:
+		return "", fmt.Errorf("unsupported field comparison %s", r.Name())
+	}
+
+	var cond, message string
+	switch {
+	case expr.Elem.IsTime() && other.Elem.IsTime():
+		cond, message = fmt.Sprintf(timeCond, expr.Operand(), other.Value()), timeMessage
+	case expr.Elem.IsNumeric() && other.Elem.IsNumeric() && expr.Elem.Kind != TypeJSONNumber && other.Elem.Kind != TypeJSONNumber:
+		cond, message = fmt.Sprintf("%s %s %s", expr.Value(), op, other.Value()), numberMessage
+	default:
+		return "", fmt.Errorf("%s=%s on field %s: both fields must be numbers or both time.Time", r.Name(), r.OtherField, field.Name)
+	}
+
+	code, err := renderCheck(ctx, cond, fmt.Sprintf("field %s must be %s field %s", field.Name, message, r.OtherField))
+	if err != nil || !other.Pointer {
+		return code, err
+	}
+	return guardNil(other.Ref, code)
 }
 
 // RequiredWithoutRule validates that a field is not zero when another field is zero
@@ -157,7 +239,7 @@ func (r *RequiredWithoutRule) Validate(fieldType TypeInfo) error {
 
 func (r *RequiredWithoutRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	receiverVar := ctx.Receiver()
 
 	// Find the other field to get its type
 	var otherFieldInfo *FieldInfo
@@ -194,24 +276,24 @@ func (r *RequiredWithoutRule) Generate(ctx *CodeGenContext, field *FieldInfo) (s
 	}
 
 	// Generate condition to check if current field is zero/empty
+	ref := ctx.FieldRef(field)
 	var currentFieldIsEmpty string
 	if typeInfo.IsPointer {
-		currentFieldIsEmpty = fmt.Sprintf("%s.%s == nil", receiverVar, field.Name)
+		currentFieldIsEmpty = fmt.Sprintf("%s == nil", ref)
 	} else if typeInfo.IsSlice {
-		currentFieldIsEmpty = fmt.Sprintf("(%s.%s == nil || len(%s.%s) == 0)", receiverVar, field.Name, receiverVar, field.Name)
+		currentFieldIsEmpty = fmt.Sprintf("(%s == nil || len(%s) == 0)", ref, ref)
 	} else if typeInfo.Kind == TypeString {
-		currentFieldIsEmpty = fmt.Sprintf("%s.%s == \"\"", receiverVar, field.Name)
+		currentFieldIsEmpty = fmt.Sprintf("%s == \"\"", ref)
 	} else if typeInfo.IsNumeric() {
-		currentFieldIsEmpty = fmt.Sprintf("%s.%s == 0", receiverVar, field.Name)
+		currentFieldIsEmpty = fmt.Sprintf("%s == 0", ref)
 	} else {
 		// For unknown types, skip validation
 		return fmt.Sprintf("\t// field %s: required_without validation not implemented for this type", field.Name), nil
 	}
 
 	// Generate validation: if other field is empty, then this field is required
-	return fmt.Sprintf(`	if %s && %s {
-		return fmt.Errorf("field %s is required when %s is not provided")
-	}`, otherFieldIsEmpty, currentFieldIsEmpty, field.Name, r.OtherField), nil
+	return renderCheck(ctx, otherFieldIsEmpty+" && "+currentFieldIsEmpty,
+		fmt.Sprintf("field %s is required when %s is not provided", field.Name, r.OtherField))
 }
 
 // OmitEmptyRule wraps other validations to skip if field is empty
@@ -229,9 +311,13 @@ func (r *OmitEmptyRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string,
 	return "", nil
 }
 
-// MinRule validates minimum value or length
+// MinRule validates minimum value or length.
+// With Trim, string lengths are measured after strings.TrimSpace; with Runes, they
+// count UTF-8 runes instead of bytes.
 type MinRule struct {
 	Value string
+	Trim  bool
+	Runes bool
 }
 
 func (r *MinRule) Name() string { return "min" }
@@ -244,69 +330,48 @@ func (r *MinRule) Validate(fieldType TypeInfo) error {
 }
 
 func (r *MinRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Track if we need to dereference
-	needsDeref := typeInfo.IsPointer && typeInfo.Elem != nil
+	expr := ctx.FieldExpr(field)
+	typeInfo := expr.Elem
 
-	// Handle pointer types
-	if typeInfo.IsPointer && typeInfo.Elem != nil {
-		typeInfo = *typeInfo.Elem
+	value, err := formatNumericBound(r.Value, typeInfo.IsSlice || typeInfo.Kind == TypeMap || typeInfo.Kind == TypeString || typeInfo.IntegerBounds())
+	if err != nil {
+		return "", fmt.Errorf("min validation on field %s: %w", field.Name, err)
 	}
 
-	// Build field reference
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if needsDeref && typeInfo.Kind == TypeString {
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+	if err := checkStringLengthOptions(typeInfo, r.Trim, r.Runes); err != nil {
+		return "", fmt.Errorf("min validation on field %s: %w", field.Name, err)
 	}
 
 	if typeInfo.IsSlice {
-		return fmt.Sprintf(`	if len(%s.%s) < %s {
-		return fmt.Errorf("field %s must have at least %s elements")
-	}`, receiverVar, field.Name, r.Value, field.Name, r.Value), nil
-	}
-
-	switch typeInfo.Kind {
-	case TypeString:
-		return fmt.Sprintf(`	if len(%s) < %s {
-		return fmt.Errorf("field %s must be at least %s characters")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
-
-	case TypeInt, TypeInt8, TypeInt16, TypeInt32, TypeInt64,
-		TypeUint, TypeUint8, TypeUint16, TypeUint32, TypeUint64,
-		TypeFloat32, TypeFloat64:
-		if needsDeref {
-			fieldRef = fmt.Sprintf("*%s.%s", receiverVar, field.Name)
-		}
-		return fmt.Sprintf(`	if %s < %s {
-		return fmt.Errorf("field %s must be at least %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
-
-	case TypeJSONNumber:
-		// For json.Number, convert to float64 and compare
-		if needsDeref {
-			fieldRef = fmt.Sprintf("*%s.%s", receiverVar, field.Name)
-		}
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+		return renderCheck(ctx, fmt.Sprintf("len(%s) < %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have at least %s %s", field.Name, value, sliceUnit(typeInfo)))
 	}
-	if %s < %s {
-		return fmt.Errorf("field %s must be at least %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+
+	if typeInfo.Kind == TypeMap {
+		return renderCheck(ctx, fmt.Sprintf("len(%s) < %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have at least %s keys", field.Name, value))
+	}
+
+	switch {
+	case typeInfo.Kind == TypeString:
+		return renderCheck(ctx, fmt.Sprintf("%s < %s", stringLength(ctx, expr, r.Trim, r.Runes), value),
+			fmt.Sprintf("field %s must be at least %s characters", field.Name, value))
+
+	case typeInfo.IsNumeric() || typeInfo.IsCmpNumber():
+		return numericBoundCheck(ctx, field, r, "<", value, "at least "+value)
 
 	default:
 		return "", fmt.Errorf("min validation not supported for type %s", typeInfo.Name)
 	}
 }
 
-// MaxRule validates maximum value or length
+// MaxRule validates maximum value or length.
+// With Trim, string lengths are measured after strings.TrimSpace; with Runes, they
+// count UTF-8 runes instead of bytes.
 type MaxRule struct {
 	Value string
+	Trim  bool
+	Runes bool
 }
 
 func (r *MaxRule) Name() string { return "max" }
@@ -319,121 +384,166 @@ func (r *MaxRule) Validate(fieldType TypeInfo) error {
 }
 
 func (r *MaxRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Track if we need to dereference
-	needsDeref := typeInfo.IsPointer && typeInfo.Elem != nil
+	expr := ctx.FieldExpr(field)
+	typeInfo := expr.Elem
 
-	// Handle pointer types
-	if typeInfo.IsPointer && typeInfo.Elem != nil {
-		typeInfo = *typeInfo.Elem
+	value, err := formatNumericBound(r.Value, typeInfo.IsSlice || typeInfo.Kind == TypeMap || typeInfo.Kind == TypeString || typeInfo.IntegerBounds())
+	if err != nil {
+		return "", fmt.Errorf("max validation on field %s: %w", field.Name, err)
 	}
 
-	// Build field reference
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if needsDeref && typeInfo.Kind == TypeString {
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+	if err := checkStringLengthOptions(typeInfo, r.Trim, r.Runes); err != nil {
+		return "", fmt.Errorf("max validation on field %s: %w", field.Name, err)
 	}
 
 	if typeInfo.IsSlice {
-		return fmt.Sprintf(`	if len(%s.%s) > %s {
-		return fmt.Errorf("field %s must have at most %s elements")
-	}`, receiverVar, field.Name, r.Value, field.Name, r.Value), nil
-	}
-
-	switch typeInfo.Kind {
-	case TypeString:
-		return fmt.Sprintf(`	if len(%s) > %s {
-		return fmt.Errorf("field %s must be at most %s characters")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
-
-	case TypeInt, TypeInt8, TypeInt16, TypeInt32, TypeInt64,
-		TypeUint, TypeUint8, TypeUint16, TypeUint32, TypeUint64,
-		TypeFloat32, TypeFloat64:
-		if needsDeref {
-			fieldRef = fmt.Sprintf("*%s.%s", receiverVar, field.Name)
-		}
-		return fmt.Sprintf(`	if %s > %s {
-		return fmt.Errorf("field %s must be at most %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
-
-	case TypeJSONNumber:
-		// For json.Number, convert to float64 and compare
-		if needsDeref {
-			fieldRef = fmt.Sprintf("*%s.%s", receiverVar, field.Name)
-		}
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+		return renderCheck(ctx, fmt.Sprintf("len(%s) > %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have at most %s %s", field.Name, value, sliceUnit(typeInfo)))
+	}
+
+	if typeInfo.Kind == TypeMap {
+		return renderCheck(ctx, fmt.Sprintf("len(%s) > %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have at most %s keys", field.Name, value))
 	}
-	if %s > %s {
-		return fmt.Errorf("field %s must be at most %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+
+	switch {
+	case typeInfo.Kind == TypeString:
+		return renderCheck(ctx, fmt.Sprintf("%s > %s", stringLength(ctx, expr, r.Trim, r.Runes), value),
+			fmt.Sprintf("field %s must be at most %s characters", field.Name, value))
+
+	case typeInfo.IsNumeric() || typeInfo.IsCmpNumber():
+		return numericBoundCheck(ctx, field, r, ">", value, "at most "+value)
 
 	default:
 		return "", fmt.Errorf("max validation not supported for type %s", typeInfo.Name)
 	}
 }
 
-// GTRule validates greater than (exclusive)
-type GTRule struct {
+// LenRule validates the exact length of a string, slice or map.
+// The trim and runes options apply to strings as they do for min and max.
+type LenRule struct {
 	Value string
+	Trim  bool
+	Runes bool
 }
 
-func (r *GTRule) Name() string { return "gt" }
+func (r *LenRule) Name() string { return "len" }
 
-func (r *GTRule) Validate(fieldType TypeInfo) error {
-	if !fieldType.IsNumeric() && fieldType.Kind != TypePointer {
-		return fmt.Errorf("gt validation only applicable to numeric types")
+func (r *LenRule) Validate(fieldType TypeInfo) error {
+	if fieldType.Kind == TypeBool || fieldType.IsNumeric() {
+		return fmt.Errorf("len validation only applicable to strings, slices and maps")
 	}
 	return nil
 }
 
-func (r *GTRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+func (r *LenRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	typeInfo := expr.Elem
 
-	// Handle pointer types
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if typeInfo.IsPointer {
-		if typeInfo.Elem != nil && typeInfo.Elem.Kind == TypeJSONNumber {
-			// Pointer to json.Number
-			fieldRef = fmt.Sprintf("(*%s.%s)", receiverVar, field.Name)
-			// Use unique variable name to avoid redeclaration
-			ctx.VarCounter++
-			varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-			return fmt.Sprintf(`	%s, err := %s.Float64()
+	value, err := formatNumericBound(r.Value, true)
 	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+		return "", fmt.Errorf("len validation on field %s: %w", field.Name, err)
+	}
+
+	if err := checkStringLengthOptions(typeInfo, r.Trim, r.Runes); err != nil {
+		return "", fmt.Errorf("len validation on field %s: %w", field.Name, err)
+	}
+
+	switch {
+	case typeInfo.IsSlice:
+		return renderCheck(ctx, fmt.Sprintf("len(%s) != %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have exactly %s %s", field.Name, value, sliceUnit(typeInfo)))
+
+	case typeInfo.Kind == TypeMap:
+		return renderCheck(ctx, fmt.Sprintf("len(%s) != %s", expr.Value(), value),
+			fmt.Sprintf("field %s must have exactly %s keys", field.Name, value))
+
+	case typeInfo.Kind == TypeString:
+		return renderCheck(ctx, fmt.Sprintf("%s != %s", stringLength(ctx, expr, r.Trim, r.Runes), value),
+			fmt.Sprintf("field %s must be exactly %s characters", field.Name, value))
+
+	default:
+		return "", fmt.Errorf("len validation not supported for type %s", typeInfo.Name)
+	}
+}
+
+// checkStringLengthOptions rejects the trim and runes options on non-string fields
+func checkStringLengthOptions(typeInfo TypeInfo, trim, runes bool) error {
+	if typeInfo.Kind == TypeString {
+		return nil
+	}
+	if trim {
+		return fmt.Errorf("trim option only applies to strings")
+	}
+	if runes {
+		return fmt.Errorf("runes option only applies to strings")
+	}
+	return nil
+}
+
+// stringLength returns the length expression for a string field: len of the value,
+// of strings.TrimSpace of the value with trim, and utf8.RuneCountInString with runes
+func stringLength(ctx *CodeGenContext, expr FieldExpr, trim, runes bool) string {
+	if !trim && !runes {
+		return fmt.Sprintf("len(%s)", expr.Value())
 	}
-	if %s <= %s {
-		return fmt.Errorf("field %s must be greater than %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+	ref, _ := expr.StringValue("length")
+	if trim {
+		ctx.AddImport("strings", "strings")
+		ref = fmt.Sprintf("strings.TrimSpace(%s)", ref)
+	}
+	if runes {
+		ctx.AddImport("unicode/utf8", "utf8")
+		return fmt.Sprintf("utf8.RuneCountInString(%s)", ref)
+	}
+	return fmt.Sprintf("len(%s)", ref)
+}
+
+// nanGuard returns a "math.IsNaN(x) || " prefix for bound checks on floating-point values.
+// NaN compares false against every bound, so without the guard it would pass all of them.
+// For json.Number, ref is the float64 returned by Float64.
+func nanGuard(ctx *CodeGenContext, typeInfo TypeInfo, ref string) string {
+	switch {
+	case typeInfo.Kind == TypeJSONNumber:
+	case typeInfo.IsFloat():
+		if typeInfo.Name != "float64" {
+			ref = fmt.Sprintf("float64(%s)", ref)
 		}
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+	default:
+		return ""
 	}
 
-	// Handle json.Number
-	if typeInfo.Kind == TypeJSONNumber {
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+	ctx.AddImport("math", "math")
+	return fmt.Sprintf("math.IsNaN(%s) || ", ref)
+}
+
+// GTRule validates greater than (exclusive)
+type GTRule struct {
+	Value string
+}
+
+func (r *GTRule) Name() string { return "gt" }
+
+func (r *GTRule) Validate(fieldType TypeInfo) error {
+	if r.Value == timeBoundNow {
+		return validateTimeBound(r.Name(), fieldType)
 	}
-	if %s <= %s {
-		return fmt.Errorf("field %s must be greater than %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+	if !fieldType.IsNumeric() && !fieldType.IsCmpNumber() && fieldType.Kind != TypePointer {
+		return fmt.Errorf("gt validation only applicable to numeric types")
 	}
+	return nil
+}
 
-	return fmt.Sprintf(`	if %s <= %s {
-		return fmt.Errorf("field %s must be greater than %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
+func (r *GTRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if r.Value == timeBoundNow || expr.Elem.IsTime() {
+		return timeBoundCheck(ctx, field, r.Name(), r.Value, "!%s.After(time.Now())", "in the future")
+	}
+	value, err := formatNumericBound(r.Value, expr.Elem.IntegerBounds())
+	if err != nil {
+		return "", fmt.Errorf("gt validation on field %s: %w", field.Name, err)
+	}
+	return numericBoundCheck(ctx, field, r, "<=", value, "greater than "+value)
 }
 
 // LTRule validates less than (exclusive)
@@ -444,53 +554,25 @@ type LTRule struct {
 func (r *LTRule) Name() string { return "lt" }
 
 func (r *LTRule) Validate(fieldType TypeInfo) error {
-	if !fieldType.IsNumeric() && fieldType.Kind != TypePointer {
+	if r.Value == timeBoundNow {
+		return validateTimeBound(r.Name(), fieldType)
+	}
+	if !fieldType.IsNumeric() && !fieldType.IsCmpNumber() && fieldType.Kind != TypePointer {
 		return fmt.Errorf("lt validation only applicable to numeric types")
 	}
 	return nil
 }
 
 func (r *LTRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Handle pointer types
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if typeInfo.IsPointer {
-		if typeInfo.Elem != nil && typeInfo.Elem.Kind == TypeJSONNumber {
-			// Pointer to json.Number
-			fieldRef = fmt.Sprintf("(*%s.%s)", receiverVar, field.Name)
-			// Use unique variable name to avoid redeclaration
-			ctx.VarCounter++
-			varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-			return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+	expr := ctx.FieldExpr(field)
+	if r.Value == timeBoundNow || expr.Elem.IsTime() {
+		return timeBoundCheck(ctx, field, r.Name(), r.Value, "!%s.Before(time.Now())", "in the past")
 	}
-	if %s >= %s {
-		return fmt.Errorf("field %s must be less than %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
-		}
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
-	}
-
-	// Handle json.Number
-	if typeInfo.Kind == TypeJSONNumber {
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
+	value, err := formatNumericBound(r.Value, expr.Elem.IntegerBounds())
 	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
-	}
-	if %s >= %s {
-		return fmt.Errorf("field %s must be less than %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+		return "", fmt.Errorf("lt validation on field %s: %w", field.Name, err)
 	}
-
-	return fmt.Sprintf(`	if %s >= %s {
-		return fmt.Errorf("field %s must be less than %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
+	return numericBoundCheck(ctx, field, r, ">=", value, "less than "+value)
 }
 
 // GTERule validates greater than or equal (inclusive)
@@ -501,53 +583,25 @@ type GTERule struct {
 func (r *GTERule) Name() string { return "gte" }
 
 func (r *GTERule) Validate(fieldType TypeInfo) error {
-	if !fieldType.IsNumeric() && fieldType.Kind != TypePointer {
+	if r.Value == timeBoundNow {
+		return validateTimeBound(r.Name(), fieldType)
+	}
+	if !fieldType.IsNumeric() && !fieldType.IsCmpNumber() && fieldType.Kind != TypePointer {
 		return fmt.Errorf("gte validation only applicable to numeric types")
 	}
 	return nil
 }
 
 func (r *GTERule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Handle pointer types
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if typeInfo.IsPointer {
-		if typeInfo.Elem != nil && typeInfo.Elem.Kind == TypeJSONNumber {
-			// Pointer to json.Number
-			fieldRef = fmt.Sprintf("(*%s.%s)", receiverVar, field.Name)
-			// Use unique variable name to avoid redeclaration
-			ctx.VarCounter++
-			varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-			return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
-	}
-	if %s < %s {
-		return fmt.Errorf("field %s must be at least %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
-		}
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+	expr := ctx.FieldExpr(field)
+	if r.Value == timeBoundNow || expr.Elem.IsTime() {
+		return timeBoundCheck(ctx, field, r.Name(), r.Value, "%s.Before(time.Now())", "not in the past")
 	}
-
-	// Handle json.Number
-	if typeInfo.Kind == TypeJSONNumber {
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
+	value, err := formatNumericBound(r.Value, expr.Elem.IntegerBounds())
 	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
-	}
-	if %s < %s {
-		return fmt.Errorf("field %s must be at least %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+		return "", fmt.Errorf("gte validation on field %s: %w", field.Name, err)
 	}
-
-	return fmt.Sprintf(`	if %s < %s {
-		return fmt.Errorf("field %s must be at least %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
+	return numericBoundCheck(ctx, field, r, "<", value, "at least "+value)
 }
 
 // LTERule validates less than or equal (inclusive)
@@ -558,53 +612,51 @@ type LTERule struct {
 func (r *LTERule) Name() string { return "lte" }
 
 func (r *LTERule) Validate(fieldType TypeInfo) error {
-	if !fieldType.IsNumeric() && fieldType.Kind != TypePointer {
+	if r.Value == timeBoundNow {
+		return validateTimeBound(r.Name(), fieldType)
+	}
+	if !fieldType.IsNumeric() && !fieldType.IsCmpNumber() && fieldType.Kind != TypePointer {
 		return fmt.Errorf("lte validation only applicable to numeric types")
 	}
 	return nil
 }
 
 func (r *LTERule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Handle pointer types
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-	if typeInfo.IsPointer {
-		if typeInfo.Elem != nil && typeInfo.Elem.Kind == TypeJSONNumber {
-			// Pointer to json.Number
-			fieldRef = fmt.Sprintf("(*%s.%s)", receiverVar, field.Name)
-			// Use unique variable name to avoid redeclaration
-			ctx.VarCounter++
-			varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-			return fmt.Sprintf(`	%s, err := %s.Float64()
-	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
+	expr := ctx.FieldExpr(field)
+	if r.Value == timeBoundNow || expr.Elem.IsTime() {
+		return timeBoundCheck(ctx, field, r.Name(), r.Value, "%s.After(time.Now())", "not in the future")
 	}
-	if %s > %s {
-		return fmt.Errorf("field %s must be at most %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
-		}
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
-	}
-
-	// Handle json.Number
-	if typeInfo.Kind == TypeJSONNumber {
-		// Use unique variable name to avoid redeclaration
-		ctx.VarCounter++
-		varName := fmt.Sprintf("%sFloat%d", field.Name, ctx.VarCounter)
-		return fmt.Sprintf(`	%s, err := %s.Float64()
+	value, err := formatNumericBound(r.Value, expr.Elem.IntegerBounds())
 	if err != nil {
-		return fmt.Errorf("field %s must be a valid number: %%w", err)
-	}
-	if %s > %s {
-		return fmt.Errorf("field %s must be at most %s")
-	}`, varName, fieldRef, field.Name, varName, r.Value, field.Name, r.Value), nil
+		return "", fmt.Errorf("lte validation on field %s: %w", field.Name, err)
 	}
+	return numericBoundCheck(ctx, field, r, ">", value, "at most "+value)
+}
+
+// FutureRule validates that a time.Time is after the current time, like gt=now
+type FutureRule struct{}
+
+func (r *FutureRule) Name() string { return "future" }
+
+func (r *FutureRule) Validate(fieldType TypeInfo) error {
+	return validateTimeBound(r.Name(), fieldType)
+}
+
+func (r *FutureRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	return timeBoundCheck(ctx, field, r.Name(), timeBoundNow, "!%s.After(time.Now())", "in the future")
+}
+
+// PastRule validates that a time.Time is before the current time, like lt=now
+type PastRule struct{}
 
-	return fmt.Sprintf(`	if %s > %s {
-		return fmt.Errorf("field %s must be at most %s")
-	}`, fieldRef, r.Value, field.Name, r.Value), nil
+func (r *PastRule) Name() string { return "past" }
+
+func (r *PastRule) Validate(fieldType TypeInfo) error {
+	return validateTimeBound(r.Name(), fieldType)
+}
+
+func (r *PastRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	return timeBoundCheck(ctx, field, r.Name(), timeBoundNow, "!%s.Before(time.Now())", "in the past")
 }
 
 // RegexpRule validates using an imported regexp variable
@@ -617,11 +669,11 @@ func (r *RegexpRule) Name() string { return "regexp" }
 
 func (r *RegexpRule) Validate(fieldType TypeInfo) error {
 	// Handle pointer to string
-	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+	if fieldType.IsPointer && fieldType.Elem != nil {
+		fieldType = *fieldType.Elem
 	}
 
-	if fieldType.Kind != TypeString {
+	if fieldType.Kind != TypeString && !fieldType.IsBytes() {
 		// Silently skip non-string types as per requirements
 		return nil
 	}
@@ -629,40 +681,24 @@ func (r *RegexpRule) Validate(fieldType TypeInfo) error {
 }
 
 func (r *RegexpRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-
 	// Skip non-string types
-	if typeInfo.Kind != TypeString {
-		if typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString {
-			return "", nil
-		}
-		if !typeInfo.IsPointer {
-			return "", nil
-		}
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", nil
 	}
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
 	// Add import
 	parts := strings.Split(r.ImportPath, "/")
 	pkgName := parts[len(parts)-1]
 	alias := ctx.AddImport(r.ImportPath, pkgName)
 
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-
-	if typeInfo.IsPointer {
-		// For pointer to string, dereference
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
-	}
-
-	return fmt.Sprintf(`	if !%s.%s.MatchString(%s) {
-		return fmt.Errorf("field %s does not match required pattern")
-	}`, alias, r.VarName, fieldRef, field.Name), nil
+	return renderCheck(ctx, fmt.Sprintf("!%s.%s.MatchString(%s)", alias, r.VarName, fieldRef),
+		fmt.Sprintf("field %s does not match required pattern", field.Name))
 }
 
 // UniqueRule validates uniqueness within a slice
 type UniqueRule struct {
-	FieldName string // empty for scalar slices
+	FieldNames []string // empty for scalar slices; several for a composite key (unique=A+B)
 }
 
 func (r *UniqueRule) Name() string { return "unique" }
@@ -683,67 +719,111 @@ func (r *UniqueRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, er
 		return "", nil
 	}
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-	mapVar := fmt.Sprintf("seen%s", field.Name)
+	data := uniqueData{
+		Map: fmt.Sprintf("seen%s%s", field.identName(), strings.Join(r.FieldNames, "")),
+		Ref: ctx.FieldRef(field),
+	}
+
+	if len(r.FieldNames) == 0 {
+		// Scalar slice - key the map by the element type itself
+		data.KeyType, data.Key = uniqueKey(ctx, uniqueSliceElem(ctx, field), "item")
+		data.Seen = "item"
+		data.Failure = fieldError(ctx, checkData{Message: fmt.Sprintf("field %s has duplicate value at index %%d", field.Name), Args: []string{"i"}})
+	} else {
+		// Struct slice - key the map by the field's own type, or by a local struct
+		// type holding every field of a composite key
+		keyTypes := make([]string, len(r.FieldNames))
+		values := make([]string, len(r.FieldNames))
+		for i, name := range r.FieldNames {
+			t, err := uniqueElemField(ctx, field, name)
+			if err != nil {
+				return "", err
+			}
+			keyTypes[i], values[i] = uniqueKey(ctx, t, "item."+name)
+		}
 
-	if r.FieldName != "" {
-		mapVar = fmt.Sprintf("seen%s%s", field.Name, r.FieldName)
+		data.KeyType, data.Key = keyTypes[0], values[0]
+		if len(r.FieldNames) > 1 {
+			data.KeyType = data.Map + "Key"
+			for i, name := range r.FieldNames {
+				data.KeyFields = append(data.KeyFields, uniqueKeyField{Name: name, Type: keyTypes[i]})
+			}
+			data.Key = fmt.Sprintf("%s{%s}", data.KeyType, strings.Join(values, ", "))
+		}
+		data.Seen = "item." + r.FieldNames[0]
+		data.SkipNil = typeInfo.Elem != nil && typeInfo.Elem.IsPointer
+		data.Failure = fieldError(ctx, checkData{Message: fmt.Sprintf("field %s has duplicate %s at index %%d", field.Name, strings.Join(r.FieldNames, "+")), Args: []string{"i"}})
 	}
 
-	var code strings.Builder
+	// A key other than the element or a plain field is built once per element
+	if data.Key != data.Seen {
+		data.Seen = "key"
+	}
+	return renderCode("unique", data)
+}
 
-	// Generate map initialization
-	code.WriteString(fmt.Sprintf("\t%s := make(map[string]bool, len(%s.%s))\n",
-		mapVar, receiverVar, field.Name))
+// uniqueKey returns the map key type and key expression for a value of type t read
+// by ref. Comparable types are used as they are; other types, such as slices and
+// maps, are keyed by their fmt %v formatting. Without type information the key
+// is the formatted value as well.
+func uniqueKey(ctx *CodeGenContext, t types.Type, ref string) (keyType, keyExpr string) {
+	if t == nil || !types.Comparable(t) {
+		return "string", fmt.Sprintf("fmt.Sprintf(\"%%v\", %s)", ref)
+	}
+	return qualifiedTypeString(ctx, t), ref
+}
+
+// uniqueSliceElem returns the element type of a slice field, or nil when type
+// information is unavailable
+func uniqueSliceElem(ctx *CodeGenContext, field *FieldInfo) types.Type {
+	if ctx.TypesInfo == nil {
+		return nil
+	}
+	t := ctx.TypesInfo.TypeOf(field.Type)
+	if t == nil {
+		return nil
+	}
+	slice, ok := t.Underlying().(*types.Slice)
+	if !ok {
+		return nil
+	}
+	return slice.Elem()
+}
 
-	// Generate loop
-	if r.FieldName == "" {
-		// Scalar slice - check each element directly
-		// For non-string types, we need to convert to string for the map key
-		needsConversion := typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString
+// uniqueElemField returns the type of fieldName on the element type of a struct
+// slice field ([]T or []*T), for use as the map key in unique=Field checks.
+// It is nil when type information is unavailable.
+func uniqueElemField(ctx *CodeGenContext, field *FieldInfo, fieldName string) (types.Type, error) {
+	elem := uniqueSliceElem(ctx, field)
+	if elem == nil {
+		return nil, nil
+	}
+	if ptr, ok := elem.Underlying().(*types.Pointer); ok {
+		elem = ptr.Elem()
+	}
 
-		if needsConversion {
-			code.WriteString(fmt.Sprintf(`	for i, item := range %s.%s {
-		key := fmt.Sprintf("%%v", item)
-		if %s[key] {
-			return fmt.Errorf("field %s has duplicate value at index %%d", i)
-		}
-		%s[key] = true
-	}`, receiverVar, field.Name, mapVar, field.Name, mapVar))
-		} else {
-			code.WriteString(fmt.Sprintf(`	for i, item := range %s.%s {
-		if %s[item] {
-			return fmt.Errorf("field %s has duplicate value at index %%d", i)
-		}
-		%s[item] = true
-	}`, receiverVar, field.Name, mapVar, field.Name, mapVar))
-		}
-	} else {
-		// Struct slice - check specific field
-		// Need to determine if slice of pointers or values
-		if typeInfo.Elem != nil && typeInfo.Elem.IsPointer {
-			// Slice of pointers
-			code.WriteString(fmt.Sprintf(`	for i, item := range %s.%s {
-		if item == nil {
-			continue
-		}
-		if %s[item.%s] {
-			return fmt.Errorf("field %s has duplicate %s at index %%d", i)
-		}
-		%s[item.%s] = true
-	}`, receiverVar, field.Name, mapVar, r.FieldName, field.Name, r.FieldName, mapVar, r.FieldName))
-		} else {
-			// Slice of values
-			code.WriteString(fmt.Sprintf(`	for i, item := range %s.%s {
-		if %s[item.%s] {
-			return fmt.Errorf("field %s has duplicate %s at index %%d", i)
-		}
-		%s[item.%s] = true
-	}`, receiverVar, field.Name, mapVar, r.FieldName, field.Name, r.FieldName, mapVar, r.FieldName))
-		}
+	var pkg *types.Package
+	if named, ok := elem.(*types.Named); ok {
+		pkg = named.Obj().Pkg()
+	}
+	obj, _, _ := types.LookupFieldOrMethod(elem, true, pkg, fieldName)
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() {
+		return nil, fmt.Errorf("unique on field %s: element type %s has no field %s", field.Name, elem, fieldName)
 	}
+	return v.Type(), nil
+}
 
-	return code.String(), nil
+// qualifiedTypeString renders t as Go source for the generated file, importing
+// the packages of named types declared outside the current package, and the
+// current package itself in subpackage mode
+func qualifiedTypeString(ctx *CodeGenContext, t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p.Path() == ctx.PkgPath {
+			return ctx.localQualifier()
+		}
+		return ctx.AddImport(p.Path(), p.Name())
+	})
 }
 
 // DiveRule validates nested structures
@@ -751,6 +831,24 @@ type DiveRule struct {
 	// ElementRules are validation rules to apply to each element
 	// These are the rules that come AFTER the dive tag
 	ElementRules []ValidationRule
+
+	// KeyRules are applied to each key of a map, from dive,keys,...,endkeys
+	KeyRules []ValidationRule `model:"keys"`
+
+	// WithContext calls ValidateContext(ctx) instead of Validate() on the target
+	WithContext bool
+
+	// Named calls Validate() on a field of a named slice or map type with rules of
+	// its own, instead of diving into its elements
+	Named bool
+
+	// depth is 1 for a dive nested in another dive's element rules (dive,dive), 2 for
+	// one nested in that, and so on. It names the loop variables.
+	depth int
+
+	// target is the struct of the current package the dive reaches, whose generated
+	// method it calls
+	target *StructInfo
 }
 
 func (r *DiveRule) Name() string { return "dive" }
@@ -761,7 +859,26 @@ func (r *DiveRule) Validate(fieldType TypeInfo) error {
 
 func (r *DiveRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+	ref := ctx.FieldRef(field)
+
+	if nested := r.nested(); nested != nil {
+		nested.depth = r.depth + 1
+	}
+
+	// url.Values and http.Header: validate each value under each key
+	expr := ctx.FieldExpr(field)
+	if expr.Elem.Kind == TypeMap && !r.Named && r.depth > 0 {
+		return "", fmt.Errorf("nested dive is only applicable to slices")
+	}
+	if expr.Elem.IsMultiValueMap() && !r.Named && r.nested() == nil {
+		return r.generateMultiValueMapValidation(ctx, field, expr)
+	}
+	if expr.Elem.Kind == TypeMap && !r.Named {
+		return r.generateMapValidation(ctx, field, expr)
+	}
+	if len(r.KeyRules) > 0 {
+		return "", fmt.Errorf("keys is only applicable to maps")
+	}
 
 	if typeInfo.IsSlice {
 		// Dive into slice elements
@@ -774,15 +891,15 @@ func (r *DiveRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, erro
 		// Check if element is a struct type (or pointer to struct)
 		isStructElem := false
 		if elemType.IsPointer && elemType.Elem != nil {
-			isStructElem = elemType.Elem.Kind == TypeStruct || elemType.Elem.Kind == TypeUnknown
+			isStructElem = (elemType.Elem.Kind == TypeStruct || elemType.Elem.Kind == TypeUnknown) && !elemType.Elem.IsValueType()
 		} else {
-			isStructElem = elemType.Kind == TypeStruct || elemType.Kind == TypeUnknown
+			isStructElem = (elemType.Kind == TypeStruct || elemType.Kind == TypeUnknown || elemType.Kind == TypeInterface) && !elemType.IsValueType()
 		}
 
 		// If we have element-specific validation rules AND element is primitive
 		if len(r.ElementRules) > 0 && !isStructElem {
 			// Generate validation for primitive slice elements with custom rules
-			return r.generateSliceElementValidation(ctx, field, elemType, receiverVar)
+			return r.generateSliceElementValidation(ctx, field, elemType, ref)
 		}
 
 		// Check if element type is from an external package
@@ -793,32 +910,19 @@ func (r *DiveRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, erro
 		// 2. Apply any element rules (like unique) that work on the struct level
 		if len(r.ElementRules) > 0 && isStructElem {
 			// Generate both Validate() calls and struct-level rules like unique
-			return r.generateStructSliceValidation(ctx, field, elemType, receiverVar, isExternalType)
+			return r.generateStructSliceValidation(ctx, field, elemType, ref, isExternalType)
 		}
 
 		// Skip generating Validate() calls for external types without validation tags
 		if isExternalType {
+			if err := r.checkExternalType(ctx, field, elemType); err != nil {
+				return "", err
+			}
 			return fmt.Sprintf("\t// Skipping dive validation for external type without validation tags"), nil
 		}
 
 		// No element rules - just call Validate() on struct elements
-		// Handle slice of pointers vs values
-		if elemType.IsPointer {
-			return fmt.Sprintf(`	for i := range %s.%s {
-		if %s.%s[i] == nil {
-			continue
-		}
-		if err := %s.%s[i].Validate(); err != nil {
-			return fmt.Errorf("field %s[%%d] validation failed: %%w", i, err)
-		}
-	}`, receiverVar, field.Name, receiverVar, field.Name, receiverVar, field.Name, field.Name), nil
-		}
-
-		return fmt.Sprintf(`	for i := range %s.%s {
-		if err := %s.%s[i].Validate(); err != nil {
-			return fmt.Errorf("field %s[%%d] validation failed: %%w", i, err)
-		}
-	}`, receiverVar, field.Name, receiverVar, field.Name, field.Name), nil
+		return r.elementValidateLoop(ctx, field, elemType, ref)
 	}
 
 	// Check if type is from an external package
@@ -826,69 +930,220 @@ func (r *DiveRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, erro
 
 	// Skip generating Validate() calls for external types
 	if isExternalType {
+		if err := r.checkExternalType(ctx, field, typeInfo); err != nil {
+			return "", err
+		}
 		return fmt.Sprintf("\t// Skipping dive validation for external type without validation tags"), nil
 	}
 
-	if typeInfo.IsPointer {
-		// Dive into pointer to struct
-		return fmt.Sprintf(`	if %s.%s != nil {
-		if err := %s.%s.Validate(); err != nil {
-			return fmt.Errorf("field %s validation failed: %%w", err)
-		}
-	}`, receiverVar, field.Name, receiverVar, field.Name, field.Name), nil
+	call, err := r.targetCall(ctx, field, ref, typeInfo.IsPointer)
+	if err != nil {
+		return "", err
 	}
-
-	// Dive into struct field
-	return fmt.Sprintf(`	if err := %s.%s.Validate(); err != nil {
-		return fmt.Errorf("field %s validation failed: %%w", err)
-	}`, receiverVar, field.Name, field.Name), nil
+	code, err := renderCheck(ctx, fmt.Sprintf("err := %s; err != nil", call), fmt.Sprintf("field %s validation failed: %%w", field.Name), "err")
+	if err != nil || !typeInfo.IsPointer {
+		return code, err
+	}
+	// Dive into pointer to struct
+	return guardNil(ref, code)
 }
 
-// isExternalType checks if a type is from an external package
-func (r *DiveRule) isExternalType(typeInfo TypeInfo) bool {
-	// Check if the type has a package path (indicating it's from another package)
-	if typeInfo.PkgPath != "" {
-		return true
+// validateCall returns the method call made on each dive target: the generated
+// method of a struct of the current package, or Validate
+func (r *DiveRule) validateCall(ctx *CodeGenContext) string {
+	method := "Validate"
+	if r.target != nil {
+		method = methodName(r.target, ctx.Options)
 	}
-
-	// For pointer types, check the underlying element type
-	if typeInfo.IsPointer && typeInfo.Elem != nil {
-		return r.isExternalType(*typeInfo.Elem)
+	if r.WithContext {
+		return method + "Context(ctx)"
 	}
-
-	return false
+	return method + "()"
 }
 
-// generateStructSliceValidation handles dive on slice of structs with additional element rules
-func (r *DiveRule) generateStructSliceValidation(ctx *CodeGenContext, field *FieldInfo, elemType TypeInfo, receiverVar string, isExternalType bool) (string, error) {
-	var code strings.Builder
+// elementRequired reports whether the element rules start with required, which
+// checks nil pointer elements itself
+func (r *DiveRule) elementRequired() bool {
+	if len(r.ElementRules) == 0 {
+		return false
+	}
+	_, ok := r.ElementRules[0].(*RequiredRule)
+	return ok
+}
 
-	// Only call Validate() on each element if it's not an external type
-	if !isExternalType {
-		if elemType.IsPointer {
-			code.WriteString(fmt.Sprintf(`	for i := range %s.%s {
-		if %s.%s[i] == nil {
-			continue
+// targetCall returns the call that validates a dive target: its Validate method, or a
+// generated function for an anonymous struct, a type parameter whose constraint lacks
+// Validate, or an interface. pointer reports whether ref is a pointer to the target.
+func (r *DiveRule) targetCall(ctx *CodeGenContext, field *FieldInfo, ref string, pointer bool) (string, error) {
+	target := diveTarget(ctx, field)
+	tp, isTypeParam := target.(*types.TypeParam)
+
+	var fn string
+	switch {
+	case field.Inline != nil:
+		var err error
+		if fn, err = inlineStructFunc(ctx, field); err != nil {
+			return "", err
 		}
-		if err := %s.%s[i].Validate(); err != nil {
-			return fmt.Errorf("field %s[%%d] validation failed: %%w", i, err)
+	case isTypeParam && !types.Implements(tp, validatableInterface):
+		// A constraint with a Validate method allows calling it directly
+		fn = typeParamValidateFunc(ctx)
+	case !isTypeParam && target != nil && types.IsInterface(target):
+		// The dynamic type is only known at run time
+		if pointer {
+			ref = "*" + ref
 		}
-	}`, receiverVar, field.Name, receiverVar, field.Name, receiverVar, field.Name, field.Name))
-		} else {
-			code.WriteString(fmt.Sprintf(`	for i := range %s.%s {
-		if err := %s.%s[i].Validate(); err != nil {
-			return fmt.Errorf("field %s[%%d] validation failed: %%w", i, err)
+		return fmt.Sprintf("%s(%s)", interfaceValidateFunc(ctx), ref), nil
+	case r.target != nil && ctx.Options.Subpackage != "":
+		// Structs of the current package are validated by generated functions
+		if !pointer {
+			ref = "&" + ref
 		}
-	}`, receiverVar, field.Name, receiverVar, field.Name, field.Name))
+		if r.WithContext {
+			return fmt.Sprintf("%sContext(ctx, %s)", validateFunc(r.target, ctx.Options), ref), nil
 		}
+		return fmt.Sprintf("%s(%s)", validateFunc(r.target, ctx.Options), ref), nil
+	default:
+		return ref + "." + r.validateCall(ctx), nil
+	}
+	if pointer {
+		return fmt.Sprintf("%s(%s)", fn, ref), nil
+	}
+	return fmt.Sprintf("%s(&%s)", fn, ref), nil
+}
+
+// diveTarget returns the type a dive on field reaches: the field's type, or the
+// element or value type of a slice or map, through pointers. It is nil without type
+// information.
+func diveTarget(ctx *CodeGenContext, field *FieldInfo) types.Type {
+	if ctx.TypesInfo == nil {
+		return nil
+	}
+	t := ctx.TypesInfo.TypeOf(field.Type)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if _, ok := t.(*types.TypeParam); !ok && t != nil {
+		switch u := t.Underlying().(type) {
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Map:
+			t = u.Elem()
+		}
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return t
+}
+
+// typeParamValidateFunc returns the helper that dives into a value of a type parameter
+// whose constraint has no Validate method: it calls Validate when the value, or a
+// pointer to it, implements interface{ Validate() error }, and skips nil pointers
+// and types without the method.
+func typeParamValidateFunc(ctx *CodeGenContext) string {
+	ctx.AddImport("reflect", "reflect")
+	return ctx.AddHelperFunc("validateTypeParam", `[T any](v *T) error {
+	if validatable, ok := any(v).(interface{ Validate() error }); ok {
+		return validatable.Validate()
+	}
+	if validatable, ok := any(*v).(interface{ Validate() error }); ok {
+		if rv := reflect.ValueOf(validatable); rv.Kind() != reflect.Pointer || !rv.IsNil() {
+			return validatable.Validate()
+		}
+	}
+	return nil
+}`)
+}
+
+// interfaceValidateFunc returns the helper that dives into an interface value: it
+// calls Validate when the dynamic type implements interface{ Validate() error }, and
+// skips nil interfaces, nil pointers and types without the method.
+func interfaceValidateFunc(ctx *CodeGenContext) string {
+	ctx.AddImport("reflect", "reflect")
+	return ctx.AddHelperFunc("validateInterface", `(v any) error {
+	validatable, ok := v.(interface{ Validate() error })
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return validatable.Validate()
+}`)
+}
+
+// isExternalType reports whether a type comes from another package and has no
+// Validate method, so no Validate() call can be generated for it. Types from the
+// current package are not external: houp generates their Validate methods, as it
+// does for structs of other packages with validate tags.
+func (r *DiveRule) isExternalType(typeInfo TypeInfo) bool {
+	if typeInfo.HasValidateMethod || (typeInfo.GoType != nil && hasValidateTags(typeInfo.GoType)) {
+		return false
+	}
+	// Interfaces are checked for a Validate method at run time
+	if typeInfo.GoType != nil && types.IsInterface(typeInfo.GoType) {
+		if _, ok := typeInfo.GoType.(*types.TypeParam); !ok {
+			return false
+		}
+	}
+
+	// Check if the type has a package path (indicating it's from another package)
+	if typeInfo.PkgPath != "" {
+		return true
+	}
+
+	// For pointer types, check the underlying element type
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		return r.isExternalType(*typeInfo.Elem)
+	}
+
+	return false
+}
+
+// checkExternalType fails a dive into a type of another package without a Validate
+// method when the cross-package mode is strict; by default the dive skips it
+func (r *DiveRule) checkExternalType(ctx *CodeGenContext, field *FieldInfo, typeInfo TypeInfo) error {
+	if ctx.Options == nil || ctx.Options.CrossPackageMode != "strict" {
+		return nil
+	}
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		typeInfo = *typeInfo.Elem
+	}
+	name := typeInfo.Name
+	if typeInfo.PkgPath != "" {
+		name = typeInfo.PkgPath + "." + name
+	}
+	return fmt.Errorf("dive on field %s reaches %s, which has no Validate method", field.Name, name)
+}
+
+// generateStructSliceValidation handles dive on slice of structs with additional element rules
+func (r *DiveRule) generateStructSliceValidation(ctx *CodeGenContext, field *FieldInfo, elemType TypeInfo, ref string, isExternalType bool) (string, error) {
+	var code strings.Builder
+
+	// Only call Validate() on each element if it's not an external type
+	if !isExternalType {
+		loop, err := r.elementValidateLoop(ctx, field, elemType, ref)
+		if err != nil {
+			return "", err
+		}
+		code.WriteString(loop)
 	} else {
+		if err := r.checkExternalType(ctx, field, elemType); err != nil {
+			return "", err
+		}
 		// Add a comment indicating we're skipping validation for external types
 		code.WriteString(fmt.Sprintf("\t// Skipping Validate() call for external type %s in field %s\n", elemType.Name, field.Name))
 	}
 
 	// Now apply struct-level rules (like unique)
 	for _, rule := range r.ElementRules {
-		ruleCode, err := rule.Generate(ctx, field)
+		// Inside a dive, the label keeps the verbs of the enclosing element
+		fail := &failureContext{Rule: rule, Value: ctx.FieldExpr(field).Ref, Label: field.Name}
+		if ctx.Failure != nil {
+			fail.LabelArgs = ctx.Failure.LabelArgs
+		}
+		ruleCode, err := generateRule(ctx, rule, field, fail)
 		if err != nil {
 			return "", fmt.Errorf("failed to generate dive element rule %s: %w", rule.Name(), err)
 		}
@@ -902,501 +1157,1933 @@ func (r *DiveRule) generateStructSliceValidation(ctx *CodeGenContext, field *Fie
 	return code.String(), nil
 }
 
+// elementValidateLoop generates the loop that calls Validate() on every struct
+// element of a slice, skipping nil pointers. ref is the slice.
+func (r *DiveRule) elementValidateLoop(ctx *CodeGenContext, field *FieldInfo, elemType TypeInfo, ref string) (string, error) {
+	index := r.indexVar()
+	elemRef := fmt.Sprintf("%s[%s]", ref, index)
+	call, err := r.targetCall(ctx, field, elemRef, elemType.IsPointer)
+	if err != nil {
+		return "", err
+	}
+	return renderCode("elementValidate", elementValidateData{
+		Index:   index,
+		Ref:     ref,
+		ElemRef: elemRef,
+		Call:    call,
+		SkipNil: elemType.IsPointer,
+		Failure: fieldError(ctx, checkData{Message: "field " + field.Name + "[%d] validation failed: %w", Args: []string{index, "err"}}),
+	})
+}
+
+// nested returns the dive among the element rules, from dive,dive, or nil
+func (r *DiveRule) nested() *DiveRule {
+	for _, rule := range r.ElementRules {
+		if d, ok := rule.(*DiveRule); ok {
+			return d
+		}
+	}
+	return nil
+}
+
+// indexVar returns the name of the index variable of the dive's slice loop: i, then
+// j, k, ... for nested dives
+func (r *DiveRule) indexVar() string {
+	return string(rune('i' + r.depth))
+}
+
+// elemVar returns the name of the element variable of the dive's loops: elem, then
+// elem2, elem3, ... for nested dives
+func (r *DiveRule) elemVar() string {
+	if r.depth == 0 {
+		return "elem"
+	}
+	return fmt.Sprintf("elem%d", r.depth+1)
+}
+
 // generateSliceElementValidation generates validation code for slice elements with custom rules
-func (r *DiveRule) generateSliceElementValidation(ctx *CodeGenContext, field *FieldInfo, elemType TypeInfo, receiverVar string) (string, error) {
-	// Create a temporary FieldInfo for the element
-	// This allows us to reuse existing rule generation logic
+func (r *DiveRule) generateSliceElementValidation(ctx *CodeGenContext, field *FieldInfo, elemType TypeInfo, ref string) (string, error) {
+	index := r.indexVar()
+	validationLines, err := r.elementRuleLines(ctx, elemType.UnderlyingGo, field.Name+"[%d]", index)
+	if err != nil {
+		return "", err
+	}
+
+	// If no validation code was generated, don't create an empty loop
+	if len(validationLines) == 0 {
+		return "", nil
+	}
+
+	return renderCode("sliceElements", sliceElementsData{
+		Index: index,
+		Elem:  r.elemVar(),
+		Ref:   ref,
+		// Nil pointer elements have nothing to validate, unless required rejects them
+		SkipNil: elemType.IsPointer && !r.elementRequired(),
+		Lines:   validationLines,
+	})
+}
+
+// generateMultiValueMapValidation applies the element rules to every value of a
+// url.Values or http.Header style map; errors name the key and the value's index
+func (r *DiveRule) generateMultiValueMapValidation(ctx *CodeGenContext, field *FieldInfo, expr FieldExpr) (string, error) {
+	keyLines, err := r.keyRuleLines(ctx, ast.NewIdent("string"), field.Name+" key %q")
+	if err != nil {
+		return "", err
+	}
+	validationLines, err := r.elementRuleLines(ctx, ast.NewIdent("string"), field.Name+"[%q][%d]", "key, i")
+	if err != nil {
+		return "", err
+	}
+	if len(keyLines) == 0 && len(validationLines) == 0 {
+		return "", nil
+	}
+
+	code, err := renderCode("multiValueMap", multiValueMapData{
+		Ref:      expr.Value(),
+		KeyLines: keyLines,
+		Values:   validationLines,
+	})
+	if err != nil || !expr.Pointer {
+		return code, err
+	}
+	return guardNil(expr.Ref, code)
+}
+
+// generateMapValidation dives into the values of a map: the element rules are
+// applied to every value, then struct values get their Validate() called. Errors
+// name the value's key.
+func (r *DiveRule) generateMapValidation(ctx *CodeGenContext, field *FieldInfo, expr FieldExpr) (string, error) {
+	valueExpr, valueType, err := mapValueType(expr.Elem, ctx.TypesInfo, ctx.PkgPath)
+	if err != nil {
+		return "", err
+	}
+	keyVerb := "%v"
+	if expr.Elem.GoType != nil {
+		m := expr.Elem.GoType.Underlying().(*types.Map)
+		if key, ok := m.Key().Underlying().(*types.Basic); ok && key.Kind() == types.String {
+			keyVerb = "%q"
+		}
+	}
+	label := field.Name + "[" + keyVerb + "]"
+
+	structValue := valueType.Kind == TypeStruct || valueType.Kind == TypeUnknown || valueType.Kind == TypeInterface
+	if valueType.IsPointer && valueType.Elem != nil {
+		structValue = valueType.Elem.Kind == TypeStruct || valueType.Elem.Kind == TypeUnknown
+	}
+	external := structValue && r.isExternalType(valueType)
+	if external {
+		if err := r.checkExternalType(ctx, field, valueType); err != nil {
+			return "", err
+		}
+	}
+
+	keyExpr, err := mapKeyExpr(expr.Elem)
+	if err != nil {
+		return "", err
+	}
+	keyLines, err := r.keyRuleLines(ctx, keyExpr, field.Name+" key "+keyVerb)
+	if err != nil {
+		return "", err
+	}
+	ruleLines, err := r.elementRuleLines(ctx, valueExpr, label, "key")
+	if err != nil {
+		return "", err
+	}
+	if external && len(ruleLines) == 0 && len(keyLines) == 0 {
+		return "\t// Skipping dive validation for external type without validation tags", nil
+	}
+
+	// Key rules come first, then element rules such as required, then the value's
+	// own Validate()
+	lines := append(keyLines, ruleLines...)
+	if structValue && !external {
+		validate, err := r.targetCall(ctx, field, "elem", valueType.IsPointer)
+		if err != nil {
+			return "", err
+		}
+		call, err := renderCheck(ctx, fmt.Sprintf("err := %s; err != nil", validate), fmt.Sprintf("field %s validation failed: %%w", label), "key", "err")
+		if err != nil {
+			return "", err
+		}
+		// Nil values have nothing to validate, unless required already rejected them
+		if valueType.IsPointer && !r.elementRequired() {
+			if call, err = guardNil("elem", call); err != nil {
+				return "", err
+			}
+		}
+		lines = append(lines, codeLines(call)...)
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+
+	code, err := renderCode("mapValues", mapValuesData{
+		Ref:   expr.Value(),
+		Elem:  len(ruleLines) > 0 || (structValue && !external),
+		Lines: lines,
+	})
+	if err != nil || !expr.Pointer {
+		return code, err
+	}
+	return guardNil(expr.Ref, code)
+}
+
+// mapKeyExpr returns an expression for the key type of a map that the key rules
+// can resolve
+func mapKeyExpr(mapType TypeInfo) (ast.Expr, error) {
+	if m, ok := mapType.UnderlyingGo.(*ast.MapType); ok {
+		return m.Key, nil
+	}
+	if mapType.GoType != nil {
+		if m, ok := mapType.GoType.Underlying().(*types.Map); ok {
+			if basic, ok := m.Key().(*types.Basic); ok {
+				return ast.NewIdent(basic.Name()), nil
+			}
+			return nil, fmt.Errorf("cannot validate keys of %s: declare the field as a map type literal", mapType.GoType)
+		}
+	}
+	return nil, fmt.Errorf("cannot validate map keys: key type unknown")
+}
+
+// mapValueType returns the value type of a map along with an expression for it that
+// the element rules can resolve. Named map types such as type Labels map[string]string
+// only show their value type to the type checker: basic values are described by
+// their type name, other values by the type checker alone.
+func mapValueType(mapType TypeInfo, typesInfo *types.Info, pkgPath string) (ast.Expr, TypeInfo, error) {
+	if m, ok := mapType.UnderlyingGo.(*ast.MapType); ok {
+		return m.Value, ResolveTypeInfo(m.Value, typesInfo), nil
+	}
+	if mapType.GoType == nil {
+		return nil, TypeInfo{}, fmt.Errorf("cannot dive into map: value type unknown")
+	}
+	m, ok := mapType.GoType.Underlying().(*types.Map)
+	if !ok {
+		return nil, TypeInfo{}, fmt.Errorf("cannot dive into map: value type unknown")
+	}
+
+	if basic, ok := m.Elem().(*types.Basic); ok {
+		ident := ast.NewIdent(basic.Name())
+		return ident, ResolveTypeInfo(ident, nil), nil
+	}
+
+	valueType := goTypeInfo(m.Elem(), pkgPath)
+	if valueType.Kind != TypeStruct && !(valueType.IsPointer && valueType.Elem.Kind == TypeStruct) {
+		return nil, TypeInfo{}, fmt.Errorf("cannot dive into values of %s: declare the field as a map type literal", mapType.GoType)
+	}
+	return ast.NewIdent(types.TypeString(m.Elem(), nil)), valueType, nil
+}
+
+// goTypeInfo describes a struct or pointer-to-struct type known only to the type
+// checker; PkgPath is set for types declared outside pkgPath
+func goTypeInfo(t types.Type, pkgPath string) TypeInfo {
+	info := TypeInfo{GoType: t}
+	info.HasValidateMethod = info.Implements(validatableInterface)
+	if ptr, ok := t.(*types.Pointer); ok {
+		elem := goTypeInfo(ptr.Elem(), pkgPath)
+		info.Kind = TypePointer
+		info.IsPointer = true
+		info.Elem = &elem
+		return info
+	}
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		info.Kind = TypeStruct
+		info.IsStruct = true
+	}
+	if named, ok := t.(*types.Named); ok {
+		info.Name = named.Obj().Name()
+		if pkg := named.Obj().Pkg(); pkg != nil && pkg.Path() != pkgPath {
+			info.PkgPath = pkg.Path()
+			info.PkgName = pkg.Name()
+		}
+	}
+	return info
+}
+
+// indentLines indents each line by one tab
+func indentLines(lines []string) []string {
+	indented := make([]string, len(lines))
+	for i, line := range lines {
+		indented[i] = "\t" + line
+	}
+	return indented
+}
+
+// elementRuleLines generates the element rules for a loop variable named elem of the
+// given type. Error messages name the element as label, e.g. "Tags[%d]", and args
+// supplies the values of label's verbs.
+func (r *DiveRule) elementRuleLines(ctx *CodeGenContext, elemTypeExpr ast.Expr, label, args string) ([]string, error) {
+	return loopVarRuleLines(ctx, r.ElementRules, r.elemVar(), elemTypeExpr, label, args)
+}
+
+// keyRuleLines generates the key rules for a map loop variable named key. Error
+// messages name the key as label, e.g. "Labels key %q".
+func (r *DiveRule) keyRuleLines(ctx *CodeGenContext, keyTypeExpr ast.Expr, label string) ([]string, error) {
+	lines, err := loopVarRuleLines(ctx, r.KeyRules, "key", keyTypeExpr, label, "key")
+	if err != nil {
+		return nil, fmt.Errorf("map key rules: %w", err)
+	}
+	return lines, nil
+}
+
+// loopVarRuleLines generates rules for a loop variable of the given name and type.
+// The rules see the element as a field that reads the loop variable and is named
+// label, so their messages name the element, e.g. "field Tags[%d] ...".
+func loopVarRuleLines(ctx *CodeGenContext, rules []ValidationRule, varName string, typeExpr ast.Expr, label, args string) ([]string, error) {
 	elemField := &FieldInfo{
-		Name:  "elem",
-		Type:  elemType.UnderlyingGo,
-		Rules: r.ElementRules,
+		Name:  label,
+		Ref:   varName,
+		Type:  typeExpr,
+		Rules: rules,
 	}
 
-	// Generate validation code for all element rules first to see if we have any valid code
+	// The values of the label's verbs follow those of the enclosing dive's label
+	var labelArgs []string
+	if ctx.Failure != nil {
+		labelArgs = append(labelArgs, ctx.Failure.LabelArgs...)
+	}
+	labelArgs = append(labelArgs, args)
+
 	var validationLines []string
-	for _, rule := range r.ElementRules {
+	for _, rule := range rules {
 		// Generate the rule code
-		ruleCode, err := rule.Generate(ctx, elemField)
+		ruleCode, err := generateRule(ctx, rule, elemField, &failureContext{Rule: rule, Value: varName, Label: label, LabelArgs: labelArgs})
 		if err != nil {
-			return "", fmt.Errorf("failed to generate dive element rule %s: %w", rule.Name(), err)
+			return nil, fmt.Errorf("failed to generate dive element rule %s: %w", rule.Name(), err)
 		}
 
 		if ruleCode != "" {
-			// Fix up the generated code to work in the loop context
-			// 1. Replace receiver.elem with just elem (the loop variable)
-			ruleCode = strings.ReplaceAll(ruleCode, receiverVar+".elem", "elem")
-
-			// 2. Update error messages to include array index
-			ruleCode = strings.ReplaceAll(ruleCode, `"field elem`, fmt.Sprintf(`"field %s[%%d]`, field.Name))
-
-			// 3. Add index parameter to fmt.Errorf calls
-			// Only replace the closing ) in fmt.Errorf lines
-			lines := strings.Split(strings.TrimSpace(ruleCode), "\n")
-			var fixedLines []string
-			for _, line := range lines {
-				if strings.Contains(line, "fmt.Errorf") && !strings.Contains(line, ", i)") {
-					// Add ", i" before the last ")"
-					lastParen := strings.LastIndex(line, ")")
-					if lastParen > 0 {
-						line = line[:lastParen] + ", i" + line[lastParen:]
-					}
-				}
-				fixedLines = append(fixedLines, line)
-			}
-			validationLines = append(validationLines, fixedLines...)
+			validationLines = append(validationLines, strings.Split(strings.TrimSpace(ruleCode), "\n")...)
 		}
 	}
+	return validationLines, nil
+}
+
+// CustomRule calls a custom validation function, func(T) error or
+// func(context.Context, T) error
+type CustomRule struct {
+	ImportPath  string
+	FuncName    string
+	WithContext bool // the function takes a context.Context first
+}
+
+func (r *CustomRule) Name() string { return "custom" }
+
+func (r *CustomRule) Validate(fieldType TypeInfo) error {
+	return nil
+}
+
+func (r *CustomRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	ref := ctx.FieldRef(field)
+
+	// Add import
+	parts := strings.Split(r.ImportPath, "/")
+	pkgName := parts[len(parts)-1]
+	alias := ctx.AddImport(r.ImportPath, pkgName)
+
+	ctxArg := ""
+	if r.WithContext {
+		ctxArg = "ctx, "
+	}
+
+	return renderCheck(ctx, fmt.Sprintf("err := %s.%s(%s%s); err != nil", alias, r.FuncName, ctxArg, ref),
+		fmt.Sprintf("field %s custom validation failed: %%w", field.Name), "err")
+}
+
+// UUIDRule validates that a string field is a valid UUID.
+// By default versions 1-5 are accepted; Version restricts the field to a single
+// version and AnyVersion accepts any version nibble as long as the variant is RFC 4122.
+type UUIDRule struct {
+	Version    int  // 3, 4 or 5; 0 accepts versions 1-5
+	AnyVersion bool // uuid_rfc4122: any version, RFC 4122 variant
+	AllowNil   bool // also accept 00000000-0000-0000-0000-000000000000
+}
+
+func (r *UUIDRule) Name() string {
+	switch {
+	case r.AnyVersion:
+		return "uuid_rfc4122"
+	case r.Version != 0:
+		return fmt.Sprintf("uuid%d", r.Version)
+	}
+	return "uuid"
+}
+
+func (r *UUIDRule) Validate(fieldType TypeInfo) error {
+	if fieldType.IsUUID() || (fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.IsUUID()) {
+		return nil
+	}
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *UUIDRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	if expr := ctx.FieldExpr(field); expr.Elem.IsUUID() {
+		return r.generateUUIDType(ctx, field, expr)
+	}
+
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	// Add regexp package import
+	ctx.AddImport("regexp", "regexp")
+
+	// The version nibble opens the third group, the variant nibble the fourth
+	versionGroup := `[1-5][0-9a-fA-F]{3}`
+	description := "UUID"
+	switch {
+	case r.AnyVersion:
+		versionGroup = `[0-9a-fA-F]{4}`
+		description = "RFC 4122 UUID"
+	case r.Version != 0:
+		versionGroup = fmt.Sprintf(`%d[0-9a-fA-F]{3}`, r.Version)
+		description = fmt.Sprintf("version %d UUID", r.Version)
+	}
+	uuidPattern := `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-` + versionGroup + `-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}`
+	if r.AllowNil {
+		uuidPattern = `(?:` + uuidPattern + `|00000000-0000-0000-0000-000000000000)`
+		description += " or the nil UUID"
+	}
+
+	// Get or create package-level regexp variable
+	regexpVar := ctx.AddRegexpVar(`^`+uuidPattern+`$`, "uuidRegexp")
+
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid %s", field.Name, description))
+}
+
+// generateUUIDType checks a uuid.UUID field, which is always well-formed, for the
+// version and variant the rule accepts, as the string form would be checked
+func (r *UUIDRule) generateUUIDType(ctx *CodeGenContext, field *FieldInfo, expr FieldExpr) (string, error) {
+	alias := ctx.AddImport(uuidPkgPath, "uuid")
+	cond := fmt.Sprintf("%s.Variant() != %s.RFC4122", expr.Ref, alias)
+	description := "UUID"
+	switch {
+	case r.AnyVersion:
+		description = "RFC 4122 UUID"
+	case r.Version != 0:
+		cond = fmt.Sprintf("%s.Version() != %d || %s", expr.Ref, r.Version, cond)
+		description = fmt.Sprintf("version %d UUID", r.Version)
+	default:
+		cond = fmt.Sprintf("%s.Version() < 1 || %s.Version() > 5 || %s", expr.Ref, expr.Ref, cond)
+	}
+	if r.AllowNil {
+		cond = fmt.Sprintf("%s != %s.Nil && (%s)", expr.Value(), alias, cond)
+		description += " or the nil UUID"
+	}
+
+	return renderCheck(ctx, cond, fmt.Sprintf("field %s must be a valid %s", field.Name, description))
+}
+
+// ulidPattern matches the 26 Crockford base32 characters of a ULID
+const ulidPattern = `^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`
+
+// ULIDRule validates that a string field is a ULID: 26 Crockford base32 characters
+// (no I, L, O or U, case-insensitive) whose first character is 0-7 so it fits 128 bits
+type ULIDRule struct{}
+
+func (r *ULIDRule) Name() string { return "ulid" }
+
+func (r *ULIDRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ULIDRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	// Add regexp package import
+	ctx.AddImport("regexp", "regexp")
+
+	regexpVar := ctx.AddRegexpVar(ulidPattern, "ulidRegexp")
+
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid ULID", field.Name))
+}
+
+// ISO4217Rule validates that a string field is a valid ISO 4217 currency code
+type ISO4217Rule struct{}
+
+func (r *ISO4217Rule) Name() string { return "iso4217" }
+
+func (r *ISO4217Rule) Validate(fieldType TypeInfo) error {
+	// Handle pointer to string
+	if fieldType.IsPointer && fieldType.Elem != nil {
+		fieldType = *fieldType.Elem
+	}
+
+	if fieldType.Kind != TypeString && !fieldType.IsBytes() {
+		return fmt.Errorf("iso4217 validation only applicable to string types")
+	}
+	return nil
+}
+
+func (r *ISO4217Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso4217Codes", isodata.Currencies, "ISO 4217 currency code")
+}
+
+// emailPattern is a basic email address pattern - intentionally broad
+const emailPattern = `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
+
+// EmailRule validates that a string field is a valid email address
+type EmailRule struct{}
+
+func (r *EmailRule) Name() string { return "email" }
+
+func (r *EmailRule) Validate(fieldType TypeInfo) error {
+	// Handle pointer to string
+	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
+		return nil
+	}
+
+	// Handle slice of strings
+	if fieldType.IsSlice && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
+		return nil
+	}
+
+	// Handle slice of pointer to strings
+	if fieldType.IsSlice && fieldType.Elem != nil && fieldType.Elem.IsPointer && fieldType.Elem.Elem != nil && fieldType.Elem.Elem.Kind == TypeString {
+		return nil
+	}
+
+	if fieldType.Kind != TypeString {
+		return fmt.Errorf("email validation only applicable to string types")
+	}
+	return nil
+}
+
+func (r *EmailRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+
+	ref := ctx.FieldRef(field)
+
+	// Add regexp package import
+	ctx.AddImport("regexp", "regexp")
+
+	// Get or create package-level regexp variable
+	regexpVar := ctx.AddRegexpVar(emailPattern, "emailRegexp")
+
+	// Handle slice of strings
+	if typeInfo.IsSlice {
+		if typeInfo.Elem == nil {
+			return "", fmt.Errorf("cannot validate slice: element type unknown")
+		}
+
+		elemType := *typeInfo.Elem
+
+		// Handle slice of pointer to strings
+		if elemType.IsPointer {
+			return emailSliceCheck(ctx, regexpVar, ref, "*email", field.Name, true)
+		}
+
+		// Handle slice of strings
+		if elemType.Kind == TypeString {
+			return emailSliceCheck(ctx, regexpVar, ref, "email", field.Name, false)
+		}
+
+		return "", fmt.Errorf("email validation only applicable to string types")
+	}
+
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid email address", field.Name))
+}
+
+// emailSliceCheck checks every element of the slice ref, read as value, against the
+// email regexp, skipping nil elements if skipNil
+func emailSliceCheck(ctx *CodeGenContext, regexpVar, ref, value, name string, skipNil bool) (string, error) {
+	check, err := renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, value), fmt.Sprintf("field %s[%%d] must be a valid email address", name), "i")
+	if err != nil {
+		return "", err
+	}
+	return renderCode("sliceElements", sliceElementsData{
+		Index:   "i",
+		Elem:    "email",
+		Ref:     ref,
+		SkipNil: skipNil,
+		Lines:   codeLines(check),
+	})
+}
+
+// ISO3166_1_Alpha2Rule validates that a string field is a valid ISO 3166-1 alpha-2 country code
+type ISO3166_1_Alpha2Rule struct{}
+
+func (r *ISO3166_1_Alpha2Rule) Name() string { return "iso3166_1_alpha2" }
+
+func (r *ISO3166_1_Alpha2Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISO3166_1_Alpha2Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	codes := iso3166Codes(func(c isodata.Country) string { return c.Alpha2 })
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso3166Alpha2Codes", codes, "ISO 3166-1 alpha-2 country code")
+}
+
+// ISO3166_1_Alpha3Rule validates that a string field is a valid ISO 3166-1 alpha-3 country code
+type ISO3166_1_Alpha3Rule struct{}
+
+func (r *ISO3166_1_Alpha3Rule) Name() string { return "iso3166_1_alpha3" }
+
+func (r *ISO3166_1_Alpha3Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISO3166_1_Alpha3Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	codes := iso3166Codes(func(c isodata.Country) string { return c.Alpha3 })
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso3166Alpha3Codes", codes, "ISO 3166-1 alpha-3 country code")
+}
+
+// ISO3166_1_NumericRule validates that a string field is a valid ISO 3166-1 numeric country code
+// (three digits, e.g. "840")
+type ISO3166_1_NumericRule struct{}
+
+func (r *ISO3166_1_NumericRule) Name() string { return "iso3166_1_numeric" }
+
+func (r *ISO3166_1_NumericRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISO3166_1_NumericRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	codes := iso3166Codes(func(c isodata.Country) string { return c.Numeric })
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso3166NumericCodes", codes, "ISO 3166-1 numeric country code")
+}
+
+// ISO639_1Rule validates that a string field is a valid ISO 639-1 two-letter language code
+type ISO639_1Rule struct{}
+
+func (r *ISO639_1Rule) Name() string { return "iso639_1" }
+
+func (r *ISO639_1Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISO639_1Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	codes := iso639Codes(func(l isodata.Language) []string { return []string{l.Alpha2} })
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso6391Codes", codes, "ISO 639-1 language code")
+}
+
+// ISO639_2Rule validates that a string field is a valid ISO 639-2 three-letter language code.
+// Both bibliographic ("ger") and terminology ("deu") codes are accepted.
+type ISO639_2Rule struct{}
+
+func (r *ISO639_2Rule) Name() string { return "iso639_2" }
+
+func (r *ISO639_2Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISO639_2Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	codes := iso639Codes(func(l isodata.Language) []string { return []string{l.Alpha3B, l.Alpha3T} })
+	return generateCodeSetCheck(ctx, field, r.Name(), "iso6392Codes", codes, "ISO 639-2 language code")
+}
+
+// generateCodeSetCheck emits a membership check of a string field in a set of
+// allowed codes. The set is a package-level map named table, built once and shared
+// by every field and struct of the generated file that checks it.
+func generateCodeSetCheck(ctx *CodeGenContext, field *FieldInfo, ruleName, table string, codes []string, description string) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, ruleName)
+	if err != nil {
+		return "", err
+	}
+
+	tableVar := ctx.AddHelperVar(table, " = map[string]struct{}{\n"+formatCodeSetEntries(codes)+"\n}")
+
+	return renderCheck(ctx, fmt.Sprintf("_, ok := %s[%s]; !ok", tableVar, fieldRef), fmt.Sprintf("field %s must be a valid %s", field.Name, description))
+}
+
+// DateTimeRule validates that a string field matches a Go time format.
+// With several formats (datetime=layout1|layout2) any one of them may match.
+// With Lang, month and weekday names of that language are turned into English
+// before parsing, so "2 janvier 2024" matches "2 January 2006" with lang=fr.
+type DateTimeRule struct {
+	Formats []string
+	Lang    string
+}
+
+func (r *DateTimeRule) Name() string { return "datetime" }
+
+func (r *DateTimeRule) Validate(fieldType TypeInfo) error {
+	// Handle pointer to string or custom string type
+	if fieldType.IsPointer && fieldType.Elem != nil {
+		// Check if element is string or string-based
+		if fieldType.Elem.Kind == TypeString || fieldType.Elem.Kind == TypeUnknown {
+			return nil
+		}
+		return fmt.Errorf("datetime validation only applicable to string types")
+	}
+
+	// Handle direct string or custom string types (which have Kind == TypeString after resolution)
+	if fieldType.Kind != TypeString && fieldType.Kind != TypeUnknown {
+		return fmt.Errorf("datetime validation only applicable to string types")
+	}
+	return nil
+}
+
+func (r *DateTimeRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+
+	// Check if this is a valid type for datetime validation
+	// Valid types: string, custom string types (TypeUnknown with string underlying), pointer to either
+	isValidType := typeInfo.Kind == TypeString || typeInfo.Kind == TypeUnknown
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		isValidType = typeInfo.Elem.Kind == TypeString || typeInfo.Elem.Kind == TypeUnknown
+	}
+
+	if !isValidType {
+		return "", fmt.Errorf("datetime validation only applicable to string types")
+	}
+
+	// Add time package import
+	ctx.AddImport("time", "time")
+
+	// Custom string types need an explicit conversion
+	expr := ctx.FieldExpr(field)
+	fieldRef := expr.Value()
+	if expr.Elem.Name != "" && expr.Elem.Name != "string" {
+		fieldRef = fmt.Sprintf("string(%s)", fieldRef)
+	}
+
+	if len(r.Formats) == 1 {
+		if r.Lang != "" {
+			fieldRef = dateNamesCall(ctx, r.Lang, strconv.Quote(r.Formats[0]), fieldRef)
+		}
+		return renderCheck(ctx, fmt.Sprintf("_, err := time.Parse(\"%s\", %s); err != nil", r.Formats[0], fieldRef),
+			fmt.Sprintf("field %s must be a valid datetime in format %s: %%w", field.Name, r.Formats[0]), "err")
+	}
+
+	// Try each layout in tag order and stop at the first match. Names are turned
+	// into English for the layout variable of the anyLayout loop.
+	if r.Lang != "" {
+		fieldRef = dateNamesCall(ctx, r.Lang, "layout", fieldRef)
+	}
+	matchedVar := ctx.LocalVarName(field, r.Name(), "datetimeMatched")
+	layouts := make([]string, len(r.Formats))
+	for i, format := range r.Formats {
+		layouts[i] = strconv.Quote(format)
+	}
+
+	return renderCode("anyLayout", anyLayoutData{
+		Matched: matchedVar,
+		Layouts: strings.Join(layouts, ", "),
+		Value:   fieldRef,
+		Check: fieldError(ctx, checkData{
+			Cond:    "!" + matchedVar,
+			Message: fmt.Sprintf("field %s must be a valid datetime in one of the formats %s", field.Name, strings.Join(r.Formats, " | ")),
+		}),
+	})
+}
+
+// bcp47Pattern matches well-formed RFC 5646 (BCP 47) language tags: a langtag,
+// a private use tag, or one of the irregular grandfathered tags. Subtags are not
+// checked against the IANA registry, so generated code stays dependency-free.
+const bcp47Pattern = `^(?i:` +
+	`(?:[a-z]{2,3}(?:-[a-z]{3}){0,3}|[a-z]{4,8})` + // language, extlang
+	`(?:-[a-z]{4})?` + // script
+	`(?:-(?:[a-z]{2}|[0-9]{3}))?` + // region
+	`(?:-(?:[a-z0-9]{5,8}|[0-9][a-z0-9]{3}))*` + // variants
+	`(?:-[0-9a-wyz](?:-[a-z0-9]{2,8})+)*` + // extensions
+	`(?:-x(?:-[a-z0-9]{1,8})+)?` + // private use suffix
+	`|x(?:-[a-z0-9]{1,8})+` + // private use tag
+	`|en-GB-oed|i-(?:ami|bnn|default|enochian|hak|klingon|lux|mingo|navajo|pwn|tao|tay|tsu)|sgn-(?:BE-FR|BE-NL|CH-DE)` +
+	`)$`
+
+// BCP47Rule validates that a string field is a well-formed BCP 47 language tag such as "en-US"
+type BCP47Rule struct{}
+
+func (r *BCP47Rule) Name() string { return "bcp47" }
+
+func (r *BCP47Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *BCP47Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("regexp", "regexp")
+	regexpVar := ctx.AddRegexpVar(bcp47Pattern, "bcp47Regexp")
+
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid BCP 47 language tag", field.Name))
+}
+
+// semverPattern is the Semantic Versioning 2.0.0 grammar from semver.org:
+// MAJOR.MINOR.PATCH with optional pre-release and build metadata, no "v" prefix
+const semverPattern = `^(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)` +
+	`(?:-(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?` +
+	`(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?$`
+
+// SemverRule validates that a string field is a semantic version such as "1.4.0-rc.1+build.5"
+type SemverRule struct{}
+
+func (r *SemverRule) Name() string { return "semver" }
+
+func (r *SemverRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *SemverRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("regexp", "regexp")
+	regexpVar := ctx.AddRegexpVar(semverPattern, "semverRegexp")
+
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid semantic version", field.Name))
+}
+
+// layoutReferenceTime is formatted and parsed back to check datetime layouts.
+// Every component differs from the reference time in the layout itself so
+// no element can be mistaken for a literal.
+var layoutReferenceTime = time.Date(2009, time.November, 17, 20, 34, 58, 651387237, time.FixedZone("EET", 2*60*60))
+
+// validateTimeLayout rejects datetime layouts that would fail for every input
+// or that contain digits which are not layout elements (e.g. "2006-13-99").
+// The layout is round-tripped through Format and Parse to catch the former.
+func validateTimeLayout(layout string) error {
+	formatted := layoutReferenceTime.Format(layout)
+	if formatted == layout {
+		return fmt.Errorf("datetime layout %q contains no time elements", layout)
+	}
+	if _, err := time.Parse(layout, formatted); err != nil {
+		return fmt.Errorf("datetime layout %q cannot parse its own output: %w", layout, err)
+	}
+
+	for i := 0; i < len(layout); {
+		if n := layoutElementLen(layout[i:]); n > 0 {
+			i += n
+			continue
+		}
+		if layout[i] >= '0' && layout[i] <= '9' {
+			return fmt.Errorf("datetime layout %q has digit %q at position %d that is not part of a layout element (see the time package constants)", layout, layout[i], i)
+		}
+		i++
+	}
+	return nil
+}
+
+// layoutTimeElements lists the time package layout elements that contain digits,
+// longest first so that prefixes are matched the way time.Parse matches them
+var layoutTimeElements = []string{
+	"Z07:00:00", "-07:00:00", "Z070000", "-070000",
+	"Z07:00", "-07:00", "Z0700", "-0700", "__2", "2006",
+	"Z07", "-07", "002", "_2", "01", "02", "03", "04", "05", "06", "15",
+	"1", "2", "3", "4", "5",
+}
+
+// layoutElementLen returns the length of the digit-bearing layout element at the
+// start of s, or 0 if s does not start with one
+func layoutElementLen(s string) int {
+	// Fractional seconds: a separator followed by a run of 0s or 9s not followed by a digit
+	if len(s) > 1 && (s[0] == '.' || s[0] == ',') && (s[1] == '0' || s[1] == '9') {
+		j := 1
+		for j < len(s) && s[j] == s[1] {
+			j++
+		}
+		if j == len(s) || s[j] < '0' || s[j] > '9' {
+			return j
+		}
+	}
+
+	for _, elem := range layoutTimeElements {
+		if strings.HasPrefix(s, elem) {
+			return len(elem)
+		}
+	}
+	return 0
+}
+
+// DurationRule validates that a string field is accepted by time.ParseDuration, e.g. "30s" or "1h30m"
+type DurationRule struct{}
+
+func (r *DurationRule) Name() string { return "duration" }
+
+func (r *DurationRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *DurationRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("time", "time")
+
+	return renderCheck(ctx, fmt.Sprintf("_, err := time.ParseDuration(%s); err != nil", fieldRef),
+		fmt.Sprintf("field %s must be a valid duration: %%w", field.Name), "err")
+}
+
+// BooleanRule validates that a string field is accepted by strconv.ParseBool:
+// 1, t, T, TRUE, true, True, 0, f, F, FALSE, false or False
+type BooleanRule struct{}
+
+func (r *BooleanRule) Name() string { return "boolean" }
+
+func (r *BooleanRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *BooleanRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("strconv", "strconv")
+
+	return renderCheck(ctx, fmt.Sprintf("_, err := strconv.ParseBool(%s); err != nil", fieldRef),
+		fmt.Sprintf("field %s must be a valid boolean", field.Name))
+}
+
+// UnixTSRule validates that an integer field, or a string holding a base-10 integer, is a
+// Unix timestamp between From and To (inclusive, YYYY-MM-DD in UTC). The bounds default
+// to 2000-01-01 and 2100-01-01, which also catches milliseconds passed as seconds and
+// the other way round.
+type UnixTSRule struct {
+	Millis bool   // unixts=ms: milliseconds instead of seconds
+	From   string // lower bound date, "" for the default
+	To     string // upper bound date, "" for the default
+}
+
+// Default unixts range
+const (
+	unixTSDefaultFrom = "2000-01-01"
+	unixTSDefaultTo   = "2100-01-01"
+)
+
+func (r *UnixTSRule) Name() string { return "unixts" }
+
+func (r *UnixTSRule) Validate(fieldType TypeInfo) error {
+	if fieldType.IsPointer && fieldType.Elem != nil {
+		fieldType = *fieldType.Elem
+	}
+	if fieldType.Kind != TypeString && !fieldType.IsInteger() {
+		return fmt.Errorf("unixts validation only applicable to integer and string types")
+	}
+	return nil
+}
+
+// bounds returns the range as timestamps in the rule's unit
+func (r *UnixTSRule) bounds() (from, to int64, err error) {
+	fromDate, toDate := r.From, r.To
+	if fromDate == "" {
+		fromDate = unixTSDefaultFrom
+	}
+	if toDate == "" {
+		toDate = unixTSDefaultTo
+	}
+
+	fromTime, err := time.Parse("2006-01-02", fromDate)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unixts from option must be a date in YYYY-MM-DD format, got: %s", fromDate)
+	}
+	toTime, err := time.Parse("2006-01-02", toDate)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unixts to option must be a date in YYYY-MM-DD format, got: %s", toDate)
+	}
+	if fromTime.Unix() < 0 {
+		return 0, 0, fmt.Errorf("unixts from option must not be before 1970-01-01, got: %s", fromDate)
+	}
+	if !toTime.After(fromTime) {
+		return 0, 0, fmt.Errorf("unixts range is empty: %s is not before %s", fromDate, toDate)
+	}
+
+	if r.Millis {
+		return fromTime.UnixMilli(), toTime.UnixMilli(), nil
+	}
+	return fromTime.Unix(), toTime.Unix(), nil
+}
+
+func (r *UnixTSRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	from, to, err := r.bounds()
+	if err != nil {
+		return "", err
+	}
+
+	unit := "seconds"
+	if r.Millis {
+		unit = "milliseconds"
+	}
+	fromDate, toDate := r.From, r.To
+	if fromDate == "" {
+		fromDate = unixTSDefaultFrom
+	}
+	if toDate == "" {
+		toDate = unixTSDefaultTo
+	}
+
+	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+	elemType := typeInfo
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		elemType = *typeInfo.Elem
+	}
+
+	var setup []string
+	var checks []checkData
+	var valueRef string
+	if elemType.Kind == TypeString {
+		fieldRef, err := stringFieldRef(ctx, field, r.Name())
+		if err != nil {
+			return "", err
+		}
+		ctx.AddImport("strconv", "strconv")
+
+		// Use unique names for the value and its error to avoid redeclaration and shadowing
+		valueRef = ctx.LocalVarName(field, r.Name(), field.identName()+"Unix")
+		setup = []string{fmt.Sprintf("%s, %sErr := strconv.ParseInt(%s, 10, 64)", valueRef, valueRef, fieldRef)}
+		checks = append(checks, checkData{
+			Cond:    valueRef + "Err != nil",
+			Message: fmt.Sprintf("field %s must be a Unix timestamp in %s", field.Name, unit),
+		})
+	} else if elemType.IsInteger() {
+		fieldRef := ctx.FieldExpr(field).Value()
+		// Converting to int64 keeps the bounds representable for every integer type;
+		// uint64 values above math.MaxInt64 turn negative and fail the lower bound
+		valueRef = fmt.Sprintf("int64(%s)", fieldRef)
+		if elemType.Kind == TypeInt64 && (elemType.Name == "" || elemType.Name == "int64") {
+			valueRef = fieldRef
+		}
+	} else {
+		return "", fmt.Errorf("unixts validation only applicable to integer and string types")
+	}
+
+	checks = append(checks, checkData{
+		Cond:    fmt.Sprintf("%s < %d || %s > %d", valueRef, from, valueRef, to),
+		Message: fmt.Sprintf("field %s must be a Unix timestamp in %s between %s and %s", field.Name, unit, fromDate, toDate),
+	})
+	return renderChecks(ctx, setup, checks...)
+}
+
+// TimezoneRule validates that a string field is an IANA time zone name such as "Europe/Kyiv".
+// Empty and "Local" are rejected because time.LoadLocation maps them to UTC and the host zone.
+type TimezoneRule struct{}
+
+func (r *TimezoneRule) Name() string { return "timezone" }
+
+func (r *TimezoneRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *TimezoneRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("time", "time")
+
+	message := fmt.Sprintf("field %s must be a valid IANA time zone", field.Name)
+	return renderChecks(ctx, nil,
+		checkData{Cond: fmt.Sprintf(`%s == "" || %s == "Local"`, fieldRef, fieldRef), Message: message},
+		checkData{Cond: fmt.Sprintf("_, err := time.LoadLocation(%s); err != nil", fieldRef), Message: message + ": %w", Args: []string{"err"}},
+	)
+}
+
+// ISBNRule validates that a string field is a valid ISBN-10 or ISBN-13 including its check digit.
+// Hyphens and spaces are ignored, so "978-3-16-148410-0" is accepted.
+type ISBNRule struct {
+	Version int // 10, 13, or 0 to accept either
+}
+
+func (r *ISBNRule) Name() string {
+	if r.Version == 0 {
+		return "isbn"
+	}
+	return fmt.Sprintf("isbn%d", r.Version)
+}
+
+func (r *ISBNRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *ISBNRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	switch r.Version {
+	case 10:
+		isbn10 := ctx.AddHelperFunc("isISBN10", isbn10Helper)
+		return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isbn10, fieldRef), fmt.Sprintf("field %s must be a valid ISBN-10", field.Name))
+	case 13:
+		isbn13 := ctx.AddHelperFunc("isISBN13", isbn13Helper)
+		return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isbn13, fieldRef), fmt.Sprintf("field %s must be a valid ISBN-13", field.Name))
+	default:
+		isbn10 := ctx.AddHelperFunc("isISBN10", isbn10Helper)
+		isbn13 := ctx.AddHelperFunc("isISBN13", isbn13Helper)
+		return renderCheck(ctx, fmt.Sprintf("!%s(%s) && !%s(%s)", isbn10, fieldRef, isbn13, fieldRef), fmt.Sprintf("field %s must be a valid ISBN", field.Name))
+	}
+}
+
+// isbn10Helper checks an ISBN-10 (mod 11, optional X check digit), ignoring hyphens and spaces
+const isbn10Helper = `(s string) bool {
+	sum, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '-' || c == ' ':
+			continue
+		case c >= '0' && c <= '9':
+			sum += int(c-'0') * (10 - n)
+		case (c == 'X' || c == 'x') && n == 9:
+			sum += 10
+		default:
+			return false
+		}
+		n++
+		if n > 10 {
+			return false
+		}
+	}
+	return n == 10 && sum%11 == 0
+}`
+
+// isbn13Helper checks an ISBN-13 (alternating 1/3 weights, mod 10), ignoring hyphens and spaces
+const isbn13Helper = `(s string) bool {
+	sum, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '-' || c == ' ' {
+			continue
+		}
+		if c < '0' || c > '9' {
+			return false
+		}
+		if n%2 == 0 {
+			sum += int(c - '0')
+		} else {
+			sum += int(c-'0') * 3
+		}
+		n++
+		if n > 13 {
+			return false
+		}
+	}
+	return n == 13 && sum%10 == 0
+}`
+
+// NumericRule validates that a string field holds a finite decimal number. The gt, gte,
+// lt and lte rules of the same field are folded into Bounds by the parser, so the string
+// is parsed once with strconv.ParseFloat and the result compared against each bound.
+type NumericRule struct {
+	Bounds []ValidationRule // *GTRule, *GTERule, *LTRule or *LTERule
+}
+
+func (r *NumericRule) Name() string { return "numeric" }
+
+func (r *NumericRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *NumericRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("strconv", "strconv")
+	ctx.AddImport("math", "math")
+
+	// Use unique names for the value and its error to avoid redeclaration and shadowing
+	varName := ctx.LocalVarName(field, r.Name(), field.identName()+"Float")
+
+	setup := []string{fmt.Sprintf("%s, %sErr := strconv.ParseFloat(%s, 64)", varName, varName, fieldRef)}
+	checks := []checkData{{
+		Cond:    fmt.Sprintf("%sErr != nil || math.IsNaN(%s) || math.IsInf(%s, 0)", varName, varName, varName),
+		Message: fmt.Sprintf("field %s must be a valid number", field.Name),
+	}}
+
+	for _, bound := range r.Bounds {
+		var op, message, raw string
+		switch b := bound.(type) {
+		case *GTRule:
+			op, message, raw = "<=", "greater than", b.Value
+		case *GTERule:
+			op, message, raw = "<", "at least", b.Value
+		case *LTRule:
+			op, message, raw = ">=", "less than", b.Value
+		case *LTERule:
+			op, message, raw = ">", "at most", b.Value
+		default:
+			return "", fmt.Errorf("numeric validation on field %s: unsupported bound rule %s", field.Name, bound.Name())
+		}
+
+		value, err := formatNumericBound(raw, false)
+		if err == nil {
+			err = checkBoundRange(TypeInfo{GoType: types.Typ[types.Float64]}, value)
+		}
+		if err != nil {
+			return "", fmt.Errorf("%s validation on field %s: %w", bound.Name(), field.Name, err)
+		}
+
+		checks = append(checks, checkData{
+			Cond:    fmt.Sprintf("%s %s %s", varName, op, value),
+			Message: fmt.Sprintf("field %s must be %s %s", field.Name, message, value),
+		})
+	}
+
+	return renderChecks(ctx, setup, checks...)
+}
+
+// FiniteRule validates that a float field is neither NaN nor ±Inf
+type FiniteRule struct{}
+
+func (r *FiniteRule) Name() string { return "finite" }
+
+func (r *FiniteRule) Validate(fieldType TypeInfo) error {
+	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.IsFloat() {
+		return nil
+	}
+	if !fieldType.IsFloat() {
+		return fmt.Errorf("finite validation only applicable to float types")
+	}
+	return nil
+}
+
+func (r *FiniteRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	typeInfo := expr.Elem
+	fieldRef := expr.Value()
+	if !typeInfo.IsFloat() {
+		return "", fmt.Errorf("finite validation only applicable to float types")
+	}
+	if typeInfo.Name != "float64" {
+		fieldRef = fmt.Sprintf("float64(%s)", fieldRef)
+	}
+
+	ctx.AddImport("math", "math")
+
+	return renderCheck(ctx, fmt.Sprintf("math.IsNaN(%s) || math.IsInf(%s, 0)", fieldRef, fieldRef), fmt.Sprintf("field %s must be a finite number", field.Name))
+}
+
+// CronRule validates that a string field is a cron expression: five fields (minute, hour,
+// day of month, month, day of week), six fields with a leading seconds field, or one of
+// the @yearly/@annually/@monthly/@weekly/@daily/@midnight/@hourly descriptors
+type CronRule struct{}
+
+func (r *CronRule) Name() string { return "cron" }
+
+func (r *CronRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *CronRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("strings", "strings")
+	isCron := ctx.AddHelperFunc("isCron", cronHelper)
+
+	return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isCron, fieldRef), fmt.Sprintf("field %s must be a valid cron expression", field.Name))
+}
+
+// cronHelper checks each field of a cron expression against its range. Items are
+// comma-separated values, a-b ranges or *, each optionally followed by /step.
+// Months and weekdays also accept three-letter names (case-insensitive), weekday 7
+// is Sunday, and ? is accepted in the day-of-month and day-of-week fields.
+const cronHelper = `(s string) bool {
+	switch s {
+	case "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly":
+		return true
+	}
+
+	type bounds struct {
+		min, max int
+		names    string // three-letter names of min, min+1, ...
+	}
+	specs := []bounds{{0, 59, ""}, {0, 23, ""}, {1, 31, ""}, {1, 12, "JANFEBMARAPRMAYJUNJULAUGSEPOCTNOVDEC"}, {0, 7, "SUNMONTUEWEDTHUFRISAT"}}
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 5:
+	case 6:
+		specs = append([]bounds{{0, 59, ""}}, specs...)
+	default:
+		return false
+	}
+
+	value := func(v string, b bounds) (int, bool) {
+		if v != "" && len(v) <= 2 && strings.Trim(v, "0123456789") == "" {
+			n := 0
+			for i := 0; i < len(v); i++ {
+				n = n*10 + int(v[i]-'0')
+			}
+			return n, n >= b.min && n <= b.max
+		}
+		for i := 0; len(v) == 3 && i+3 <= len(b.names); i += 3 {
+			if strings.EqualFold(v, b.names[i:i+3]) {
+				return b.min + i/3, true
+			}
+		}
+		return 0, false
+	}
+
+	for i, field := range fields {
+		b := specs[i]
+		for _, item := range strings.Split(field, ",") {
+			rng, step, hasStep := strings.Cut(item, "/")
+			if hasStep {
+				if _, ok := value(step, bounds{1, b.max, ""}); !ok {
+					return false
+				}
+			}
+			if rng == "*" || (rng == "?" && (i == len(specs)-3 || i == len(specs)-1)) {
+				continue
+			}
+			lo, hi, isRange := strings.Cut(rng, "-")
+			start, ok := value(lo, b)
+			if !ok {
+				return false
+			}
+			if isRange {
+				if end, endOK := value(hi, b); !endOK || end < start {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}`
+
+// IBANRule validates that a string field is an IBAN (ISO 13616): two-letter country code,
+// two check digits and an alphanumeric BBAN, 15-34 characters in total, with a valid mod-97
+// checksum. Spaces are ignored so the printed form "GB82 WEST 1234 5698 7654 32" is accepted.
+// Country-specific BBAN lengths and formats are not checked.
+type IBANRule struct{}
+
+func (r *IBANRule) Name() string { return "iban" }
+
+func (r *IBANRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *IBANRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	isIBAN := ctx.AddHelperFunc("isIBAN", ibanHelper)
+	return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isIBAN, fieldRef), fmt.Sprintf("field %s must be a valid IBAN", field.Name))
+}
+
+// ibanHelper checks the IBAN structure and its ISO 7064 mod 97-10 checksum, ignoring spaces.
+// The first four characters are moved to the end, letters become 10..35 and the
+// resulting number must leave remainder 1; it is reduced digit by digit to avoid big integers.
+const ibanHelper = `(s string) bool {
+	var buf [34]byte
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == ' ' {
+			continue
+		}
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+		if n == len(buf) {
+			return false
+		}
+		buf[n] = c
+		n++
+	}
+	if n < 15 {
+		return false
+	}
+	if buf[0] < 'A' || buf[1] < 'A' || buf[2] > '9' || buf[3] > '9' {
+		return false
+	}
+	rem := 0
+	for i := 0; i < n; i++ {
+		c := buf[(i+4)%n]
+		if c >= 'A' {
+			rem = (rem*100 + int(c-'A'+10)) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem == 1
+}`
+
+// bicPattern matches a BIC: institution, country and location codes, then an
+// optional branch code
+const bicPattern = `^[A-Z]{6}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`
+
+// BICRule validates that a string field is a BIC / SWIFT code (ISO 9362): four-letter
+// institution code, two-letter country code, two-character location code and an
+// optional three-character branch code, in upper case
+type BICRule struct{}
+
+func (r *BICRule) Name() string { return "bic" }
+
+func (r *BICRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
 
-	// If no validation code was generated, don't create an empty loop
-	if len(validationLines) == 0 {
-		return "", nil
+func (r *BICRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
 	}
 
-	var code strings.Builder
+	// Add regexp package import
+	ctx.AddImport("regexp", "regexp")
+
+	regexpVar := ctx.AddRegexpVar(bicPattern, "bicRegexp")
 
-	// Start loop
-	code.WriteString(fmt.Sprintf("\tfor i, elem := range %s.%s {\n", receiverVar, field.Name))
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid BIC", field.Name))
+}
+
+// MongoDBRule validates that a string field is a MongoDB ObjectID in its hex form:
+// exactly 24 hexadecimal characters, in either case
+type MongoDBRule struct{}
+
+func (r *MongoDBRule) Name() string { return "mongodb" }
+
+func (r *MongoDBRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
 
-	// Add validation lines
-	for _, line := range validationLines {
-		code.WriteString("\t\t")
-		code.WriteString(line)
-		code.WriteString("\n")
+func (r *MongoDBRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
 	}
 
-	code.WriteString("\t}")
+	// Add regexp package import
+	ctx.AddImport("regexp", "regexp")
+
+	regexpVar := ctx.AddRegexpVar(`^[0-9a-fA-F]{24}$`, "mongodbRegexp")
 
-	return code.String(), nil
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef), fmt.Sprintf("field %s must be a valid MongoDB ObjectID", field.Name))
 }
 
-// CustomRule calls a custom validation function
-type CustomRule struct {
-	ImportPath string
-	FuncName   string
+// hashHexLengths is the number of hex characters in the digest of each hash rule
+var hashHexLengths = map[string]int{
+	"md5":    32,
+	"sha1":   40,
+	"sha256": 64,
+	"sha512": 128,
 }
 
-func (r *CustomRule) Name() string { return "custom" }
+// hashDisplayNames are the algorithm names used in error messages
+var hashDisplayNames = map[string]string{
+	"md5":    "MD5",
+	"sha1":   "SHA-1",
+	"sha256": "SHA-256",
+	"sha512": "SHA-512",
+}
 
-func (r *CustomRule) Validate(fieldType TypeInfo) error {
-	return nil
+// HashRule validates that a string field is a hex-encoded digest of the given
+// algorithm: md5, sha1, sha256 or sha512. Only the shape is checked, i.e. the
+// length and the hexadecimal characters, in either case.
+type HashRule struct {
+	Algorithm string
 }
 
-func (r *CustomRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+func (r *HashRule) Name() string { return r.Algorithm }
 
-	// Add import
-	parts := strings.Split(r.ImportPath, "/")
-	pkgName := parts[len(parts)-1]
-	alias := ctx.AddImport(r.ImportPath, pkgName)
+func (r *HashRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *HashRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("regexp", "regexp")
+
+	pattern := fmt.Sprintf(`^[0-9a-fA-F]{%d}$`, hashHexLengths[r.Algorithm])
+	regexpVar := ctx.AddRegexpVar(pattern, r.Algorithm+"Regexp")
 
-	return fmt.Sprintf(`	if err := %s.%s(%s.%s); err != nil {
-		return fmt.Errorf("field %s custom validation failed: %%w", err)
-	}`, alias, r.FuncName, receiverVar, field.Name, field.Name), nil
+	return renderCheck(ctx, fmt.Sprintf("!%s.MatchString(%s)", regexpVar, fieldRef),
+		fmt.Sprintf("field %s must be a valid %s hex digest", field.Name, hashDisplayNames[r.Algorithm]))
 }
 
-// UUIDRule validates that a string field is a valid UUID
-type UUIDRule struct{}
+// PrintableRule rejects strings that could break log lines or terminal output.
+// printable allows only printable runes (letters, marks, numbers, punctuation,
+// symbols and the ASCII space); no_control_chars only rejects control characters
+// such as newlines, tabs and escape. Both reject invalid UTF-8.
+type PrintableRule struct {
+	ControlOnly bool // no_control_chars
+}
 
-func (r *UUIDRule) Name() string { return "uuid" }
+func (r *PrintableRule) Name() string {
+	if r.ControlOnly {
+		return "no_control_chars"
+	}
+	return "printable"
+}
 
-func (r *UUIDRule) Validate(fieldType TypeInfo) error {
-	// Handle pointer to string
-	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+func (r *PrintableRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *PrintableRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
 	}
 
-	if fieldType.Kind != TypeString {
-		return fmt.Errorf("uuid validation only applicable to string types")
+	ctx.AddImport("unicode", "unicode")
+	ctx.AddImport("unicode/utf8", "utf8")
+
+	if r.ControlOnly {
+		noControl := ctx.AddHelperFunc("hasNoControlChars", noControlCharsHelper)
+		return renderCheck(ctx, fmt.Sprintf("!%s(%s)", noControl, fieldRef), fmt.Sprintf("field %s must not contain control characters", field.Name))
 	}
-	return nil
-}
 
-func (r *UUIDRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+	isPrintable := ctx.AddHelperFunc("isPrintable", printableHelper)
+	return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isPrintable, fieldRef), fmt.Sprintf("field %s must contain only printable characters", field.Name))
+}
 
-	// Skip non-string types
-	if typeInfo.Kind != TypeString {
-		if typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString {
-			return "", fmt.Errorf("uuid validation only applicable to string types")
-		}
-		if !typeInfo.IsPointer {
-			return "", fmt.Errorf("uuid validation only applicable to string types")
+// printableHelper accepts valid UTF-8 made of unicode.IsPrint runes
+const printableHelper = `(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
 		}
 	}
+	return true
+}`
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+// noControlCharsHelper accepts valid UTF-8 without unicode.IsControl runes
+const noControlCharsHelper = `(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}`
 
-	// Add regexp package import
-	ctx.AddImport("regexp", "regexp")
+// Base64Rule validates that a string or []byte field holds standard, padded base64
+type Base64Rule struct{}
 
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
+func (r *Base64Rule) Name() string { return "base64" }
 
-	// UUID regex pattern (matches UUID v1-v5)
-	uuidPattern := `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[1-5][0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`
+func (r *Base64Rule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
 
-	if typeInfo.IsPointer {
-		// For pointer to string, dereference
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+func (r *Base64Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
 	}
 
-	// Get or create package-level regexp variable
-	regexpVar := ctx.AddRegexpVar(uuidPattern, "uuidRegexp")
+	ctx.AddImport("encoding/base64", "base64")
 
-	return fmt.Sprintf(`	if !%s.MatchString(%s) {
-		return fmt.Errorf("field %s must be a valid UUID")
-	}`, regexpVar, fieldRef, field.Name), nil
+	return renderCheck(ctx, fmt.Sprintf("_, err := base64.StdEncoding.DecodeString(%s); err != nil", fieldRef), fmt.Sprintf("field %s must be valid base64", field.Name))
 }
 
-// ISO4217Rule validates that a string field is a valid ISO 4217 currency code
-type ISO4217Rule struct{}
+// JSONRule validates that a string, []byte or json.RawMessage field holds well-formed
+// JSON
+type JSONRule struct{}
 
-func (r *ISO4217Rule) Name() string { return "iso4217" }
+func (r *JSONRule) Name() string { return "json" }
 
-func (r *ISO4217Rule) Validate(fieldType TypeInfo) error {
-	// Handle pointer to string
-	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+func (r *JSONRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *JSONRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if err := r.Validate(expr.Type); err != nil {
+		return "", err
 	}
 
-	if fieldType.Kind != TypeString {
-		return fmt.Errorf("iso4217 validation only applicable to string types")
+	data := expr.Value()
+	if expr.Elem.Kind == TypeString {
+		data = fmt.Sprintf("[]byte(%s)", data)
 	}
-	return nil
+	ctx.AddImport("encoding/json", "json")
+
+	return renderCheck(ctx, fmt.Sprintf("!json.Valid(%s)", data), fmt.Sprintf("field %s must be valid JSON", field.Name))
 }
 
-func (r *ISO4217Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+// DataURIRule validates that a string field is an RFC 2397 data URI with a base64
+// payload, e.g. "data:image/png;base64,iVBORw0KGgo=". The media type may be left
+// out; when present it must parse with mime.ParseMediaType.
+type DataURIRule struct{}
 
-	// Skip non-string types
-	if typeInfo.Kind != TypeString {
-		if typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString {
-			return "", fmt.Errorf("iso4217 validation only applicable to string types")
+func (r *DataURIRule) Name() string {
+	return "datauri"
+}
+
+func (r *DataURIRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, "datauri")
+}
+
+func (r *DataURIRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, "datauri")
+	if err != nil {
+		return "", err
+	}
+
+	ctx.AddImport("encoding/base64", "base64")
+	ctx.AddImport("mime", "mime")
+	ctx.AddImport("strings", "strings")
+	isDataURI := ctx.AddHelperFunc("isDataURI", dataURIHelper)
+
+	return renderCheck(ctx, fmt.Sprintf("!%s(%s)", isDataURI, fieldRef), fmt.Sprintf("field %s must be a base64 data URI", field.Name))
+}
+
+// dataURIHelper accepts "data:[<mediatype>];base64,<payload>" with a type/subtype
+// media type, or parameters only (text/plain is implied), and standard base64
+const dataURIHelper = `(s string) bool {
+	rest, ok := strings.CutPrefix(s, "data:")
+	if !ok {
+		return false
+	}
+	meta, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return false
+	}
+	meta, ok = strings.CutSuffix(meta, ";base64")
+	if !ok {
+		return false
+	}
+	if meta != "" {
+		if strings.HasPrefix(meta, ";") {
+			meta = "text/plain" + meta
 		}
-		if !typeInfo.IsPointer {
-			return "", fmt.Errorf("iso4217 validation only applicable to string types")
+		mediaType, _, err := mime.ParseMediaType(meta)
+		if err != nil || !strings.Contains(mediaType, "/") {
+			return false
 		}
 	}
+	_, err := base64.StdEncoding.DecodeString(payload)
+	return err == nil
+}`
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-
-	if typeInfo.IsPointer {
-		// For pointer to string, dereference
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
-	}
-
-	// Use unique variable name to avoid redeclaration
-	ctx.VarCounter++
-	mapVar := fmt.Sprintf("iso4217Codes%d", ctx.VarCounter)
-
-	// Generate the validation code with an inline map
-	return fmt.Sprintf(`	%s := map[string]struct{}{
-		"AFN": {}, "EUR": {}, "ALL": {}, "DZD": {}, "USD": {},
-		"AOA": {}, "XCD": {}, "ARS": {}, "AMD": {}, "AWG": {},
-		"AUD": {}, "AZN": {}, "BSD": {}, "BHD": {}, "BDT": {},
-		"BBD": {}, "BYN": {}, "BZD": {}, "XOF": {}, "BMD": {},
-		"INR": {}, "BTN": {}, "BOB": {}, "BOV": {}, "BAM": {},
-		"BWP": {}, "NOK": {}, "BRL": {}, "BND": {}, "BGN": {},
-		"BIF": {}, "CVE": {}, "KHR": {}, "XAF": {}, "CAD": {},
-		"KYD": {}, "CLP": {}, "CLF": {}, "CNY": {}, "COP": {},
-		"COU": {}, "KMF": {}, "CDF": {}, "NZD": {}, "CRC": {},
-		"CUP": {}, "CZK": {}, "DKK": {}, "DJF": {}, "DOP": {},
-		"EGP": {}, "SVC": {}, "ERN": {}, "SZL": {}, "ETB": {},
-		"FKP": {}, "FJD": {}, "XPF": {}, "GMD": {}, "GEL": {},
-		"GHS": {}, "GIP": {}, "GTQ": {}, "GBP": {}, "GNF": {},
-		"GYD": {}, "HTG": {}, "HNL": {}, "HKD": {}, "HUF": {},
-		"ISK": {}, "IDR": {}, "XDR": {}, "IRR": {}, "IQD": {},
-		"ILS": {}, "JMD": {}, "JPY": {}, "JOD": {}, "KZT": {},
-		"KES": {}, "KPW": {}, "KRW": {}, "KWD": {}, "KGS": {},
-		"LAK": {}, "LBP": {}, "LSL": {}, "ZAR": {}, "LRD": {},
-		"LYD": {}, "CHF": {}, "MOP": {}, "MKD": {}, "MGA": {},
-		"MWK": {}, "MYR": {}, "MVR": {}, "MRU": {}, "MUR": {},
-		"XUA": {}, "MXN": {}, "MXV": {}, "MDL": {}, "MNT": {},
-		"MAD": {}, "MZN": {}, "MMK": {}, "NAD": {}, "NPR": {},
-		"NIO": {}, "NGN": {}, "OMR": {}, "PKR": {}, "PAB": {},
-		"PGK": {}, "PYG": {}, "PEN": {}, "PHP": {}, "PLN": {},
-		"QAR": {}, "RON": {}, "RUB": {}, "RWF": {}, "SHP": {},
-		"WST": {}, "STN": {}, "SAR": {}, "RSD": {}, "SCR": {},
-		"SLE": {}, "SGD": {}, "XSU": {}, "SBD": {}, "SOS": {},
-		"SSP": {}, "LKR": {}, "SDG": {}, "SRD": {}, "SEK": {},
-		"CHE": {}, "CHW": {}, "SYP": {}, "TWD": {}, "TJS": {},
-		"TZS": {}, "THB": {}, "TOP": {}, "TTD": {}, "TND": {},
-		"TRY": {}, "TMT": {}, "UGX": {}, "UAH": {}, "AED": {},
-		"USN": {}, "UYU": {}, "UYI": {}, "UYW": {}, "UZS": {},
-		"VUV": {}, "VES": {}, "VED": {}, "VND": {}, "YER": {},
-		"ZMW": {}, "ZWG": {}, "XBA": {}, "XBB": {}, "XBC": {},
-		"XBD": {}, "XCG": {}, "XTS": {}, "XXX": {}, "XAU": {},
-		"XPD": {}, "XPT": {}, "XAG": {},
-	}
-	if _, ok := %s[%s]; !ok {
-		return fmt.Errorf("field %s must be a valid ISO 4217 currency code")
-	}`, mapVar, mapVar, fieldRef, field.Name), nil
+// OneOfRule validates that a string or integer field is one of a set of values,
+// e.g. oneof=draft published archived
+type OneOfRule struct {
+	Values []string
 }
 
-// EmailRule validates that a string field is a valid email address
-type EmailRule struct{}
+func (r *OneOfRule) Name() string { return "oneof" }
 
-func (r *EmailRule) Name() string { return "email" }
+func (r *OneOfRule) Validate(fieldType TypeInfo) error {
+	return nil
+}
 
-func (r *EmailRule) Validate(fieldType TypeInfo) error {
-	// Handle pointer to string
-	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+func (r *OneOfRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	set, err := newCodeSet(r.Name(), r.Values, expr.Elem)
+	if err != nil {
+		return "", err
 	}
-
-	// Handle slice of strings
-	if fieldType.IsSlice && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+	value := expr.Value()
+	if set.Kind == "string" {
+		if value, err = expr.StringValue(r.Name()); err != nil {
+			return "", err
+		}
 	}
 
-	// Handle slice of pointer to strings
-	if fieldType.IsSlice && fieldType.Elem != nil && fieldType.Elem.IsPointer && fieldType.Elem.Elem != nil && fieldType.Elem.Elem.Kind == TypeString {
-		return nil
+	message := fmt.Sprintf("field %s must be %s", field.Name, strings.ReplaceAll(set.description(), "%", "%%"))
+	failure, err := renderFail(ctx, literal(message))
+	if err != nil {
+		return "", err
 	}
+	return "\t" + strings.Join(set.check(ctx, value, failure), "\n\t"), nil
+}
 
-	if fieldType.Kind != TypeString {
-		return fmt.Errorf("email validation only applicable to string types")
-	}
-	return nil
+// SubsetOfRule validates that every element of a slice or array is one of a set of
+// values, e.g. subsetof=read write admin
+type SubsetOfRule struct {
+	Values []string
 }
 
-func (r *EmailRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+func (r *SubsetOfRule) Name() string { return "subsetof" }
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
+func (r *SubsetOfRule) Validate(fieldType TypeInfo) error {
+	return nil
+}
 
-	// Add regexp package import
-	ctx.AddImport("regexp", "regexp")
+func (r *SubsetOfRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if (!expr.Elem.IsSlice && expr.Elem.Kind != TypeArray) || expr.Elem.Elem == nil {
+		return "", fmt.Errorf("subsetof validation only applicable to slices and arrays")
+	}
+	elem := *expr.Elem.Elem
+	set, err := newCodeSet(r.Name(), r.Values, elem)
+	if err != nil {
+		return "", err
+	}
+	value := "elem"
+	if set.Kind == "string" && elem.Name != "" && elem.Name != "string" {
+		value = "string(elem)"
+	}
 
-	// Basic email regex pattern - intentionally broad
-	emailPattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
+	message := fmt.Sprintf("field %s[%%d] must be %s", field.Name, strings.ReplaceAll(set.description(), "%", "%%"))
+	failure, err := renderFail(ctx, literal(message), "i")
+	if err != nil {
+		return "", err
+	}
+	return renderCode("sliceElements", sliceElementsData{
+		Index: "i",
+		Elem:  "elem",
+		Ref:   expr.Value(),
+		Lines: set.check(ctx, value, failure),
+	})
+}
 
-	// Get or create package-level regexp variable
-	regexpVar := ctx.AddRegexpVar(emailPattern, "emailRegexp")
+// JSONOfRule validates that a []byte or json.RawMessage field holds a JSON encoding
+// of a struct type, and that the decoded value passes the type's Validate method.
+// An empty ImportPath refers to the current package.
+type JSONOfRule struct {
+	ImportPath string
+	TypeName   string
 
-	// Handle slice of strings
-	if typeInfo.IsSlice {
-		if typeInfo.Elem == nil {
-			return "", fmt.Errorf("cannot validate slice: element type unknown")
-		}
+	// target is the struct of the current package the JSON decodes into
+	target *StructInfo
+}
 
-		elemType := *typeInfo.Elem
+func (r *JSONOfRule) Name() string { return "jsonof" }
 
-		// Handle slice of pointer to strings
-		if elemType.IsPointer {
-			return fmt.Sprintf(`	for i, email := range %s.%s {
-		if email == nil {
-			continue
-		}
-		if !%s.MatchString(*email) {
-			return fmt.Errorf("field %s[%%d] must be a valid email address", i)
-		}
-	}`, receiverVar, field.Name, regexpVar, field.Name), nil
-		}
+func (r *JSONOfRule) Validate(fieldType TypeInfo) error {
+	return nil
+}
 
-		// Handle slice of strings
-		if elemType.Kind == TypeString {
-			return fmt.Sprintf(`	for i, email := range %s.%s {
-		if !%s.MatchString(email) {
-			return fmt.Errorf("field %s[%%d] must be a valid email address", i)
-		}
-	}`, receiverVar, field.Name, regexpVar, field.Name), nil
-		}
+// call returns the call that validates the decoded value held in payload: its
+// Validate method, or the generated method or subpackage function of a struct of
+// the current package
+func (r *JSONOfRule) call(ctx *CodeGenContext, payload string) string {
+	if r.target == nil {
+		return payload + ".Validate()"
+	}
+	if ctx.Options.Subpackage != "" {
+		return fmt.Sprintf("%s(&%s)", validateFunc(r.target, ctx.Options), payload)
+	}
+	return payload + "." + methodName(r.target, ctx.Options) + "()"
+}
 
-		return "", fmt.Errorf("email validation only applicable to string types")
+func (r *JSONOfRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	expr := ctx.FieldExpr(field)
+	if !isByteSlice(expr.Elem) {
+		return "", fmt.Errorf("jsonof validation only applicable to []byte and json.RawMessage fields")
 	}
 
-	// Skip non-string types
-	if typeInfo.Kind != TypeString {
-		if typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString {
-			return "", fmt.Errorf("email validation only applicable to string types")
-		}
-		if !typeInfo.IsPointer {
-			return "", fmt.Errorf("email validation only applicable to string types")
+	var typeName string
+	if r.ImportPath != "" && r.ImportPath != ctx.PkgPath {
+		parts := strings.Split(r.ImportPath, "/")
+		typeName = ctx.AddImport(r.ImportPath, parts[len(parts)-1]) + "." + r.TypeName
+	} else {
+		typeName = ctx.LocalRef(r.TypeName)
+	}
+	ctx.AddImport("encoding/json", "json")
+	payload := ctx.LocalVarName(field, r.Name(), "payload")
+
+	code, err := renderChecks(ctx, []string{fmt.Sprintf("var %s %s", payload, typeName)},
+		checkData{
+			Cond:    fmt.Sprintf("err := json.Unmarshal(%s, &%s); err != nil", expr.Value(), payload),
+			Message: fmt.Sprintf("field %s must be a JSON %s: %%w", field.Name, r.TypeName),
+			Args:    []string{"err"},
+		},
+		checkData{
+			Cond:    fmt.Sprintf("err := %s; err != nil", r.call(ctx, payload)),
+			Message: fmt.Sprintf("field %s validation failed: %%w", field.Name),
+			Args:    []string{"err"},
+		},
+	)
+	if err != nil || !expr.Pointer {
+		return code, err
+	}
+	return guardNil(expr.Ref, code)
+}
+
+// isByteSlice reports whether the type is []byte or a named type with that
+// underlying type, such as json.RawMessage. It is true without type information
+// only for a literal []byte.
+func isByteSlice(t TypeInfo) bool {
+	if t.GoType != nil {
+		slice, ok := t.GoType.Underlying().(*types.Slice)
+		if !ok {
+			return false
 		}
+		elem, ok := slice.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Uint8
 	}
+	return t.IsSlice && t.Elem != nil && t.Elem.Kind == TypeUint8
+}
 
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
+// PostcodeRule validates that a string field is a postal code in the format used by the
+// country whose ISO 3166-1 alpha-2 code is held in a sibling field. Unknown countries and
+// countries without postal codes fail validation.
+type PostcodeRule struct {
+	CountryField string
+}
 
-	if typeInfo.IsPointer {
-		// For pointer to string, dereference
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
+func (r *PostcodeRule) Name() string { return "postcode_iso3166_alpha2" }
+
+func (r *PostcodeRule) Validate(fieldType TypeInfo) error {
+	return validateStringType(fieldType, r.Name())
+}
+
+func (r *PostcodeRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+	fieldRef, err := stringFieldRef(ctx, field, r.Name())
+	if err != nil {
+		return "", err
+	}
+
+	countryType := structFieldType(ctx.Struct, r.CountryField)
+	if countryType == nil {
+		return "", fmt.Errorf("%s=%s on field %s: struct %s has no field %s", r.Name(), r.CountryField, field.Name, ctx.Struct.Name, r.CountryField)
+	}
+	countryField := &FieldInfo{Name: r.CountryField, Type: countryType}
+	countryRef, err := stringFieldRef(ctx, countryField, r.Name())
+	if err != nil {
+		return "", fmt.Errorf("%s=%s on field %s: country field must be a string", r.Name(), r.CountryField, field.Name)
 	}
 
-	return fmt.Sprintf(`	if !%s.MatchString(%s) {
-		return fmt.Errorf("field %s must be a valid email address")
-	}`, regexpVar, fieldRef, field.Name), nil
+	ctx.AddImport("regexp", "regexp")
+	patterns := ctx.AddHelperVar("postcodePatterns", formatPostcodeTable())
+
+	var checks []checkData
+	if ResolveTypeInfo(countryType, ctx.TypesInfo).IsPointer {
+		checks = append(checks, checkData{
+			Cond:    fmt.Sprintf("%s.%s == nil", ctx.Receiver(), r.CountryField),
+			Message: fmt.Sprintf("field %s requires field %s to be set", field.Name, r.CountryField),
+		})
+	}
+	// A unique name keeps the pattern from shadowing the receiver
+	pattern := ctx.LocalVarName(field, r.Name(), field.identName()+"Pattern")
+	checks = append(checks, checkData{
+		Cond:    fmt.Sprintf("%s, ok := %s[%s]; !ok || !%s.MatchString(%s)", pattern, patterns, countryRef, pattern, fieldRef),
+		Message: fmt.Sprintf("field %s must be a valid postal code for the country in field %s", field.Name, r.CountryField),
+	})
+	return renderChecks(ctx, nil, checks...)
 }
 
-// ISO3166_1_Alpha2Rule validates that a string field is a valid ISO 3166-1 alpha-2 country code
-type ISO3166_1_Alpha2Rule struct{}
+// CoordinateRule validates that a float or numeric string field is a valid latitude (-90..90)
+// or longitude (-180..180). The range check is written so that NaN is rejected.
+type CoordinateRule struct {
+	Longitude bool
+}
 
-func (r *ISO3166_1_Alpha2Rule) Name() string { return "iso3166_1_alpha2" }
+func (r *CoordinateRule) Name() string {
+	if r.Longitude {
+		return "longitude"
+	}
+	return "latitude"
+}
 
-func (r *ISO3166_1_Alpha2Rule) Validate(fieldType TypeInfo) error {
-	// Handle pointer to string
-	if fieldType.IsPointer && fieldType.Elem != nil && fieldType.Elem.Kind == TypeString {
-		return nil
+// limit returns the absolute bound for the coordinate
+func (r *CoordinateRule) limit() int {
+	if r.Longitude {
+		return 180
 	}
+	return 90
+}
 
-	if fieldType.Kind != TypeString {
-		return fmt.Errorf("iso3166_1_alpha2 validation only applicable to string types")
+func (r *CoordinateRule) Validate(fieldType TypeInfo) error {
+	if fieldType.IsPointer && fieldType.Elem != nil {
+		fieldType = *fieldType.Elem
 	}
-	return nil
+
+	if fieldType.IsFloat() || fieldType.Kind == TypeString {
+		return nil
+	}
+	return fmt.Errorf("%s validation only applicable to float and string types", r.Name())
 }
 
-func (r *ISO3166_1_Alpha2Rule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
+func (r *CoordinateRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
 	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+	elemType := typeInfo
+	if typeInfo.IsPointer && typeInfo.Elem != nil {
+		elemType = *typeInfo.Elem
+	}
 
-	// Skip non-string types
-	if typeInfo.Kind != TypeString {
-		if typeInfo.IsPointer && typeInfo.Elem != nil && typeInfo.Elem.Kind != TypeString {
-			return "", fmt.Errorf("iso3166_1_alpha2 validation only applicable to string types")
-		}
-		if !typeInfo.IsPointer {
-			return "", fmt.Errorf("iso3166_1_alpha2 validation only applicable to string types")
+	limit := r.limit()
+
+	if elemType.Kind == TypeString {
+		fieldRef, err := stringFieldRef(ctx, field, r.Name())
+		if err != nil {
+			return "", err
 		}
+
+		ctx.AddImport("strconv", "strconv")
+
+		// A unique name keeps the parsed value from shadowing the receiver
+		varName := ctx.LocalVarName(field, r.Name(), field.identName()+"Float")
+		return renderCheck(ctx, fmt.Sprintf("%s, err := strconv.ParseFloat(%s, 64); err != nil || !(%s >= -%d && %s <= %d)", varName, fieldRef, varName, limit, varName, limit),
+			fmt.Sprintf("field %s must be a valid %s", field.Name, r.Name()))
 	}
 
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
+	if !elemType.IsFloat() {
+		return "", fmt.Errorf("%s validation only applicable to float and string types", r.Name())
+	}
 
-	if typeInfo.IsPointer {
-		// For pointer to string, dereference
-		fieldRef = fmt.Sprintf("*%s", fieldRef)
-	}
-
-	// Use unique variable name to avoid redeclaration
-	ctx.VarCounter++
-	mapVar := fmt.Sprintf("iso3166_1_alpha2Codes%d", ctx.VarCounter)
-
-	// Generate the validation code with an inline map
-	return fmt.Sprintf(`	%s := map[string]struct{}{
-		"AF": {}, "AX": {}, "AL": {}, "DZ": {}, "AS": {},
-		"AD": {}, "AO": {}, "AI": {}, "AQ": {}, "AG": {},
-		"AR": {}, "AM": {}, "AW": {}, "AU": {}, "AT": {},
-		"AZ": {}, "BS": {}, "BH": {}, "BD": {}, "BB": {},
-		"BY": {}, "BE": {}, "BZ": {}, "BJ": {}, "BM": {},
-		"BT": {}, "BO": {}, "BQ": {}, "BA": {}, "BW": {},
-		"BV": {}, "BR": {}, "IO": {}, "BN": {}, "BG": {},
-		"BF": {}, "BI": {}, "KH": {}, "CM": {}, "CA": {},
-		"CV": {}, "KY": {}, "CF": {}, "TD": {}, "CL": {},
-		"CN": {}, "CX": {}, "CC": {}, "CO": {}, "KM": {},
-		"CG": {}, "CD": {}, "CK": {}, "CR": {}, "CI": {},
-		"HR": {}, "CU": {}, "CW": {}, "CY": {}, "CZ": {},
-		"DK": {}, "DJ": {}, "DM": {}, "DO": {}, "EC": {},
-		"EG": {}, "SV": {}, "GQ": {}, "ER": {}, "EE": {},
-		"ET": {}, "FK": {}, "FO": {}, "FJ": {}, "FI": {},
-		"FR": {}, "GF": {}, "PF": {}, "TF": {}, "GA": {},
-		"GM": {}, "GE": {}, "DE": {}, "GH": {}, "GI": {},
-		"GR": {}, "GL": {}, "GD": {}, "GP": {}, "GU": {},
-		"GT": {}, "GG": {}, "GN": {}, "GW": {}, "GY": {},
-		"HT": {}, "HM": {}, "VA": {}, "HN": {}, "HK": {},
-		"HU": {}, "IS": {}, "IN": {}, "ID": {}, "IR": {},
-		"IQ": {}, "IE": {}, "IM": {}, "IL": {}, "IT": {},
-		"JM": {}, "JP": {}, "JE": {}, "JO": {}, "KZ": {},
-		"KE": {}, "KI": {}, "KP": {}, "KR": {}, "KW": {},
-		"KG": {}, "LA": {}, "LV": {}, "LB": {}, "LS": {},
-		"LR": {}, "LY": {}, "LI": {}, "LT": {}, "LU": {},
-		"MO": {}, "MK": {}, "MG": {}, "MW": {}, "MY": {},
-		"MV": {}, "ML": {}, "MT": {}, "MH": {}, "MQ": {},
-		"MR": {}, "MU": {}, "YT": {}, "MX": {}, "FM": {},
-		"MD": {}, "MC": {}, "MN": {}, "ME": {}, "MS": {},
-		"MA": {}, "MZ": {}, "MM": {}, "NA": {}, "NR": {},
-		"NP": {}, "NL": {}, "NC": {}, "NZ": {}, "NI": {},
-		"NE": {}, "NG": {}, "NU": {}, "NF": {}, "MP": {},
-		"NO": {}, "OM": {}, "PK": {}, "PW": {}, "PS": {},
-		"PA": {}, "PG": {}, "PY": {}, "PE": {}, "PH": {},
-		"PN": {}, "PL": {}, "PT": {}, "PR": {}, "QA": {},
-		"RE": {}, "RO": {}, "RU": {}, "RW": {}, "BL": {},
-		"SH": {}, "KN": {}, "LC": {}, "MF": {}, "PM": {},
-		"VC": {}, "WS": {}, "SM": {}, "ST": {}, "SA": {},
-		"SN": {}, "RS": {}, "SC": {}, "SL": {}, "SG": {},
-		"SX": {}, "SK": {}, "SI": {}, "SB": {}, "SO": {},
-		"ZA": {}, "GS": {}, "SS": {}, "ES": {}, "LK": {},
-		"SD": {}, "SR": {}, "SJ": {}, "SZ": {}, "SE": {},
-		"CH": {}, "SY": {}, "TW": {}, "TJ": {}, "TZ": {},
-		"TH": {}, "TL": {}, "TG": {}, "TK": {}, "TO": {},
-		"TT": {}, "TN": {}, "TR": {}, "TM": {}, "TC": {},
-		"TV": {}, "UG": {}, "UA": {}, "AE": {}, "GB": {},
-		"US": {}, "UM": {}, "UY": {}, "UZ": {}, "VU": {},
-		"VE": {}, "VN": {}, "VG": {}, "VI": {}, "WF": {},
-		"EH": {}, "YE": {}, "ZM": {}, "ZW": {}, "XK": {},
-	}
-	if _, ok := %s[%s]; !ok {
-		return fmt.Errorf("field %s must be a valid ISO 3166-1 alpha-2 country code")
-	}`, mapVar, mapVar, fieldRef, field.Name), nil
-}
-
-// DateTimeRule validates that a string field matches a Go time format
-type DateTimeRule struct {
-	Format string
+	fieldRef := ctx.FieldExpr(field).Value()
+
+	return renderCheck(ctx, fmt.Sprintf("!(%s >= -%d && %s <= %d)", fieldRef, limit, fieldRef, limit), fmt.Sprintf("field %s must be a valid %s", field.Name, r.Name()))
 }
 
-func (r *DateTimeRule) Name() string { return "datetime" }
+// sliceUnit names what the length of a slice counts in error messages
+func sliceUnit(t TypeInfo) string {
+	if t.IsBytes() {
+		return "bytes"
+	}
+	return "elements"
+}
 
-func (r *DateTimeRule) Validate(fieldType TypeInfo) error {
-	// Handle pointer to string or custom string type
+// validateStringType checks that a field is a string, a []byte or a pointer to one for
+// string-only rules
+func validateStringType(fieldType TypeInfo, ruleName string) error {
 	if fieldType.IsPointer && fieldType.Elem != nil {
-		// Check if element is string or string-based
-		if fieldType.Elem.Kind == TypeString || fieldType.Elem.Kind == TypeUnknown {
-			return nil
-		}
-		return fmt.Errorf("datetime validation only applicable to string types")
+		fieldType = *fieldType.Elem
 	}
 
-	// Handle direct string or custom string types (which have Kind == TypeString after resolution)
-	if fieldType.Kind != TypeString && fieldType.Kind != TypeUnknown {
-		return fmt.Errorf("datetime validation only applicable to string types")
+	if fieldType.Kind != TypeString && !fieldType.IsBytes() {
+		return fmt.Errorf("%s validation only applicable to string types", ruleName)
 	}
 	return nil
 }
 
-func (r *DateTimeRule) Generate(ctx *CodeGenContext, field *FieldInfo) (string, error) {
-	typeInfo := ResolveTypeInfo(field.Type, ctx.TypesInfo)
+// stringFieldRef builds a string-typed expression for a string or *string field.
+// Pointers are dereferenced and custom string types are converted to string.
+func stringFieldRef(ctx *CodeGenContext, field *FieldInfo, ruleName string) (string, error) {
+	return ctx.FieldExpr(field).StringValue(ruleName)
+}
 
-	// Check if this is a valid type for datetime validation
-	// Valid types: string, custom string types (TypeUnknown with string underlying), pointer to either
-	isValidType := typeInfo.Kind == TypeString || typeInfo.Kind == TypeUnknown
-	if typeInfo.IsPointer && typeInfo.Elem != nil {
-		isValidType = typeInfo.Elem.Kind == TypeString || typeInfo.Elem.Kind == TypeUnknown
+// structFieldType returns the type expression of the named field declared directly in
+// the struct, whether or not it has validation tags, or nil if there is no such field
+func structFieldType(s *StructInfo, name string) ast.Expr {
+	if s.TypeSpec == nil {
+		return nil
 	}
-
-	if !isValidType {
-		return "", fmt.Errorf("datetime validation only applicable to string types")
+	structType, ok := s.TypeSpec.Type.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return nil
 	}
-
-	receiverVar := strings.ToLower(string(ctx.Struct.Name[0]))
-
-	// Add time package import
-	ctx.AddImport("time", "time")
-
-	fieldRef := fmt.Sprintf("%s.%s", receiverVar, field.Name)
-
-	if typeInfo.IsPointer {
-		// For pointer to string or custom string type
-		if typeInfo.Elem != nil {
-			// Check if the element is a custom string type
-			elemNeedsCast := typeInfo.Elem.Name != "" && typeInfo.Elem.Name != "string"
-			if elemNeedsCast {
-				fieldRef = fmt.Sprintf("string(*%s)", fieldRef)
-			} else {
-				fieldRef = fmt.Sprintf("*%s", fieldRef)
+	for _, f := range structType.Fields.List {
+		for _, ident := range f.Names {
+			if ident.Name == name {
+				return f.Type
 			}
-		} else {
-			fieldRef = fmt.Sprintf("*%s", fieldRef)
-		}
-	} else {
-		// For non-pointer types, check if it's a custom string type
-		needsCast := typeInfo.Name != "" && typeInfo.Name != "string"
-		if needsCast {
-			fieldRef = fmt.Sprintf("string(%s)", fieldRef)
 		}
 	}
-
-	return fmt.Sprintf(`	if _, err := time.Parse("%s", %s); err != nil {
-		return fmt.Errorf("field %s must be a valid datetime in format %s: %%w", err)
-	}`, r.Format, fieldRef, field.Name, r.Format), nil
+	return nil
 }
 
 // UnknownRule represents an unknown validation tag
@@ -1456,19 +3143,50 @@ func GetNonOmitEmptyRules(rules []ValidationRule) []ValidationRule {
 	return result
 }
 
-// ValidateUniqueFieldType validates that the field used in unique validation is a string
-func ValidateUniqueFieldType(sliceElemType TypeInfo, fieldName string) error {
-	if fieldName == "" {
-		// Scalar slice - no field to check
-		return nil
+// parseNumericBound parses a numeric tag parameter such as the N in max=N.
+// Any Go number literal is accepted, so bounds may be written in scientific
+// (1e6) or underscore (1_000_000) notation.
+func parseNumericBound(s string) (constant.Value, error) {
+	lit := strings.TrimLeft(s, "+-")
+	if lit == "" {
+		return nil, fmt.Errorf("missing numeric value")
 	}
 
-	// For now, we'll assume string type - full type checking would require AST inspection
-	// This will be caught at compile time if wrong
-	return nil
+	v := constant.MakeFromLiteral(lit, token.INT, 0)
+	if v.Kind() == constant.Unknown {
+		v = constant.MakeFromLiteral(lit, token.FLOAT, 0)
+	}
+	if v.Kind() == constant.Unknown || len(s)-len(lit) > 1 {
+		return nil, fmt.Errorf("invalid numeric value %q", s)
+	}
+
+	if strings.HasPrefix(s, "-") {
+		v = constant.UnaryOp(token.SUB, v, 0)
+	}
+	return v, nil
 }
 
-// Helper function to parse numeric value from string
-func parseNumeric(s string) (float64, error) {
-	return strconv.ParseFloat(s, 64)
+// formatNumericBound normalizes a numeric tag parameter into a plain Go literal.
+// Integer contexts (integer fields, lengths) require a whole number and get a
+// decimal integer; float contexts keep whole numbers as integers and format
+// everything else in the shortest float representation. Fractions too small for a
+// float64 are rejected rather than rounded to zero.
+func formatNumericBound(s string, integer bool) (string, error) {
+	v, err := parseNumericBound(s)
+	if err != nil {
+		return "", err
+	}
+
+	if iv := constant.ToInt(v); iv.Kind() == constant.Int {
+		return iv.ExactString(), nil
+	}
+	if integer {
+		return "", fmt.Errorf("value %q is not an integer", s)
+	}
+
+	f, _ := constant.Float64Val(v)
+	if f == 0 {
+		return "", fmt.Errorf("value %q underflows float64", s)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
 }
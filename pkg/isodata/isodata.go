@@ -0,0 +1,25 @@
+// Package isodata holds the ISO 3166-1 country, ISO 4217 currency and ISO 639
+// language datasets used by the generator and the runtime package. The tables in
+// countries.go and currencies.go are generated by "houp data update" from the CSV
+// sources below; do not edit them by hand.
+package isodata
+
+// Default CSV sources for "houp data update"
+const (
+	CountriesSource  = "https://raw.githubusercontent.com/datasets/country-codes/main/data/country-codes.csv"
+	CurrenciesSource = "https://raw.githubusercontent.com/datasets/currency-codes/main/data/codes-all.csv"
+)
+
+// Country is a single ISO 3166-1 entry
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string // empty for user-assigned codes without a numeric code
+}
+
+// userAssignedCountries are widely used codes that are not part of ISO 3166-1
+// proper and therefore missing from the upstream CSV. They are appended by
+// ParseCountriesCSV when absent.
+var userAssignedCountries = []Country{
+	{"XK", "XKX", ""}, // Kosovo
+}
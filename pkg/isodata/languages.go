@@ -0,0 +1,501 @@
+package isodata
+
+// Language is a single ISO 639-2 entry. It is the one source of language data
+// for the iso639_1 and iso639_2 rules.
+type Language struct {
+	Alpha3B string // bibliographic code
+	Alpha3T string // terminology code, empty when it equals Alpha3B
+	Alpha2  string // ISO 639-1 code, empty when the language has none
+}
+
+// Languages lists the ISO 639-2 languages in the order codes are emitted in
+// generated code. The reserved local-use range qaa-qtz is not included. Unlike
+// the country and currency tables it is maintained by hand.
+var Languages = []Language{
+	{"aar", "", "aa"},
+	{"abk", "", "ab"},
+	{"ace", "", ""},
+	{"ach", "", ""},
+	{"ada", "", ""},
+	{"ady", "", ""},
+	{"afa", "", ""},
+	{"afh", "", ""},
+	{"afr", "", "af"},
+	{"ain", "", ""},
+	{"aka", "", "ak"},
+	{"akk", "", ""},
+	{"alb", "sqi", "sq"},
+	{"ale", "", ""},
+	{"alg", "", ""},
+	{"alt", "", ""},
+	{"amh", "", "am"},
+	{"ang", "", ""},
+	{"anp", "", ""},
+	{"apa", "", ""},
+	{"ara", "", "ar"},
+	{"arc", "", ""},
+	{"arg", "", "an"},
+	{"arm", "hye", "hy"},
+	{"arn", "", ""},
+	{"arp", "", ""},
+	{"art", "", ""},
+	{"arw", "", ""},
+	{"asm", "", "as"},
+	{"ast", "", ""},
+	{"ath", "", ""},
+	{"aus", "", ""},
+	{"ava", "", "av"},
+	{"ave", "", "ae"},
+	{"awa", "", ""},
+	{"aym", "", "ay"},
+	{"aze", "", "az"},
+	{"bad", "", ""},
+	{"bai", "", ""},
+	{"bak", "", "ba"},
+	{"bal", "", ""},
+	{"bam", "", "bm"},
+	{"ban", "", ""},
+	{"baq", "eus", "eu"},
+	{"bas", "", ""},
+	{"bat", "", ""},
+	{"bej", "", ""},
+	{"bel", "", "be"},
+	{"bem", "", ""},
+	{"ben", "", "bn"},
+	{"ber", "", ""},
+	{"bho", "", ""},
+	{"bih", "", ""},
+	{"bik", "", ""},
+	{"bin", "", ""},
+	{"bis", "", "bi"},
+	{"bla", "", ""},
+	{"bnt", "", ""},
+	{"tib", "bod", "bo"},
+	{"bos", "", "bs"},
+	{"bra", "", ""},
+	{"bre", "", "br"},
+	{"btk", "", ""},
+	{"bua", "", ""},
+	{"bug", "", ""},
+	{"bul", "", "bg"},
+	{"bur", "mya", "my"},
+	{"byn", "", ""},
+	{"cad", "", ""},
+	{"cai", "", ""},
+	{"car", "", ""},
+	{"cat", "", "ca"},
+	{"cau", "", ""},
+	{"ceb", "", ""},
+	{"cel", "", ""},
+	{"cze", "ces", "cs"},
+	{"cha", "", "ch"},
+	{"chb", "", ""},
+	{"che", "", "ce"},
+	{"chg", "", ""},
+	{"chi", "zho", "zh"},
+	{"chk", "", ""},
+	{"chm", "", ""},
+	{"chn", "", ""},
+	{"cho", "", ""},
+	{"chp", "", ""},
+	{"chr", "", ""},
+	{"chu", "", "cu"},
+	{"chv", "", "cv"},
+	{"chy", "", ""},
+	{"cmc", "", ""},
+	{"cnr", "", ""},
+	{"cop", "", ""},
+	{"cor", "", "kw"},
+	{"cos", "", "co"},
+	{"cpe", "", ""},
+	{"cpf", "", ""},
+	{"cpp", "", ""},
+	{"cre", "", "cr"},
+	{"crh", "", ""},
+	{"crp", "", ""},
+	{"csb", "", ""},
+	{"cus", "", ""},
+	{"wel", "cym", "cy"},
+	{"dak", "", ""},
+	{"dan", "", "da"},
+	{"dar", "", ""},
+	{"day", "", ""},
+	{"del", "", ""},
+	{"den", "", ""},
+	{"ger", "deu", "de"},
+	{"dgr", "", ""},
+	{"din", "", ""},
+	{"div", "", "dv"},
+	{"doi", "", ""},
+	{"dra", "", ""},
+	{"dsb", "", ""},
+	{"dua", "", ""},
+	{"dum", "", ""},
+	{"dut", "nld", "nl"},
+	{"dyu", "", ""},
+	{"dzo", "", "dz"},
+	{"efi", "", ""},
+	{"egy", "", ""},
+	{"eka", "", ""},
+	{"gre", "ell", "el"},
+	{"elx", "", ""},
+	{"eng", "", "en"},
+	{"enm", "", ""},
+	{"epo", "", "eo"},
+	{"est", "", "et"},
+	{"ewe", "", "ee"},
+	{"ewo", "", ""},
+	{"fan", "", ""},
+	{"fao", "", "fo"},
+	{"per", "fas", "fa"},
+	{"fat", "", ""},
+	{"fij", "", "fj"},
+	{"fil", "", ""},
+	{"fin", "", "fi"},
+	{"fiu", "", ""},
+	{"fon", "", ""},
+	{"fre", "fra", "fr"},
+	{"frm", "", ""},
+	{"fro", "", ""},
+	{"frr", "", ""},
+	{"frs", "", ""},
+	{"fry", "", "fy"},
+	{"ful", "", "ff"},
+	{"fur", "", ""},
+	{"gaa", "", ""},
+	{"gay", "", ""},
+	{"gba", "", ""},
+	{"gem", "", ""},
+	{"geo", "kat", "ka"},
+	{"gez", "", ""},
+	{"gil", "", ""},
+	{"gla", "", "gd"},
+	{"gle", "", "ga"},
+	{"glg", "", "gl"},
+	{"glv", "", "gv"},
+	{"gmh", "", ""},
+	{"goh", "", ""},
+	{"gon", "", ""},
+	{"gor", "", ""},
+	{"got", "", ""},
+	{"grb", "", ""},
+	{"grc", "", ""},
+	{"grn", "", "gn"},
+	{"gsw", "", ""},
+	{"guj", "", "gu"},
+	{"gwi", "", ""},
+	{"hai", "", ""},
+	{"hat", "", "ht"},
+	{"hau", "", "ha"},
+	{"haw", "", ""},
+	{"heb", "", "he"},
+	{"her", "", "hz"},
+	{"hil", "", ""},
+	{"him", "", ""},
+	{"hin", "", "hi"},
+	{"hit", "", ""},
+	{"hmn", "", ""},
+	{"hmo", "", "ho"},
+	{"hrv", "", "hr"},
+	{"hsb", "", ""},
+	{"hun", "", "hu"},
+	{"hup", "", ""},
+	{"iba", "", ""},
+	{"ibo", "", "ig"},
+	{"ice", "isl", "is"},
+	{"ido", "", "io"},
+	{"iii", "", "ii"},
+	{"ijo", "", ""},
+	{"iku", "", "iu"},
+	{"ile", "", "ie"},
+	{"ilo", "", ""},
+	{"ina", "", "ia"},
+	{"inc", "", ""},
+	{"ind", "", "id"},
+	{"ine", "", ""},
+	{"inh", "", ""},
+	{"ipk", "", "ik"},
+	{"ira", "", ""},
+	{"iro", "", ""},
+	{"ita", "", "it"},
+	{"jav", "", "jv"},
+	{"jbo", "", ""},
+	{"jpn", "", "ja"},
+	{"jpr", "", ""},
+	{"jrb", "", ""},
+	{"kaa", "", ""},
+	{"kab", "", ""},
+	{"kac", "", ""},
+	{"kal", "", "kl"},
+	{"kam", "", ""},
+	{"kan", "", "kn"},
+	{"kar", "", ""},
+	{"kas", "", "ks"},
+	{"kau", "", "kr"},
+	{"kaw", "", ""},
+	{"kaz", "", "kk"},
+	{"kbd", "", ""},
+	{"kha", "", ""},
+	{"khi", "", ""},
+	{"khm", "", "km"},
+	{"kho", "", ""},
+	{"kik", "", "ki"},
+	{"kin", "", "rw"},
+	{"kir", "", "ky"},
+	{"kmb", "", ""},
+	{"kok", "", ""},
+	{"kom", "", "kv"},
+	{"kon", "", "kg"},
+	{"kor", "", "ko"},
+	{"kos", "", ""},
+	{"kpe", "", ""},
+	{"krc", "", ""},
+	{"krl", "", ""},
+	{"kro", "", ""},
+	{"kru", "", ""},
+	{"kua", "", "kj"},
+	{"kum", "", ""},
+	{"kur", "", "ku"},
+	{"kut", "", ""},
+	{"lad", "", ""},
+	{"lah", "", ""},
+	{"lam", "", ""},
+	{"lao", "", "lo"},
+	{"lat", "", "la"},
+	{"lav", "", "lv"},
+	{"lez", "", ""},
+	{"lim", "", "li"},
+	{"lin", "", "ln"},
+	{"lit", "", "lt"},
+	{"lol", "", ""},
+	{"loz", "", ""},
+	{"ltz", "", "lb"},
+	{"lua", "", ""},
+	{"lub", "", "lu"},
+	{"lug", "", "lg"},
+	{"lui", "", ""},
+	{"lun", "", ""},
+	{"luo", "", ""},
+	{"lus", "", ""},
+	{"mac", "mkd", "mk"},
+	{"mad", "", ""},
+	{"mag", "", ""},
+	{"mah", "", "mh"},
+	{"mai", "", ""},
+	{"mak", "", ""},
+	{"mal", "", "ml"},
+	{"man", "", ""},
+	{"mao", "mri", "mi"},
+	{"map", "", ""},
+	{"mar", "", "mr"},
+	{"mas", "", ""},
+	{"may", "msa", "ms"},
+	{"mdf", "", ""},
+	{"mdr", "", ""},
+	{"men", "", ""},
+	{"mga", "", ""},
+	{"mic", "", ""},
+	{"min", "", ""},
+	{"mis", "", ""},
+	{"mkh", "", ""},
+	{"mlg", "", "mg"},
+	{"mlt", "", "mt"},
+	{"mnc", "", ""},
+	{"mni", "", ""},
+	{"mno", "", ""},
+	{"moh", "", ""},
+	{"mon", "", "mn"},
+	{"mos", "", ""},
+	{"mul", "", ""},
+	{"mun", "", ""},
+	{"mus", "", ""},
+	{"mwl", "", ""},
+	{"mwr", "", ""},
+	{"myn", "", ""},
+	{"myv", "", ""},
+	{"nah", "", ""},
+	{"nai", "", ""},
+	{"nap", "", ""},
+	{"nau", "", "na"},
+	{"nav", "", "nv"},
+	{"nbl", "", "nr"},
+	{"nde", "", "nd"},
+	{"ndo", "", "ng"},
+	{"nds", "", ""},
+	{"nep", "", "ne"},
+	{"new", "", ""},
+	{"nia", "", ""},
+	{"nic", "", ""},
+	{"niu", "", ""},
+	{"nno", "", "nn"},
+	{"nob", "", "nb"},
+	{"nog", "", ""},
+	{"non", "", ""},
+	{"nor", "", "no"},
+	{"nqo", "", ""},
+	{"nso", "", ""},
+	{"nub", "", ""},
+	{"nwc", "", ""},
+	{"nya", "", "ny"},
+	{"nym", "", ""},
+	{"nyn", "", ""},
+	{"nyo", "", ""},
+	{"nzi", "", ""},
+	{"oci", "", "oc"},
+	{"oji", "", "oj"},
+	{"ori", "", "or"},
+	{"orm", "", "om"},
+	{"osa", "", ""},
+	{"oss", "", "os"},
+	{"ota", "", ""},
+	{"oto", "", ""},
+	{"paa", "", ""},
+	{"pag", "", ""},
+	{"pal", "", ""},
+	{"pam", "", ""},
+	{"pan", "", "pa"},
+	{"pap", "", ""},
+	{"pau", "", ""},
+	{"peo", "", ""},
+	{"phi", "", ""},
+	{"phn", "", ""},
+	{"pli", "", "pi"},
+	{"pol", "", "pl"},
+	{"pon", "", ""},
+	{"por", "", "pt"},
+	{"pra", "", ""},
+	{"pro", "", ""},
+	{"pus", "", "ps"},
+	{"que", "", "qu"},
+	{"raj", "", ""},
+	{"rap", "", ""},
+	{"rar", "", ""},
+	{"roa", "", ""},
+	{"roh", "", "rm"},
+	{"rom", "", ""},
+	{"rum", "ron", "ro"},
+	{"run", "", "rn"},
+	{"rup", "", ""},
+	{"rus", "", "ru"},
+	{"sad", "", ""},
+	{"sag", "", "sg"},
+	{"sah", "", ""},
+	{"sai", "", ""},
+	{"sal", "", ""},
+	{"sam", "", ""},
+	{"san", "", "sa"},
+	{"sas", "", ""},
+	{"sat", "", ""},
+	{"scn", "", ""},
+	{"sco", "", ""},
+	{"sel", "", ""},
+	{"sem", "", ""},
+	{"sga", "", ""},
+	{"sgn", "", ""},
+	{"shn", "", ""},
+	{"sid", "", ""},
+	{"sin", "", "si"},
+	{"sio", "", ""},
+	{"sit", "", ""},
+	{"sla", "", ""},
+	{"slo", "slk", "sk"},
+	{"slv", "", "sl"},
+	{"sma", "", ""},
+	{"sme", "", "se"},
+	{"smi", "", ""},
+	{"smj", "", ""},
+	{"smn", "", ""},
+	{"smo", "", "sm"},
+	{"sms", "", ""},
+	{"sna", "", "sn"},
+	{"snd", "", "sd"},
+	{"snk", "", ""},
+	{"sog", "", ""},
+	{"som", "", "so"},
+	{"son", "", ""},
+	{"sot", "", "st"},
+	{"spa", "", "es"},
+	{"srd", "", "sc"},
+	{"srn", "", ""},
+	{"srp", "", "sr"},
+	{"srr", "", ""},
+	{"ssa", "", ""},
+	{"ssw", "", "ss"},
+	{"suk", "", ""},
+	{"sun", "", "su"},
+	{"sus", "", ""},
+	{"sux", "", ""},
+	{"swa", "", "sw"},
+	{"swe", "", "sv"},
+	{"syc", "", ""},
+	{"syr", "", ""},
+	{"tah", "", "ty"},
+	{"tai", "", ""},
+	{"tam", "", "ta"},
+	{"tat", "", "tt"},
+	{"tel", "", "te"},
+	{"tem", "", ""},
+	{"ter", "", ""},
+	{"tet", "", ""},
+	{"tgk", "", "tg"},
+	{"tgl", "", "tl"},
+	{"tha", "", "th"},
+	{"tig", "", ""},
+	{"tir", "", "ti"},
+	{"tiv", "", ""},
+	{"tkl", "", ""},
+	{"tlh", "", ""},
+	{"tli", "", ""},
+	{"tmh", "", ""},
+	{"tog", "", ""},
+	{"ton", "", "to"},
+	{"tpi", "", ""},
+	{"tsi", "", ""},
+	{"tsn", "", "tn"},
+	{"tso", "", "ts"},
+	{"tuk", "", "tk"},
+	{"tum", "", ""},
+	{"tup", "", ""},
+	{"tur", "", "tr"},
+	{"tut", "", ""},
+	{"tvl", "", ""},
+	{"twi", "", "tw"},
+	{"tyv", "", ""},
+	{"udm", "", ""},
+	{"uga", "", ""},
+	{"uig", "", "ug"},
+	{"ukr", "", "uk"},
+	{"umb", "", ""},
+	{"und", "", ""},
+	{"urd", "", "ur"},
+	{"uzb", "", "uz"},
+	{"vai", "", ""},
+	{"ven", "", "ve"},
+	{"vie", "", "vi"},
+	{"vol", "", "vo"},
+	{"vot", "", ""},
+	{"wak", "", ""},
+	{"wal", "", ""},
+	{"war", "", ""},
+	{"was", "", ""},
+	{"wen", "", ""},
+	{"wln", "", "wa"},
+	{"wol", "", "wo"},
+	{"xal", "", ""},
+	{"xho", "", "xh"},
+	{"yao", "", ""},
+	{"yap", "", ""},
+	{"yid", "", "yi"},
+	{"yor", "", "yo"},
+	{"ypk", "", ""},
+	{"zap", "", ""},
+	{"zbl", "", ""},
+	{"zen", "", ""},
+	{"zgh", "", ""},
+	{"zha", "", "za"},
+	{"znd", "", ""},
+	{"zul", "", "zu"},
+	{"zun", "", ""},
+	{"zxx", "", ""},
+	{"zza", "", ""},
+}
@@ -0,0 +1,200 @@
+package isodata
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io"
+	"strings"
+)
+
+// CSV column headers read from the upstream sources
+const (
+	countryAlpha2Column    = "ISO3166-1-Alpha-2"
+	countryAlpha3Column    = "ISO3166-1-Alpha-3"
+	countryNumericColumn   = "ISO3166-1-numeric"
+	currencyCodeColumn     = "AlphabeticCode"
+	currencyWithdrawColumn = "WithdrawalDate"
+)
+
+// ParseCountriesCSV reads ISO 3166-1 countries from a CSV with the
+// ISO3166-1-Alpha-2, ISO3166-1-Alpha-3 and ISO3166-1-numeric columns.
+// Rows without an alpha-2 code are skipped, numeric codes are zero-padded to
+// three digits and user-assigned codes such as XK are appended when missing.
+func ParseCountriesCSV(r io.Reader) ([]Country, error) {
+	rows, cols, err := readCSV(r, countryAlpha2Column, countryAlpha3Column, countryNumericColumn)
+	if err != nil {
+		return nil, fmt.Errorf("countries: %w", err)
+	}
+
+	var countries []Country
+	seen := make(map[string]bool)
+	for i, row := range rows {
+		c := Country{
+			Alpha2:  strings.ToUpper(row[cols[0]]),
+			Alpha3:  strings.ToUpper(row[cols[1]]),
+			Numeric: row[cols[2]],
+		}
+		if c.Alpha2 == "" {
+			continue
+		}
+		if len(c.Alpha2) != 2 || len(c.Alpha3) != 3 {
+			return nil, fmt.Errorf("countries: line %d: invalid codes %q/%q", i+2, c.Alpha2, c.Alpha3)
+		}
+		if c.Numeric != "" {
+			if len(c.Numeric) > 3 || strings.Trim(c.Numeric, "0123456789") != "" {
+				return nil, fmt.Errorf("countries: line %d: invalid numeric code %q", i+2, c.Numeric)
+			}
+			c.Numeric = strings.Repeat("0", 3-len(c.Numeric)) + c.Numeric
+		}
+		if seen[c.Alpha2] {
+			return nil, fmt.Errorf("countries: line %d: duplicate code %s", i+2, c.Alpha2)
+		}
+		seen[c.Alpha2] = true
+		countries = append(countries, c)
+	}
+	if len(countries) == 0 {
+		return nil, fmt.Errorf("countries: no rows")
+	}
+
+	for _, c := range userAssignedCountries {
+		if !seen[c.Alpha2] {
+			countries = append(countries, c)
+		}
+	}
+	return countries, nil
+}
+
+// ParseCurrenciesCSV reads active ISO 4217 currency codes from a CSV with the
+// AlphabeticCode and WithdrawalDate columns (the SIX list one/list three
+// layout). A currency used by several entities is listed once, at its first
+// occurrence; withdrawn currencies are skipped.
+func ParseCurrenciesCSV(r io.Reader) ([]string, error) {
+	rows, cols, err := readCSV(r, currencyCodeColumn, currencyWithdrawColumn)
+	if err != nil {
+		return nil, fmt.Errorf("currencies: %w", err)
+	}
+
+	var codes []string
+	seen := make(map[string]bool)
+	for i, row := range rows {
+		code := strings.ToUpper(row[cols[0]])
+		if code == "" || row[cols[1]] != "" || seen[code] {
+			continue
+		}
+		if len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			return nil, fmt.Errorf("currencies: line %d: invalid code %q", i+2, code)
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("currencies: no rows")
+	}
+	return codes, nil
+}
+
+// readCSV reads all records and returns the data rows together with the
+// index of each requested column
+func readCSV(r io.Reader, columns ...string) ([][]string, []int, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("empty CSV")
+	}
+
+	header := make(map[string]int)
+	for i, name := range records[0] {
+		header[strings.TrimPrefix(strings.TrimSpace(name), "\ufeff")] = i
+	}
+	cols := make([]int, len(columns))
+	for i, name := range columns {
+		idx, ok := header[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("missing column %q", name)
+		}
+		cols[i] = idx
+	}
+
+	rows := records[1:]
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+	return rows, cols, nil
+}
+
+// CountriesVersionOf returns the version stamp of a country table
+func CountriesVersionOf(countries []Country) string {
+	entries := make([]string, len(countries))
+	for i, c := range countries {
+		entries[i] = c.Alpha2 + "," + c.Alpha3 + "," + c.Numeric
+	}
+	return dataVersion(entries)
+}
+
+// CurrenciesVersionOf returns the version stamp of a currency code list
+func CurrenciesVersionOf(codes []string) string {
+	return dataVersion(codes)
+}
+
+// dataVersion returns a stamp identifying the content of a dataset: the first
+// 12 hex digits of the SHA-256 of its entries
+func dataVersion(entries []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// RenderCountries returns the source of countries.go for the given table
+func RenderCountries(source string, countries []Country) ([]byte, error) {
+	var buf bytes.Buffer
+	writeGeneratedHeader(&buf, source)
+	fmt.Fprintf(&buf, "// CountriesVersion identifies the content of Countries\n")
+	fmt.Fprintf(&buf, "const CountriesVersion = %q\n\n", CountriesVersionOf(countries))
+	buf.WriteString("// Countries lists the ISO 3166-1 countries in the order codes are emitted\n")
+	buf.WriteString("// in generated code\n")
+	buf.WriteString("var Countries = []Country{\n")
+	for _, c := range countries {
+		fmt.Fprintf(&buf, "\t{%q, %q, %q},\n", c.Alpha2, c.Alpha3, c.Numeric)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// RenderCurrencies returns the source of currencies.go for the given codes
+func RenderCurrencies(source string, codes []string) ([]byte, error) {
+	var buf bytes.Buffer
+	writeGeneratedHeader(&buf, source)
+	fmt.Fprintf(&buf, "// CurrenciesVersion identifies the content of Currencies\n")
+	fmt.Fprintf(&buf, "const CurrenciesVersion = %q\n\n", CurrenciesVersionOf(codes))
+	buf.WriteString("// Currencies lists the active ISO 4217 currency codes in the order they are\n")
+	buf.WriteString("// emitted in generated code\n")
+	buf.WriteString("var Currencies = []string{\n")
+	for i := 0; i < len(codes); i += 10 {
+		line := codes[i:min(i+10, len(codes))]
+		buf.WriteString("\t")
+		for j, code := range line {
+			if j > 0 {
+				buf.WriteString(" ")
+			}
+			fmt.Fprintf(&buf, "%q,", code)
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+func writeGeneratedHeader(buf *bytes.Buffer, source string) {
+	buf.WriteString("// Code generated by houp data update. DO NOT EDIT.\n")
+	fmt.Fprintf(buf, "// Source: %s\n\n", source)
+	buf.WriteString("package isodata\n\n")
+}
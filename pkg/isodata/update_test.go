@@ -0,0 +1,110 @@
+package isodata
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCountriesCSV(t *testing.T) {
+	input := "\ufeffofficial_name_en,ISO3166-1-Alpha-2,ISO3166-1-Alpha-3,ISO3166-1-numeric\n" +
+		"Afghanistan,AF,AFG,4\n" +
+		"\"Bonaire, Sint Eustatius and Saba\",bq,bes,535\n" +
+		"Unassigned,,,\n" +
+		"Sark,CQ,CRQ,\n"
+
+	got, err := ParseCountriesCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseCountriesCSV() error = %v", err)
+	}
+	want := []Country{
+		{"AF", "AFG", "004"},
+		{"BQ", "BES", "535"},
+		{"CQ", "CRQ", ""},
+		{"XK", "XKX", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseCountriesCSV() = %v, want %v", got, want)
+	}
+}
+
+func TestParseCountriesCSVErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"missing column", "ISO3166-1-Alpha-2,ISO3166-1-Alpha-3\nAF,AFG\n"},
+		{"bad alpha3", "ISO3166-1-Alpha-2,ISO3166-1-Alpha-3,ISO3166-1-numeric\nAF,AF,004\n"},
+		{"bad numeric", "ISO3166-1-Alpha-2,ISO3166-1-Alpha-3,ISO3166-1-numeric\nAF,AFG,x4\n"},
+		{"duplicate", "ISO3166-1-Alpha-2,ISO3166-1-Alpha-3,ISO3166-1-numeric\nAF,AFG,004\nAF,AFG,004\n"},
+		{"no rows", "ISO3166-1-Alpha-2,ISO3166-1-Alpha-3,ISO3166-1-numeric\n"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCountriesCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseCountriesCSV() expected error")
+			}
+		})
+	}
+}
+
+func TestParseCurrenciesCSV(t *testing.T) {
+	input := "Entity,Currency,AlphabeticCode,NumericCode,MinorUnit,WithdrawalDate\n" +
+		"AFGHANISTAN,Afghani,AFN,971,2,\n" +
+		"ÅLAND ISLANDS,Euro,EUR,978,2,\n" +
+		"ANDORRA,Euro,EUR,978,2,\n" +
+		"ANTARCTICA,No universal currency,,,,\n" +
+		"YUGOSLAVIA,Yugoslavian Dinar,YUD,890,,1990-01\n" +
+		"ZIMBABWE,Zimbabwe Gold,zwg,924,2,\n"
+
+	got, err := ParseCurrenciesCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseCurrenciesCSV() error = %v", err)
+	}
+	want := []string{"AFN", "EUR", "ZWG"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseCurrenciesCSV() = %v, want %v", got, want)
+	}
+
+	if _, err := ParseCurrenciesCSV(strings.NewReader("AlphabeticCode,WithdrawalDate\nEURO,\n")); err == nil {
+		t.Error("ParseCurrenciesCSV() expected error for invalid code")
+	}
+}
+
+// TestGeneratedTables checks that countries.go and currencies.go are exactly
+// what "houp data update" would write for their contents, so hand edits that
+// skip the version stamp are caught
+func TestGeneratedTables(t *testing.T) {
+	tests := []struct {
+		file   string
+		render func() ([]byte, error)
+	}{
+		{"countries.go", func() ([]byte, error) { return RenderCountries(CountriesSource, Countries) }},
+		{"currencies.go", func() ([]byte, error) { return RenderCurrencies(CurrenciesSource, Currencies) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			want, err := tt.render()
+			if err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+			got, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s is out of date; regenerate it with houp data update", tt.file)
+			}
+		})
+	}
+
+	if CountriesVersion != CountriesVersionOf(Countries) {
+		t.Errorf("CountriesVersion = %s, want %s", CountriesVersion, CountriesVersionOf(Countries))
+	}
+	if CurrenciesVersion != CurrenciesVersionOf(Currencies) {
+		t.Errorf("CurrenciesVersion = %s, want %s", CurrenciesVersion, CurrenciesVersionOf(Currencies))
+	}
+}
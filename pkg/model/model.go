@@ -0,0 +1,55 @@
+// Package model is a read-only view of what houp parses from a Go package: the
+// structs it validates, their fields and the rules of each field. It has no
+// dependency on the generator, so documentation generators, linters and schema
+// emitters can consume it directly or as JSON from "houp model".
+package model
+
+// Version is the version of the JSON layout. It changes only when a field is
+// renamed or removed; new fields may be added without a bump.
+const Version = 1
+
+// Package is a parsed Go package
+type Package struct {
+	Version int      `json:"version"`
+	Name    string   `json:"name"`
+	PkgPath string   `json:"pkg_path"` // Go import path
+	Dir     string   `json:"dir"`      // file system path
+	Structs []Struct `json:"structs"`
+}
+
+// Struct is a struct with validation tags or struct-level validators
+type Struct struct {
+	Name       string      `json:"name"`
+	File       string      `json:"file"`                 // base name of the declaring file
+	Constraint string      `json:"constraint,omitempty"` // build constraint of the file as a //go:build expression
+	Skip       bool        `json:"skip,omitempty"`       // marked //validate:skip
+	Validators []Validator `json:"validators,omitempty"` // struct-level //validate: functions
+	Pagination string      `json:"pagination,omitempty"` // parameter of //validate:pagination=
+	Freeze     bool        `json:"freeze,omitempty"`     // marked //validate:freeze
+	Fields     []Field     `json:"fields"`
+}
+
+// Validator is a custom validation function referenced by a struct or a field
+type Validator struct {
+	ImportPath  string `json:"import_path,omitempty"` // empty for the struct's own package
+	FuncName    string `json:"func_name"`
+	WithContext bool   `json:"with_context,omitempty"` // func(context.Context, T) error
+}
+
+// Field is a struct field with a validate tag
+type Field struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`                // type as written in the source
+	JSONName string `json:"json_name,omitempty"` // name from the json tag
+	Tag      string `json:"tag"`                 // validate tag as written
+	Rules    []Rule `json:"rules"`
+}
+
+// Rule is one parsed validation rule. Params holds the rule's settings keyed by
+// snake_case name, e.g. {"value": "3"} for min=3; zero values are left out.
+// Rules nested in a rule, such as the element rules of dive, are in Rules.
+type Rule struct {
+	Name   string         `json:"name"`
+	Params map[string]any `json:"params,omitempty"`
+	Rules  []Rule         `json:"rules,omitempty"`
+}
@@ -0,0 +1,145 @@
+package houp
+
+import "errors"
+
+// Sentinel errors of the built-in rules. A *FieldError matches the one of its rule
+// with errors.Is, e.g. errors.Is(err, houp.ErrRequired), also through the dives of
+// enclosing structs.
+var (
+	ErrBase64          = errors.New("houp: base64")
+	ErrBCP47           = errors.New("houp: bcp47")
+	ErrBIC             = errors.New("houp: bic")
+	ErrBoolean         = errors.New("houp: boolean")
+	ErrCron            = errors.New("houp: cron")
+	ErrCustom          = errors.New("houp: custom")
+	ErrDataURI         = errors.New("houp: datauri")
+	ErrDatetime        = errors.New("houp: datetime")
+	ErrDuration        = errors.New("houp: duration")
+	ErrEmail           = errors.New("houp: email")
+	ErrEqField         = errors.New("houp: eqfield")
+	ErrFinite          = errors.New("houp: finite")
+	ErrFuture          = errors.New("houp: future")
+	ErrGT              = errors.New("houp: gt")
+	ErrGTE             = errors.New("houp: gte")
+	ErrGTEField        = errors.New("houp: gtefield")
+	ErrGTField         = errors.New("houp: gtfield")
+	ErrIBAN            = errors.New("houp: iban")
+	ErrISBN            = errors.New("houp: isbn")
+	ErrISBN10          = errors.New("houp: isbn10")
+	ErrISBN13          = errors.New("houp: isbn13")
+	ErrISO3166Alpha2   = errors.New("houp: iso3166_1_alpha2")
+	ErrISO3166Alpha3   = errors.New("houp: iso3166_1_alpha3")
+	ErrISO3166Numeric  = errors.New("houp: iso3166_1_numeric")
+	ErrISO4217         = errors.New("houp: iso4217")
+	ErrISO639_1        = errors.New("houp: iso639_1")
+	ErrISO639_2        = errors.New("houp: iso639_2")
+	ErrJSON            = errors.New("houp: json")
+	ErrJSONOf          = errors.New("houp: jsonof")
+	ErrLatitude        = errors.New("houp: latitude")
+	ErrLen             = errors.New("houp: len")
+	ErrLongitude       = errors.New("houp: longitude")
+	ErrLT              = errors.New("houp: lt")
+	ErrLTE             = errors.New("houp: lte")
+	ErrLTEField        = errors.New("houp: ltefield")
+	ErrLTField         = errors.New("houp: ltfield")
+	ErrMax             = errors.New("houp: max")
+	ErrMD5             = errors.New("houp: md5")
+	ErrMin             = errors.New("houp: min")
+	ErrMongoDB         = errors.New("houp: mongodb")
+	ErrNoControlChars  = errors.New("houp: no_control_chars")
+	ErrNumeric         = errors.New("houp: numeric")
+	ErrOneOf           = errors.New("houp: oneof")
+	ErrPast            = errors.New("houp: past")
+	ErrPostcode        = errors.New("houp: postcode_iso3166_alpha2")
+	ErrPrintable       = errors.New("houp: printable")
+	ErrRegexp          = errors.New("houp: regexp")
+	ErrRequired        = errors.New("houp: required")
+	ErrRequiredWithout = errors.New("houp: required_without")
+	ErrSemver          = errors.New("houp: semver")
+	ErrSHA1            = errors.New("houp: sha1")
+	ErrSHA256          = errors.New("houp: sha256")
+	ErrSHA512          = errors.New("houp: sha512")
+	ErrSubsetOf        = errors.New("houp: subsetof")
+	ErrTimezone        = errors.New("houp: timezone")
+	ErrULID            = errors.New("houp: ulid")
+	ErrUnique          = errors.New("houp: unique")
+	ErrUnixTS          = errors.New("houp: unixts")
+	ErrUUID            = errors.New("houp: uuid")
+	ErrUUID3           = errors.New("houp: uuid3")
+	ErrUUID4           = errors.New("houp: uuid4")
+	ErrUUID5           = errors.New("houp: uuid5")
+	ErrUUIDRFC4122     = errors.New("houp: uuid_rfc4122")
+)
+
+// ruleErrors maps rule names, as in FieldError.Rule, to their sentinel errors
+var ruleErrors = map[string]error{
+	"base64":                  ErrBase64,
+	"bcp47":                   ErrBCP47,
+	"bic":                     ErrBIC,
+	"boolean":                 ErrBoolean,
+	"cron":                    ErrCron,
+	"custom":                  ErrCustom,
+	"datauri":                 ErrDataURI,
+	"datetime":                ErrDatetime,
+	"duration":                ErrDuration,
+	"email":                   ErrEmail,
+	"eqfield":                 ErrEqField,
+	"finite":                  ErrFinite,
+	"future":                  ErrFuture,
+	"gt":                      ErrGT,
+	"gte":                     ErrGTE,
+	"gtefield":                ErrGTEField,
+	"gtfield":                 ErrGTField,
+	"iban":                    ErrIBAN,
+	"isbn":                    ErrISBN,
+	"isbn10":                  ErrISBN10,
+	"isbn13":                  ErrISBN13,
+	"iso3166_1_alpha2":        ErrISO3166Alpha2,
+	"iso3166_1_alpha3":        ErrISO3166Alpha3,
+	"iso3166_1_numeric":       ErrISO3166Numeric,
+	"iso4217":                 ErrISO4217,
+	"iso639_1":                ErrISO639_1,
+	"iso639_2":                ErrISO639_2,
+	"json":                    ErrJSON,
+	"jsonof":                  ErrJSONOf,
+	"latitude":                ErrLatitude,
+	"len":                     ErrLen,
+	"longitude":               ErrLongitude,
+	"lt":                      ErrLT,
+	"lte":                     ErrLTE,
+	"ltefield":                ErrLTEField,
+	"ltfield":                 ErrLTField,
+	"max":                     ErrMax,
+	"md5":                     ErrMD5,
+	"min":                     ErrMin,
+	"mongodb":                 ErrMongoDB,
+	"no_control_chars":        ErrNoControlChars,
+	"numeric":                 ErrNumeric,
+	"oneof":                   ErrOneOf,
+	"past":                    ErrPast,
+	"postcode_iso3166_alpha2": ErrPostcode,
+	"printable":               ErrPrintable,
+	"regexp":                  ErrRegexp,
+	"required":                ErrRequired,
+	"required_without":        ErrRequiredWithout,
+	"semver":                  ErrSemver,
+	"sha1":                    ErrSHA1,
+	"sha256":                  ErrSHA256,
+	"sha512":                  ErrSHA512,
+	"subsetof":                ErrSubsetOf,
+	"timezone":                ErrTimezone,
+	"ulid":                    ErrULID,
+	"unique":                  ErrUnique,
+	"unixts":                  ErrUnixTS,
+	"uuid":                    ErrUUID,
+	"uuid3":                   ErrUUID3,
+	"uuid4":                   ErrUUID4,
+	"uuid5":                   ErrUUID5,
+	"uuid_rfc4122":            ErrUUIDRFC4122,
+}
+
+// RuleError returns the sentinel error of a built-in rule, or nil for rules without
+// one, such as dive
+func RuleError(rule string) error {
+	return ruleErrors[rule]
+}
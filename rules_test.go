@@ -0,0 +1,48 @@
+package houp
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/n10ty/houp/pkg/generator"
+)
+
+func TestRuleErrorCoversRules(t *testing.T) {
+	for _, rule := range generator.SupportedRules() {
+		if rule == "dive" || rule == "omitempty" {
+			continue
+		}
+		if RuleError(rule) == nil {
+			t.Errorf("rule %s has no sentinel error", rule)
+		}
+	}
+}
+
+func TestFieldErrorIs(t *testing.T) {
+	nested := &FieldError{Struct: "Item", Field: "SKU", Rule: "required", Err: errors.New("field SKU is required")}
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{name: "own rule", err: &FieldError{Rule: "min", Err: errors.New("field Name must be at least 3 characters")}, target: ErrMin, want: true},
+		{name: "other rule", err: &FieldError{Rule: "min", Err: errors.New("field Name must be at least 3 characters")}, target: ErrMax},
+		{name: "rule without sentinel", err: &FieldError{Rule: "dive", Err: errors.New("field Items[0] validation failed")}, target: ErrRequired},
+		{
+			name:   "through a dive",
+			err:    &FieldError{Rule: "dive", Err: fmt.Errorf("field Items[%d] validation failed: %w", 0, nested)},
+			target: ErrRequired,
+			want:   true,
+		},
+		{name: "joined", err: errors.Join(errors.New("other"), nested), target: ErrRequired, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
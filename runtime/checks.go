@@ -0,0 +1,211 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"mime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IsISBN10 reports whether s is an ISBN-10 (mod 11, optional X check digit), ignoring hyphens and spaces
+func IsISBN10(s string) bool {
+	sum, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '-' || c == ' ':
+			continue
+		case c >= '0' && c <= '9':
+			sum += int(c-'0') * (10 - n)
+		case (c == 'X' || c == 'x') && n == 9:
+			sum += 10
+		default:
+			return false
+		}
+		n++
+		if n > 10 {
+			return false
+		}
+	}
+	return n == 10 && sum%11 == 0
+}
+
+// IsISBN13 reports whether s is an ISBN-13 (alternating 1/3 weights, mod 10), ignoring hyphens and spaces
+func IsISBN13(s string) bool {
+	sum, n := 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '-' || c == ' ' {
+			continue
+		}
+		if c < '0' || c > '9' {
+			return false
+		}
+		if n%2 == 0 {
+			sum += int(c - '0')
+		} else {
+			sum += int(c-'0') * 3
+		}
+		n++
+		if n > 13 {
+			return false
+		}
+	}
+	return n == 13 && sum%10 == 0
+}
+
+// IsCron reports whether s is a cron expression of five fields, six with a leading
+// seconds field, or a descriptor such as @daily
+func IsCron(s string) bool {
+	switch s {
+	case "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly":
+		return true
+	}
+
+	type bounds struct {
+		min, max int
+		names    string // three-letter names of min, min+1, ...
+	}
+	specs := []bounds{{0, 59, ""}, {0, 23, ""}, {1, 31, ""}, {1, 12, "JANFEBMARAPRMAYJUNJULAUGSEPOCTNOVDEC"}, {0, 7, "SUNMONTUEWEDTHUFRISAT"}}
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 5:
+	case 6:
+		specs = append([]bounds{{0, 59, ""}}, specs...)
+	default:
+		return false
+	}
+
+	value := func(v string, b bounds) (int, bool) {
+		if v != "" && len(v) <= 2 && strings.Trim(v, "0123456789") == "" {
+			n := 0
+			for i := 0; i < len(v); i++ {
+				n = n*10 + int(v[i]-'0')
+			}
+			return n, n >= b.min && n <= b.max
+		}
+		for i := 0; len(v) == 3 && i+3 <= len(b.names); i += 3 {
+			if strings.EqualFold(v, b.names[i:i+3]) {
+				return b.min + i/3, true
+			}
+		}
+		return 0, false
+	}
+
+	for i, field := range fields {
+		b := specs[i]
+		for _, item := range strings.Split(field, ",") {
+			rng, step, hasStep := strings.Cut(item, "/")
+			if hasStep {
+				if _, ok := value(step, bounds{1, b.max, ""}); !ok {
+					return false
+				}
+			}
+			if rng == "*" || (rng == "?" && (i == len(specs)-3 || i == len(specs)-1)) {
+				continue
+			}
+			lo, hi, isRange := strings.Cut(rng, "-")
+			start, ok := value(lo, b)
+			if !ok {
+				return false
+			}
+			if isRange {
+				if end, endOK := value(hi, b); !endOK || end < start {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// IsIBAN reports whether s is an IBAN with a valid ISO 7064 mod 97-10 checksum,
+// ignoring spaces
+func IsIBAN(s string) bool {
+	var buf [34]byte
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == ' ' {
+			continue
+		}
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+		if n == len(buf) {
+			return false
+		}
+		buf[n] = c
+		n++
+	}
+	if n < 15 {
+		return false
+	}
+	if buf[0] < 'A' || buf[1] < 'A' || buf[2] > '9' || buf[3] > '9' {
+		return false
+	}
+	rem := 0
+	for i := 0; i < n; i++ {
+		c := buf[(i+4)%n]
+		if c >= 'A' {
+			rem = (rem*100 + int(c-'A'+10)) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem == 1
+}
+
+// IsPrintable reports whether s is valid UTF-8 made of unicode.IsPrint runes
+func IsPrintable(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasNoControlChars reports whether s is valid UTF-8 without unicode.IsControl runes
+func HasNoControlChars(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDataURI reports whether s is a data URI with a base64 payload
+func IsDataURI(s string) bool {
+	rest, ok := strings.CutPrefix(s, "data:")
+	if !ok {
+		return false
+	}
+	meta, payload, ok := strings.Cut(rest, ",")
+	if !ok {
+		return false
+	}
+	meta, ok = strings.CutSuffix(meta, ";base64")
+	if !ok {
+		return false
+	}
+	if meta != "" {
+		if strings.HasPrefix(meta, ";") {
+			meta = "text/plain" + meta
+		}
+		mediaType, _, err := mime.ParseMediaType(meta)
+		if err != nil || !strings.Contains(mediaType, "/") {
+			return false
+		}
+	}
+	_, err := base64.StdEncoding.DecodeString(payload)
+	return err == nil
+}
@@ -0,0 +1,72 @@
+// Package runtime holds the checks that code generated by houp --runtime calls
+// instead of declaring its own copy in every generated file: the code tables of
+// the ISO rules, the regular expressions of pattern rules and the helper functions
+// of rules such as iban and cron. Its exported names are part of the generated
+// code, so they only change together with the generator.
+package runtime
+
+import (
+	"regexp"
+
+	"github.com/n10ty/houp/pkg/isodata"
+)
+
+// Regular expressions of the rules with a fixed pattern
+var (
+	EmailRegexp  = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	ULIDRegexp   = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	BICRegexp    = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`)
+	SemverRegexp = regexp.MustCompile(`^(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)` +
+		`(?:-(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?` +
+		`(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?$`)
+	BCP47Regexp = regexp.MustCompile(`^(?i:` +
+		`(?:[a-z]{2,3}(?:-[a-z]{3}){0,3}|[a-z]{4,8})` +
+		`(?:-[a-z]{4})?` +
+		`(?:-(?:[a-z]{2}|[0-9]{3}))?` +
+		`(?:-(?:[a-z0-9]{5,8}|[0-9][a-z0-9]{3}))*` +
+		`(?:-[0-9a-wyz](?:-[a-z0-9]{2,8})+)*` +
+		`(?:-x(?:-[a-z0-9]{1,8})+)?` +
+		`|x(?:-[a-z0-9]{1,8})+` +
+		`|en-GB-oed|i-(?:ami|bnn|default|enochian|hak|klingon|lux|mingo|navajo|pwn|tao|tay|tsu)|sgn-(?:BE-FR|BE-NL|CH-DE)` +
+		`)$`)
+)
+
+// Code tables of the ISO rules, built from package isodata. Generated code only
+// reads them.
+var (
+	ISO4217Codes        = codeTable(isodata.Currencies)
+	ISO3166Alpha2Codes  = countryCodes(func(c isodata.Country) string { return c.Alpha2 })
+	ISO3166Alpha3Codes  = countryCodes(func(c isodata.Country) string { return c.Alpha3 })
+	ISO3166NumericCodes = countryCodes(func(c isodata.Country) string { return c.Numeric })
+	ISO6391Codes        = languageCodes(func(l isodata.Language) []string { return []string{l.Alpha2} })
+	ISO6392Codes        = languageCodes(func(l isodata.Language) []string { return []string{l.Alpha3B, l.Alpha3T} })
+)
+
+// codeTable returns the set of the non-empty codes
+func codeTable(codes []string) map[string]struct{} {
+	table := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		if code != "" {
+			table[code] = struct{}{}
+		}
+	}
+	return table
+}
+
+// countryCodes returns the set of the codes selected by pick
+func countryCodes(pick func(isodata.Country) string) map[string]struct{} {
+	codes := make([]string, 0, len(isodata.Countries))
+	for _, c := range isodata.Countries {
+		codes = append(codes, pick(c))
+	}
+	return codeTable(codes)
+}
+
+// languageCodes returns the set of the codes selected by pick
+func languageCodes(pick func(isodata.Language) []string) map[string]struct{} {
+	var codes []string
+	for _, l := range isodata.Languages {
+		codes = append(codes, pick(l)...)
+	}
+	return codeTable(codes)
+}
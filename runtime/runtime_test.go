@@ -0,0 +1,65 @@
+package runtime
+
+import "testing"
+
+func TestChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(string) bool
+		value string
+		want  bool
+	}{
+		{"isbn10", IsISBN10, "0-306-40615-2", true},
+		{"isbn10 checksum", IsISBN10, "0-306-40615-3", false},
+		{"isbn13", IsISBN13, "978-0-306-40615-7", true},
+		{"isbn13 checksum", IsISBN13, "978-0-306-40615-8", false},
+		{"cron", IsCron, "0 9 * * MON-FRI", true},
+		{"cron descriptor", IsCron, "@daily", true},
+		{"cron range", IsCron, "0 24 * * *", false},
+		{"iban", IsIBAN, "GB82 WEST 1234 5698 7654 32", true},
+		{"iban checksum", IsIBAN, "GB83 WEST 1234 5698 7654 32", false},
+		{"printable", IsPrintable, "héllo wörld", true},
+		{"printable newline", IsPrintable, "a\nb", false},
+		{"no control chars", HasNoControlChars, "tab\there", false},
+		{"data uri", IsDataURI, "data:image/png;base64,iVBORw0KGgo=", true},
+		{"data uri without base64", IsDataURI, "data:text/plain,hello", false},
+		{"email", EmailRegexp.MatchString, "ann@example.com", true},
+		{"bic", BICRegexp.MatchString, "DEUTDEFF500", true},
+		{"semver", SemverRegexp.MatchString, "1.4.0-rc.1+build.5", true},
+		{"bcp47", BCP47Regexp.MatchString, "zh-Hant-TW", true},
+		{"ulid", ULIDRegexp.MatchString, "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.check(tt.value); got != tt.want {
+				t.Errorf("check(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTables(t *testing.T) {
+	tests := []struct {
+		name  string
+		table map[string]struct{}
+		code  string
+		want  bool
+	}{
+		{"currency", ISO4217Codes, "EUR", true},
+		{"currency lowercase", ISO4217Codes, "eur", false},
+		{"alpha-2", ISO3166Alpha2Codes, "DE", true},
+		{"alpha-3", ISO3166Alpha3Codes, "DEU", true},
+		{"numeric", ISO3166NumericCodes, "276", true},
+		{"numeric of user-assigned code", ISO3166NumericCodes, "", false},
+		{"iso639-1", ISO6391Codes, "de", true},
+		{"iso639-2 bibliographic", ISO6392Codes, "ger", true},
+		{"iso639-2 terminology", ISO6392Codes, "deu", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := tt.table[tt.code]; got != tt.want {
+				t.Errorf("table has %q = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
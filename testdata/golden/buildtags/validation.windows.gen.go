@@ -0,0 +1,32 @@
+// Code generated by houp. DO NOT EDIT.
+
+//go:build windows
+
+package buildtags
+
+import (
+	"fmt"
+)
+
+// Validate checks the fields of Mount against their validate tags:
+//   - Drive: required, min=2, max=2
+//   - Share: required
+//
+// It returns the error of the first check that fails.
+func (m *Mount) Validate() error {
+	// Drive: required,min=2,max=2
+	if m.Drive == "" {
+		return fmt.Errorf("field Drive is required")
+	}
+	if len(m.Drive) < 2 {
+		return fmt.Errorf("field Drive must be at least 2 characters")
+	}
+	if len(m.Drive) > 2 {
+		return fmt.Errorf("field Drive must be at most 2 characters")
+	}
+	// Share: required
+	if m.Share == "" {
+		return fmt.Errorf("field Share is required")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Code generated by houp. DO NOT EDIT.
+
+package include_tests
+
+import (
+	"fmt"
+)
+
+// Validate checks the fields of orderRequest against their validate tags:
+//   - Email: required, email
+//   - Coupons: max=3, dive, min=4
+//
+// It returns the error of the first check that fails.
+func (o *orderRequest) Validate() error {
+	// Email: required,email
+	if o.Email == "" {
+		return fmt.Errorf("field Email is required")
+	}
+	if !pkg_emailRegexp_952c0aba.MatchString(o.Email) {
+		return fmt.Errorf("field Email must be a valid email address")
+	}
+	// Coupons: max=3,dive,min=4
+	if len(o.Coupons) > 3 {
+		return fmt.Errorf("field Coupons must have at most 3 elements")
+	}
+	for i, elem := range o.Coupons {
+		if len(elem) < 4 {
+			return fmt.Errorf("field Coupons[%d] must be at least 4 characters", i)
+		}
+	}
+	return nil
+}
+
+// Validate checks the fields of orderFixture against their validate tags:
+//   - Name: required
+//   - Items: dive
+//
+// It returns the error of the first check that fails.
+func (o *orderFixture) Validate() error {
+	// Name: required
+	if o.Name == "" {
+		return fmt.Errorf("field Name is required")
+	}
+	// Items: dive
+	for i := range o.Items {
+		if o.Items[i] == nil {
+			continue
+		}
+		if err := o.Items[i].Validate(); err != nil {
+			return fmt.Errorf("field Items[%d] validation failed: %w", i, err)
+		}
+	}
+	return nil
+}
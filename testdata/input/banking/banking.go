@@ -0,0 +1,9 @@
+package banking
+
+// Transfer demonstrates IBAN and BIC validation
+type Transfer struct {
+	DebtorIBAN      string  `json:"debtor_iban" validate:"required,iban"`
+	CreditorIBAN    string  `json:"creditor_iban" validate:"required,iban"`
+	CreditorBIC     string  `json:"creditor_bic" validate:"bic"`
+	IntermediaryBIC *string `json:"intermediary_bic" validate:"omitempty,bic"`
+}
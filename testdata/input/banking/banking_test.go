@@ -0,0 +1,143 @@
+package banking
+
+import (
+	"testing"
+)
+
+func TestTransferValidation(t *testing.T) {
+	badBIC := "DEUTDEFF5"
+
+	tests := []struct {
+		name     string
+		transfer Transfer
+		wantErr  bool
+	}{
+		{
+			name: "valid transfer",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: false,
+		},
+		{
+			name: "shortest IBAN (Norway)",
+			transfer: Transfer{
+				DebtorIBAN:   "NO9386011117947",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: false,
+		},
+		{
+			name: "BIC with branch code",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "NEDSZAJJXXX",
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBAN with wrong check digits",
+			transfer: Transfer{
+				DebtorIBAN:   "GB81WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBAN with transposed digits",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0131 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBAN too short",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST1234",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBAN too long",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST123456987654321234567890123",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBAN without country code",
+			transfer: Transfer{
+				DebtorIBAN:   "8282WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBAN with punctuation",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82-WEST-1234-5698-7654-32",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "BIC too short",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUTDE",
+			},
+			wantErr: true,
+		},
+		{
+			name: "BIC with digits in country code",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "DEUT1EFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "BIC in lower case",
+			transfer: Transfer{
+				DebtorIBAN:   "GB82WEST12345698765432",
+				CreditorIBAN: "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:  "deutdeff",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid intermediary BIC",
+			transfer: Transfer{
+				DebtorIBAN:      "GB82WEST12345698765432",
+				CreditorIBAN:    "DE89 3704 0044 0532 0130 00",
+				CreditorBIC:     "DEUTDEFF",
+				IntermediaryBIC: &badBIC,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.transfer.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Transfer.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package bcp47
+
+// Preferences stores BCP 47 language tags
+type Preferences struct {
+	Locale    string   `json:"locale" validate:"required,bcp47"`
+	Fallback  *string  `json:"fallback" validate:"omitempty,bcp47"`
+	Supported []string `json:"supported" validate:"omitempty,dive,bcp47"`
+}
@@ -0,0 +1,46 @@
+package bcp47
+
+import "testing"
+
+func TestPreferencesValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	valid := []string{
+		"en", "en-US", "zh-Hant-TW", "sr-Latn-RS", "es-419", "de-CH-1996",
+		"en-US-u-ca-gregory", "x-private", "en-x-custom", "i-klingon", "zh-min-nan",
+	}
+	for _, tag := range valid {
+		t.Run("valid "+tag, func(t *testing.T) {
+			p := Preferences{Locale: tag}
+			if err := p.Validate(); err != nil {
+				t.Errorf("Preferences.Validate() with %q error = %v", tag, err)
+			}
+		})
+	}
+
+	invalid := []string{
+		"e", "en_US", "en-", "-en", "en--US", "toolonglanguage", "en-US-x", "zh-Hant-TW-toolongvariant",
+	}
+	for _, tag := range invalid {
+		t.Run("invalid "+tag, func(t *testing.T) {
+			p := Preferences{Locale: tag}
+			if err := p.Validate(); err == nil {
+				t.Errorf("Preferences.Validate() with %q expected error", tag)
+			}
+		})
+	}
+
+	t.Run("invalid fallback", func(t *testing.T) {
+		p := Preferences{Locale: "en", Fallback: strPtr("en US")}
+		if err := p.Validate(); err == nil {
+			t.Error("Preferences.Validate() expected error for invalid fallback")
+		}
+	})
+
+	t.Run("invalid supported element", func(t *testing.T) {
+		p := Preferences{Locale: "en", Supported: []string{"en-GB", "fr_FR"}}
+		if err := p.Validate(); err == nil {
+			t.Error("Preferences.Validate() expected error for invalid supported tag")
+		}
+	})
+}
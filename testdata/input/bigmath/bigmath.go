@@ -0,0 +1,12 @@
+package bigmath
+
+import "math/big"
+
+// Transfer holds arbitrary-precision amounts
+type Transfer struct {
+	Amount *big.Int   `validate:"required,gt=0,max=1_000_000_000_000"`
+	Fee    big.Int    `validate:"gte=0"`
+	Rate   *big.Float `validate:"required,gt=0,lt=1.5"`
+	Share  *big.Rat   `validate:"omitempty,gte=0,lte=0.25"`
+	Parts  []*big.Int `validate:"dive,required,min=1"`
+}
@@ -0,0 +1,141 @@
+package bigmath
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestTransfer_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer Transfer
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing amount",
+			transfer: Transfer{
+				Rate: big.NewFloat(0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero amount",
+			transfer: Transfer{
+				Amount: big.NewInt(0),
+				Rate:   big.NewFloat(0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "max amount",
+			transfer: Transfer{
+				Amount: big.NewInt(1_000_000_000_000),
+				Rate:   big.NewFloat(0.5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "amount too large",
+			transfer: Transfer{
+				Amount: big.NewInt(1_000_000_000_001),
+				Rate:   big.NewFloat(0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative fee",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Fee:    *big.NewInt(-1),
+				Rate:   big.NewFloat(0.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing rate",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "rate too high",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(1.5),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid share",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Share:  big.NewRat(1, 4),
+			},
+			wantErr: false,
+		},
+		{
+			name: "share too large",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Share:  big.NewRat(26, 100),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative share",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Share:  big.NewRat(-1, 3),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid parts",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Parts:  []*big.Int{big.NewInt(1), big.NewInt(2)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil part",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Parts:  []*big.Int{nil},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero part",
+			transfer: Transfer{
+				Amount: big.NewInt(100),
+				Rate:   big.NewFloat(0.5),
+				Parts:  []*big.Int{big.NewInt(0)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.transfer.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
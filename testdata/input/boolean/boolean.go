@@ -0,0 +1,8 @@
+package boolean
+
+// SearchQuery demonstrates boolean validation of loosely-typed query parameters
+type SearchQuery struct {
+	Term          string  `json:"q" validate:"required"`
+	IncludeDrafts string  `json:"include_drafts" validate:"required,boolean"`
+	Exact         *string `json:"exact" validate:"omitempty,boolean"`
+}
@@ -0,0 +1,33 @@
+package boolean
+
+import (
+	"testing"
+)
+
+func TestSearchQueryValidation(t *testing.T) {
+	yes, no := "yes", "F"
+
+	tests := []struct {
+		name    string
+		q       SearchQuery
+		wantErr bool
+	}{
+		{name: "true", q: SearchQuery{Term: "go", IncludeDrafts: "true"}},
+		{name: "numeric", q: SearchQuery{Term: "go", IncludeDrafts: "0"}},
+		{name: "upper case", q: SearchQuery{Term: "go", IncludeDrafts: "TRUE"}},
+		{name: "short form pointer", q: SearchQuery{Term: "go", IncludeDrafts: "t", Exact: &no}},
+		{name: "yes is not accepted", q: SearchQuery{Term: "go", IncludeDrafts: "yes"}, wantErr: true},
+		{name: "mixed case not accepted", q: SearchQuery{Term: "go", IncludeDrafts: "tRUE"}, wantErr: true},
+		{name: "missing", q: SearchQuery{Term: "go"}, wantErr: true},
+		{name: "invalid pointer", q: SearchQuery{Term: "go", IncludeDrafts: "1", Exact: &yes}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.q.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SearchQuery.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
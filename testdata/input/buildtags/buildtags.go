@@ -0,0 +1,9 @@
+package buildtags
+
+// Settings is built on every platform and dives into the platform-specific Mount.
+// License's uuid4 check uses the regexp declared for InstanceID.
+type Settings struct {
+	Name       string  `json:"name" validate:"required"`
+	InstanceID string  `json:"instance_id" validate:"omitempty,uuid4"`
+	Mounts     []Mount `json:"mounts" validate:"dive"`
+}
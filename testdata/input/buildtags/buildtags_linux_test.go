@@ -0,0 +1,27 @@
+package buildtags
+
+import (
+	"testing"
+)
+
+func TestSettingsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       Settings
+		wantErr bool
+	}{
+		{name: "valid", s: Settings{Name: "dev", Mounts: []Mount{{Source: "/src", Target: "/app"}}}},
+		{name: "no mounts", s: Settings{Name: "dev"}},
+		{name: "invalid mount", s: Settings{Name: "dev", Mounts: []Mount{{Source: "/src"}}}, wantErr: true},
+		{name: "missing name", s: Settings{Mounts: []Mount{{Source: "/src", Target: "/app"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Settings.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+//go:build ignore
+
+package main
+
+// Fixture is not part of the package and must not get a Validate method
+type Fixture struct {
+	Name string `validate:"required"`
+}
+
+func main() {}
@@ -0,0 +1,9 @@
+//go:build enterprise
+
+package buildtags
+
+// License is only compiled into enterprise builds
+type License struct {
+	Key   string `json:"key" validate:"required,uuid4"`
+	Seats int    `json:"seats" validate:"gte=1"`
+}
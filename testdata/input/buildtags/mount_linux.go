@@ -0,0 +1,7 @@
+package buildtags
+
+// Mount is a Linux bind mount
+type Mount struct {
+	Source string `json:"source" validate:"required,min=2"`
+	Target string `json:"target" validate:"required,min=2"`
+}
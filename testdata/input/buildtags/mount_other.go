@@ -0,0 +1,8 @@
+//go:build !linux && !windows
+
+package buildtags
+
+// Mount is an opaque mount specification on other platforms
+type Mount struct {
+	Spec string `json:"spec" validate:"required"`
+}
@@ -0,0 +1,7 @@
+package buildtags
+
+// Mount maps a drive letter to a share on Windows
+type Mount struct {
+	Drive string `json:"drive" validate:"required,min=2,max=2"`
+	Share string `json:"share" validate:"required"`
+}
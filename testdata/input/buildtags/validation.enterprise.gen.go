@@ -0,0 +1,29 @@
+// Code generated by houp. DO NOT EDIT.
+
+//go:build enterprise
+
+package buildtags
+
+import (
+	"fmt"
+)
+
+// Validate checks the fields of License against their validate tags:
+//   - Key: required, uuid4
+//   - Seats: gte=1
+//
+// It returns the error of the first check that fails.
+func (l *License) Validate() error {
+	// Key: required,uuid4
+	if l.Key == "" {
+		return fmt.Errorf("field Key is required")
+	}
+	if !pkg_uuidRegexp_e7cea092.MatchString(l.Key) {
+		return fmt.Errorf("field Key must be a valid version 4 UUID")
+	}
+	// Seats: gte=1
+	if l.Seats < 1 {
+		return fmt.Errorf("field Seats must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Code generated by houp. DO NOT EDIT.
+
+//go:build linux
+
+package buildtags
+
+import (
+	"fmt"
+)
+
+// Validate checks the fields of Mount against their validate tags:
+//   - Source: required, min=2
+//   - Target: required, min=2
+//
+// It returns the error of the first check that fails.
+func (m *Mount) Validate() error {
+	// Source: required,min=2
+	if m.Source == "" {
+		return fmt.Errorf("field Source is required")
+	}
+	if len(m.Source) < 2 {
+		return fmt.Errorf("field Source must be at least 2 characters")
+	}
+	// Target: required,min=2
+	if m.Target == "" {
+		return fmt.Errorf("field Target is required")
+	}
+	if len(m.Target) < 2 {
+		return fmt.Errorf("field Target must be at least 2 characters")
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package bytes
+
+// Upload carries binary payloads
+type Upload struct {
+	Data     []byte  `validate:"required,min=1,max=1024"`
+	Checksum []byte  `validate:"len=32"`
+	Key      []byte  `validate:"omitempty,base64"`
+	Token    []byte  `validate:"regexp=github.com/n10ty/houp/testdata/input/bytes/patterns:Token"`
+	Note     *[]byte `validate:"omitempty,max=64"`
+}
@@ -0,0 +1,136 @@
+package bytes
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUpload_Validate(t *testing.T) {
+	shortNote, longNote := []byte("hi"), make([]byte, 65)
+
+	tests := []struct {
+		name    string
+		upload  Upload
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing data",
+			upload: Upload{
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty data",
+			upload: Upload{
+				Data:     []byte{},
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "max data",
+			upload: Upload{
+				Data:     make([]byte, 1024),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "data too large",
+			upload: Upload{
+				Data:     make([]byte, 1025),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "short checksum",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 31),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid key",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Key:      []byte("c2VjcmV0"),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid key",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Key:      []byte("not base64!"),
+				Token:    []byte("ABC123"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "lower-case token",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("abc"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty token",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+			},
+			wantErr: true,
+		},
+		{
+			name: "short note",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+				Note:     &shortNote,
+			},
+			wantErr: false,
+		},
+		{
+			name: "note too long",
+			upload: Upload{
+				Data:     []byte("payload"),
+				Checksum: bytes.Repeat([]byte{0xab}, 32),
+				Token:    []byte("ABC123"),
+				Note:     &longNote,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.upload.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
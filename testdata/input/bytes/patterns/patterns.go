@@ -0,0 +1,6 @@
+package patterns
+
+import "regexp"
+
+// Token matches upper-case alphanumeric tokens
+var Token = regexp.MustCompile(`^[A-Z0-9]+$`)
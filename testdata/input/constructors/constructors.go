@@ -0,0 +1,34 @@
+package constructors
+
+import "time"
+
+// Audit is embedded in User
+type Audit struct {
+	CreatedBy string
+}
+
+// User gets a generated NewUser constructor
+type User struct {
+	ID        string   `json:"id" validate:"required"`
+	Email     string   `json:"email" validate:"required,email"`
+	Type      string   `json:"type"`
+	Tags      []string `json:"tags" validate:"max=3"`
+	CreatedAt time.Time
+	Audit
+}
+
+// Account declares its own constructor, so none is generated
+type Account struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// NewAccount is written by hand
+func NewAccount(name string) *Account {
+	return &Account{Name: name}
+}
+
+// session is unexported, so its constructor is too
+type session struct {
+	Token string `validate:"required,min=8"`
+	user  *User
+}
@@ -0,0 +1,41 @@
+package constructors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewUser(t *testing.T) {
+	now := time.Now()
+	u, err := NewUser("u1", "jane@example.com", "admin", []string{"a"}, now, Audit{CreatedBy: "root"})
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error = %v", err)
+	}
+	if u.ID != "u1" || u.Email != "jane@example.com" || u.Type != "admin" || !u.CreatedAt.Equal(now) || u.CreatedBy != "root" {
+		t.Errorf("NewUser() = %+v, fields not set", u)
+	}
+
+	u, err = NewUser("u1", "not-an-email", "", nil, now, Audit{})
+	if err == nil || err.Error() != "field Email must be a valid email address" {
+		t.Errorf("NewUser() error = %v, want invalid email", err)
+	}
+	if u != nil {
+		t.Errorf("NewUser() = %+v, want nil for an invalid user", u)
+	}
+}
+
+func TestNewSession(t *testing.T) {
+	if _, err := newSession("short", nil); err == nil {
+		t.Error("newSession() with a short token should fail")
+	}
+	s, err := newSession("long-enough", &User{})
+	if err != nil || s.Token != "long-enough" || s.user == nil {
+		t.Errorf("newSession() = %+v, %v", s, err)
+	}
+}
+
+func TestNewAccountIsHandWritten(t *testing.T) {
+	if a := NewAccount(""); a.Validate() == nil {
+		t.Error("Validate() on an empty account should fail")
+	}
+}
@@ -0,0 +1,26 @@
+package context_mode
+
+import "context"
+
+// Team has a struct validator that gives up when the request is cancelled
+//
+//validate:checkDeadline
+type Team struct {
+	Name    string   `validate:"required"`
+	Members []Member `validate:"dive"`
+}
+
+// Member has no context-aware validators, but gets ValidateContext in --context mode
+type Member struct {
+	Email string `validate:"required,email"`
+}
+
+// Note is not reached by any other struct
+type Note struct {
+	Text string `validate:"required,max=140"`
+}
+
+// checkDeadline stands in for a lookup that honours the request deadline
+func checkDeadline(ctx context.Context, t *Team) error {
+	return ctx.Err()
+}
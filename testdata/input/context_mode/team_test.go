@@ -0,0 +1,65 @@
+package context_mode
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTeam_ValidateContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		team    Team
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name: "valid",
+			ctx:  context.Background(),
+			team: Team{Name: "core", Members: []Member{{Email: "ann@example.com"}}},
+		},
+		{
+			name:    "cancelled",
+			ctx:     cancelled,
+			team:    Team{Name: "core"},
+			wantErr: context.Canceled,
+		},
+		{
+			name:    "invalid member",
+			ctx:     context.Background(),
+			team:    Team{Name: "core", Members: []Member{{Email: "ann"}}},
+			wantMsg: "field Members[0] validation failed: field Email must be a valid email address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.team.ValidateContext(tt.ctx)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ValidateContext() error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantMsg != "":
+				if err == nil || err.Error() != tt.wantMsg {
+					t.Errorf("ValidateContext() error = %v, want %q", err, tt.wantMsg)
+				}
+			case err != nil:
+				t.Errorf("ValidateContext() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestNote_ValidateContext(t *testing.T) {
+	note := Note{}
+	if err := note.ValidateContext(context.Background()); err == nil || err.Error() != "field Text is required" {
+		t.Errorf("ValidateContext() error = %v, want %q", err, "field Text is required")
+	}
+	if err := note.Validate(); err == nil {
+		t.Error("Validate() error = nil, want an error")
+	}
+}
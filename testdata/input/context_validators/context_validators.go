@@ -0,0 +1,36 @@
+package context_validators
+
+import (
+	"context"
+	"errors"
+
+	"github.com/n10ty/houp/testdata/input/context_validators/limits"
+)
+
+// Project has a tenant-aware field validator and a tenant-aware struct validator
+//
+//validate:checkVisibility
+type Project struct {
+	Name       string   `json:"name" validate:"required,github.com/n10ty/houp/testdata/input/context_validators/limits:NotBlank"`
+	Tags       []string `json:"tags" validate:"github.com/n10ty/houp/testdata/input/context_validators/limits:MaxTags"`
+	Visibility string   `json:"visibility" validate:"required"`
+}
+
+// Workspace dives into projects, so it passes the context on
+type Workspace struct {
+	Name     string     `json:"name" validate:"required"`
+	Projects []*Project `json:"projects" validate:"dive"`
+}
+
+// Label has no context-aware validators and keeps a plain Validate method
+type Label struct {
+	Name string `json:"name" validate:"required,github.com/n10ty/houp/testdata/input/context_validators/limits:NotBlank"`
+}
+
+// checkVisibility only lets paid plans create private projects
+func checkVisibility(ctx context.Context, p *Project) error {
+	if p.Visibility == "private" && limits.Plan(ctx) == "free" {
+		return errors.New("private projects require a paid plan")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package context_validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/n10ty/houp/testdata/input/context_validators/limits"
+)
+
+func TestWorkspaceValidateContext(t *testing.T) {
+	free := limits.WithPlan(context.Background(), "free")
+	pro := limits.WithPlan(context.Background(), "pro")
+	threeTags := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		w       Workspace
+		wantErr bool
+	}{
+		{name: "free within limit", ctx: free, w: Workspace{Name: "w", Projects: []*Project{{Name: "p", Tags: []string{"a"}, Visibility: "public"}}}},
+		{name: "free over tag limit", ctx: free, w: Workspace{Name: "w", Projects: []*Project{{Name: "p", Tags: threeTags, Visibility: "public"}}}, wantErr: true},
+		{name: "pro over free tag limit", ctx: pro, w: Workspace{Name: "w", Projects: []*Project{{Name: "p", Tags: threeTags, Visibility: "public"}}}},
+		{name: "free private project", ctx: free, w: Workspace{Name: "w", Projects: []*Project{{Name: "p", Visibility: "private"}}}, wantErr: true},
+		{name: "pro private project", ctx: pro, w: Workspace{Name: "w", Projects: []*Project{{Name: "p", Visibility: "private"}}}},
+		{name: "blank project name", ctx: pro, w: Workspace{Name: "w", Projects: []*Project{{Name: " ", Visibility: "public"}}}, wantErr: true},
+		{name: "nil project skipped", ctx: free, w: Workspace{Name: "w", Projects: []*Project{nil}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.w.ValidateContext(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Workspace.ValidateContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUsesBackgroundContext(t *testing.T) {
+	p := Project{Name: "p", Tags: []string{"a", "b", "c"}, Visibility: "public"}
+	if err := p.Validate(); err == nil {
+		t.Error("Project.Validate() expected the free plan tag limit to apply")
+	}
+
+	l := Label{Name: "urgent"}
+	if err := l.Validate(); err != nil {
+		t.Errorf("Label.Validate() error = %v", err)
+	}
+}
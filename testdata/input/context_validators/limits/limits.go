@@ -0,0 +1,43 @@
+// Package limits provides tenant-aware validators for the context_validators test data
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type planKey struct{}
+
+// maxTags is the number of tags each plan allows
+var maxTags = map[string]int{"free": 2, "pro": 10}
+
+// WithPlan returns a context carrying the tenant's plan
+func WithPlan(ctx context.Context, plan string) context.Context {
+	return context.WithValue(ctx, planKey{}, plan)
+}
+
+// Plan returns the tenant's plan, "free" when none is set
+func Plan(ctx context.Context) string {
+	if plan, ok := ctx.Value(planKey{}).(string); ok {
+		return plan
+	}
+	return "free"
+}
+
+// MaxTags rejects more tags than the tenant's plan allows
+func MaxTags(ctx context.Context, tags []string) error {
+	if limit := maxTags[Plan(ctx)]; len(tags) > limit {
+		return fmt.Errorf("plan %s allows at most %d tags", Plan(ctx), limit)
+	}
+	return nil
+}
+
+// NotBlank rejects strings that consist only of whitespace
+func NotBlank(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("must not be blank")
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package cron
+
+// Job demonstrates cron expression validation
+type Job struct {
+	Name     string  `json:"name" validate:"required"`
+	Schedule string  `json:"schedule" validate:"required,cron"`
+	Retry    *string `json:"retry" validate:"omitempty,cron"`
+}
@@ -0,0 +1,54 @@
+package cron
+
+import (
+	"testing"
+)
+
+func TestJobValidation(t *testing.T) {
+	tests := []struct {
+		schedule string
+		wantErr  bool
+	}{
+		{schedule: "* * * * *"},
+		{schedule: "0 0 * * *"},
+		{schedule: "*/15 9-17 * * MON-FRI"},
+		{schedule: "0 12 1,15 * *"},
+		{schedule: "30 4 1 jan,jul ?"},
+		{schedule: "0 0 ? * 7"},
+		{schedule: "5/10 * * * *"},
+		{schedule: "0 30 9 * * 1-5"}, // six fields with seconds
+		{schedule: "@daily"},
+		{schedule: "@hourly"},
+		{schedule: "", wantErr: true},
+		{schedule: "* * * *", wantErr: true},
+		{schedule: "* * * * * * *", wantErr: true},
+		{schedule: "60 * * * *", wantErr: true},
+		{schedule: "* 24 * * *", wantErr: true},
+		{schedule: "* * 0 * *", wantErr: true},
+		{schedule: "* * * 13 *", wantErr: true},
+		{schedule: "* * * * 8", wantErr: true},
+		{schedule: "17-9 * * * *", wantErr: true},
+		{schedule: "*/0 * * * *", wantErr: true},
+		{schedule: "? * * * *", wantErr: true},
+		{schedule: "* * * FOO *", wantErr: true},
+		{schedule: "1,,2 * * * *", wantErr: true},
+		{schedule: "@every 5m", wantErr: true},
+		{schedule: "60 0 0 * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			job := Job{Name: "report", Schedule: tt.schedule}
+			err := job.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Job.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	bad := "not a cron"
+	job := Job{Name: "report", Schedule: "@weekly", Retry: &bad}
+	if err := job.Validate(); err == nil {
+		t.Error("Job.Validate() expected error for invalid Retry schedule")
+	}
+}
@@ -0,0 +1,16 @@
+package custom_messages
+
+// Signup replaces built-in messages with its own, per rule or per field
+type Signup struct {
+	Username string   `validate:"required~Username is mandatory,min=3~Username is too short"`
+	Email    string   `validate:"required,email" errmsg:"Please enter a valid email address"`
+	Age      int      `validate:"gte=18~You must be 18 or older,lte=130" errmsg:"Age is out of range"`
+	Birthday string   `validate:"omitempty,datetime=2 January 2006,lang=de~Birthday must be a date like 2 Januar 2006"`
+	Tags     []string `validate:"dive,required~Tags cannot be blank,max=10"`
+	Items    []Item   `validate:"dive" errmsg:"Invalid item"`
+}
+
+// Item is an element of Signup.Items
+type Item struct {
+	SKU string `validate:"required~Every item needs a SKU"`
+}
@@ -0,0 +1,146 @@
+package custom_messages
+
+import "testing"
+
+func TestSignup_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		signup  Signup
+		wantErr string
+	}{
+		{
+			name: "valid",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      30,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+		},
+		{
+			name: "missing username",
+			signup: Signup{
+				Email: "a@example.com",
+				Age:   30,
+				Tags:  []string{"go"},
+				Items: []Item{{SKU: "A1"}},
+			},
+			wantErr: "Username is mandatory",
+		},
+		{
+			name: "short username",
+			signup: Signup{
+				Username: "al",
+				Email:    "a@example.com",
+				Age:      30,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Username is too short",
+		},
+		{
+			name: "missing email uses errmsg",
+			signup: Signup{
+				Username: "alice",
+				Age:      30,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Please enter a valid email address",
+		},
+		{
+			name: "invalid email uses errmsg",
+			signup: Signup{
+				Username: "alice",
+				Email:    "nope",
+				Age:      30,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Please enter a valid email address",
+		},
+		{
+			name: "rule message wins over errmsg",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      17,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "You must be 18 or older",
+		},
+		{
+			name: "errmsg for other rules",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      200,
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Age is out of range",
+		},
+		{
+			name: "message after option",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      30,
+				Birthday: "yesterday",
+				Tags:     []string{"go"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Birthday must be a date like 2 Januar 2006",
+		},
+		{
+			name: "element message",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      30,
+				Tags:     []string{"go", ""},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "Tags cannot be blank",
+		},
+		{
+			name: "element without message",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      30,
+				Tags:     []string{"averyverylongtag"},
+				Items:    []Item{{SKU: "A1"}},
+			},
+			wantErr: "field Tags[0] must be at most 10 characters",
+		},
+		// A dive keeps wrapping the error of the element, which has its own message
+		{
+			name: "nested struct",
+			signup: Signup{
+				Username: "alice",
+				Email:    "a@example.com",
+				Age:      30,
+				Tags:     []string{"go"},
+				Items:    []Item{{}},
+			},
+			wantErr: "field Items[0] validation failed: Every item needs a SKU",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signup.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
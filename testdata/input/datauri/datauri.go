@@ -0,0 +1,19 @@
+package datauri
+
+// Avatar is an inline image uploaded through the API
+type Avatar struct {
+	// Inline image, e.g. data:image/png;base64,...
+	Image string `json:"image" validate:"required,datauri"`
+
+	// Optional pointer field
+	Thumbnail *string `json:"thumbnail" validate:"omitempty,datauri"`
+
+	// Custom string type
+	Banner InlineData `json:"banner" validate:"omitempty,datauri"`
+
+	// Each attachment must be a data URI
+	Attachments []string `json:"attachments" validate:"dive,datauri"`
+}
+
+// InlineData is a custom string type
+type InlineData string
@@ -0,0 +1,80 @@
+package datauri
+
+import (
+	"strings"
+	"testing"
+)
+
+const png = "data:image/png;base64,iVBORw0KGgo="
+
+func TestAvatarValidate(t *testing.T) {
+	plain := "data:text/plain,hello"
+	tests := []struct {
+		name    string
+		avatar  Avatar
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			avatar: Avatar{Image: png, Banner: "data:image/svg+xml;charset=utf-8;base64,PHN2Zy8+", Attachments: []string{"data:;base64,aGk="}},
+		},
+		{
+			name:   "parameters without media type",
+			avatar: Avatar{Image: "data:;charset=utf-8;base64,aGk="},
+		},
+		{
+			name:   "empty payload",
+			avatar: Avatar{Image: "data:image/gif;base64,"},
+		},
+		{
+			name:    "missing scheme",
+			avatar:  Avatar{Image: "image/png;base64,iVBORw0KGgo="},
+			wantErr: "field Image must be a base64 data URI",
+		},
+		{
+			name:    "missing comma",
+			avatar:  Avatar{Image: "data:image/png;base64"},
+			wantErr: "field Image must be a base64 data URI",
+		},
+		{
+			name:    "invalid base64",
+			avatar:  Avatar{Image: "data:image/png;base64,not base64!"},
+			wantErr: "field Image must be a base64 data URI",
+		},
+		{
+			name:    "media type without subtype",
+			avatar:  Avatar{Image: "data:image;base64,aGk="},
+			wantErr: "field Image must be a base64 data URI",
+		},
+		{
+			name:    "not base64 encoded",
+			avatar:  Avatar{Image: png, Thumbnail: &plain},
+			wantErr: "field Thumbnail must be a base64 data URI",
+		},
+		{
+			name:    "custom type",
+			avatar:  Avatar{Image: png, Banner: "https://example.com/banner.png"},
+			wantErr: "field Banner must be a base64 data URI",
+		},
+		{
+			name:    "invalid attachment",
+			avatar:  Avatar{Image: png, Attachments: []string{png, "data:"}},
+			wantErr: "field Attachments[1] must be a base64 data URI",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.avatar.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -22,6 +22,21 @@ type DateFormats struct {
 	UnixDate   string `json:"unix_date" validate:"datetime=Mon Jan _2 15:04:05 MST 2006"`
 }
 
+// Reading is an ingested record that accepts several timestamp shapes
+type Reading struct {
+	ObservedAt string   `json:"observed_at" validate:"required,datetime=2006-01-02|2006-01-02T15:04:05Z07:00"`
+	ReceivedAt *ISODate `json:"received_at" validate:"omitempty,datetime=2006-01-02|02.01.2006|Jan 2 2006"`
+	Samples    []string `json:"samples" validate:"dive,datetime=2006-01-02|15:04"`
+}
+
+// Shipment ingests dates written with French, German and Spanish month and day names
+type Shipment struct {
+	IssuedOn string   `json:"issued_on" validate:"required,datetime=2 January 2006,lang=fr"`
+	DueOn    *string  `json:"due_on" validate:"omitempty,datetime=02. Jan 2006|2. January 2006,lang=de"`
+	Delivery []string `json:"delivery" validate:"dive,datetime=Monday 02/01/2006,lang=es"`
+	PickupOn string   `json:"pickup_on" validate:"omitempty,datetime=Mon 2 Jan 2006,lang=es"`
+}
+
 // CustomStringTypes tests datetime validation with custom string types
 type CustomStringTypes struct {
 	Timestamp  MetadataTimestamp  `json:"timestamp" validate:"datetime=2006-01-02T15:04:05Z07:00"`
@@ -199,6 +199,71 @@ func TestCustomStringTypesValidation(t *testing.T) {
 	}
 }
 
+func TestReadingValidation(t *testing.T) {
+	dotted := ISODate("15.01.2024")
+	slashed := ISODate("01/15/2024")
+
+	tests := []struct {
+		name    string
+		r       Reading
+		wantErr bool
+	}{
+		{name: "date", r: Reading{ObservedAt: "2024-01-15"}},
+		{name: "timestamp", r: Reading{ObservedAt: "2024-01-15T09:00:00+02:00"}},
+		{name: "neither layout", r: Reading{ObservedAt: "2024-01-15 09:00"}, wantErr: true},
+		{name: "third pointer layout", r: Reading{ObservedAt: "2024-01-15", ReceivedAt: &dotted}},
+		{name: "no pointer layout matches", r: Reading{ObservedAt: "2024-01-15", ReceivedAt: &slashed}, wantErr: true},
+		{name: "mixed samples", r: Reading{ObservedAt: "2024-01-15", Samples: []string{"2024-01-15", "09:30"}}},
+		{name: "invalid sample", r: Reading{ObservedAt: "2024-01-15", Samples: []string{"09:30:00"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.r.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reading.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShipmentValidation(t *testing.T) {
+	short := "05. Mär 2024"
+	long := "5. Dezember 2024"
+	english := "5. December 2024"
+	bad := "5 Dezember 2024"
+
+	tests := []struct {
+		name    string
+		s       Shipment
+		wantErr bool
+	}{
+		{name: "french month", s: Shipment{IssuedOn: "15 janvier 2024"}},
+		{name: "capitalized french month", s: Shipment{IssuedOn: "15 Février 2024"}},
+		{name: "english month still parses", s: Shipment{IssuedOn: "15 January 2024"}},
+		{name: "wrong language", s: Shipment{IssuedOn: "15 Januar 2024"}, wantErr: true},
+		{name: "german abbreviation", s: Shipment{IssuedOn: "1 mai 2024", DueOn: &short}},
+		{name: "german full month", s: Shipment{IssuedOn: "1 mai 2024", DueOn: &long}},
+		{name: "english in german layout", s: Shipment{IssuedOn: "1 mai 2024", DueOn: &english}},
+		{name: "layout mismatch", s: Shipment{IssuedOn: "1 mai 2024", DueOn: &bad}, wantErr: true},
+		{name: "spanish weekdays", s: Shipment{IssuedOn: "1 mai 2024", Delivery: []string{"miércoles 03/01/2024", "Sábado 06/01/2024"}}},
+		{name: "french weekday", s: Shipment{IssuedOn: "1 mai 2024", Delivery: []string{"mercredi 03/01/2024"}}, wantErr: true},
+		{name: "spanish weekday and month with the same name", s: Shipment{IssuedOn: "1 mai 2024", PickupOn: "mar 5 mar 2024"}},
+		{name: "hyphen in place of a space", s: Shipment{IssuedOn: "1 mai 2024", PickupOn: "mar-5 mar 2024"}, wantErr: true},
+		{name: "english weekday before a spanish month", s: Shipment{IssuedOn: "1 mai 2024", PickupOn: "Tue 5 mar 2024"}},
+		{name: "spanish month in the weekday position", s: Shipment{IssuedOn: "1 mai 2024", PickupOn: "ene 5 mar 2024"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shipment.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func metadataTimestampPtr(s string) *MetadataTimestamp {
 	ts := MetadataTimestamp(s)
 	return &ts
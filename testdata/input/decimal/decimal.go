@@ -0,0 +1,13 @@
+package decimal
+
+import "github.com/shopspring/decimal"
+
+// Bill holds money amounts as decimals
+type Bill struct {
+	Total    decimal.Decimal   `validate:"required,gt=0,max=1_000_000"`
+	Discount *decimal.Decimal  `validate:"omitempty,gte=0,lte=0.25"`
+	Tax      decimal.Decimal   `validate:"omitempty,min=0.01"`
+	Lines    []decimal.Decimal `validate:"dive,gt=-100.5"`
+	Credit   decimal.Decimal   `validate:"omitempty,gte=2.5e-3,lte=1e6"`
+	Reserve  decimal.Decimal   `validate:"omitempty,lte=2.5e19"`
+}
@@ -0,0 +1,151 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestBill_Validate(t *testing.T) {
+	d := func(s string) decimal.Decimal { return decimal.RequireFromString(s) }
+	ptr := func(s string) *decimal.Decimal { v := d(s); return &v }
+
+	tests := []struct {
+		name    string
+		bill    Bill
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			bill: Bill{
+				Total: d("10.50"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "zero total",
+			bill: Bill{
+				Total: decimal.Zero,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative total",
+			bill: Bill{
+				Total: d("-1"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "max total",
+			bill: Bill{
+				Total: d("1000000"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "total too large",
+			bill: Bill{
+				Total: d("1000000.01"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "max discount",
+			bill: Bill{
+				Total:    d("10.50"),
+				Discount: ptr("0.25"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "discount too large",
+			bill: Bill{
+				Total:    d("10.50"),
+				Discount: ptr("0.2501"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative discount",
+			bill: Bill{
+				Total:    d("10.50"),
+				Discount: ptr("-0.01"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "min tax",
+			bill: Bill{
+				Total: d("10.50"),
+				Tax:   d("0.01"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "tax too small",
+			bill: Bill{
+				Total: d("10.50"),
+				Tax:   d("0.009"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid lines",
+			bill: Bill{
+				Total: d("10.50"),
+				Lines: []decimal.Decimal{d("-100.49"), d("5")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "line too small",
+			bill: Bill{
+				Total: d("10.50"),
+				Lines: []decimal.Decimal{d("-100.5")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "credit bounds in scientific notation",
+			bill: Bill{
+				Total:  d("10.50"),
+				Credit: d("1000000"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "credit too small",
+			bill: Bill{
+				Total:  d("10.50"),
+				Credit: d("0.0024"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserve above int64",
+			bill: Bill{
+				Total:   d("10.50"),
+				Reserve: d("25000000000000000000"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "reserve too large",
+			bill: Bill{
+				Total:   d("10.50"),
+				Reserve: d("25000000000000000001"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bill.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/n10ty/houp/testdata/input/decimal
+
+go 1.24.7
+
+require github.com/shopspring/decimal v1.4.0
@@ -0,0 +1,30 @@
+package defined_types
+
+import "time"
+
+type Age int
+
+type Score float64
+
+type Code string
+
+type Level uint8
+
+type Timeout time.Duration
+
+// Player uses defined types of this package and of the standard library
+type Player struct {
+	Age      Age             `validate:"min=18,max=130"`
+	MaybeAge *Age            `validate:"omitempty,gte=0,lt=200"`
+	Score    Score           `validate:"gt=0,lte=100.5"`
+	Ratio    *Score          `validate:"omitempty,min=0.1,max=1"`
+	Code     Code            `validate:"required,min=3,max=8"`
+	Nick     *Code           `validate:"omitempty,len=4"`
+	Level    Level           `validate:"oneof=1 2 3"`
+	Rank     Level           `validate:"gte=1,max=255"`
+	Timeout  Timeout         `validate:"gt=0"`
+	Ages     []Age           `validate:"dive,min=1,max=99"`
+	Wait     time.Duration   `validate:"gte=0,lte=60000000000"`
+	Retries  []time.Duration `validate:"dive,gt=0"`
+	Month    time.Month      `validate:"oneof=1 6 12"`
+}
@@ -0,0 +1,355 @@
+package defined_types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPlayer_Validate(t *testing.T) {
+	age := func(v Age) *Age { return &v }
+	score := func(v Score) *Score { return &v }
+	code := func(v Code) *Code { return &v }
+
+	tests := []struct {
+		name    string
+		player  Player
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: false,
+		},
+		{
+			name: "too young",
+			player: Player{
+				Age:     17,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too old",
+			player: Player{
+				Age:     131,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid maybe age",
+			player: Player{
+				Age:      30,
+				MaybeAge: age(0),
+				Score:    50,
+				Code:     "abcd",
+				Level:    2,
+				Rank:     255,
+				Timeout:  Timeout(time.Second),
+				Wait:     time.Second,
+				Month:    time.June,
+			},
+			wantErr: false,
+		},
+		{
+			name: "maybe age too large",
+			player: Player{
+				Age:      30,
+				MaybeAge: age(200),
+				Score:    50,
+				Code:     "abcd",
+				Level:    2,
+				Rank:     255,
+				Timeout:  Timeout(time.Second),
+				Wait:     time.Second,
+				Month:    time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero score",
+			player: Player{
+				Age:     30,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "NaN score",
+			player: Player{
+				Age:     30,
+				Score:   Score(math.NaN()),
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "max score",
+			player: Player{
+				Age:     30,
+				Score:   100.5,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: false,
+		},
+		{
+			name: "score too high",
+			player: Player{
+				Age:     30,
+				Score:   100.6,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ratio",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Ratio:   score(0.5),
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: false,
+		},
+		{
+			name: "ratio too low",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Ratio:   score(0.05),
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing code",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "code too long",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcdefghi",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid nick",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Nick:    code("nick"),
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: false,
+		},
+		{
+			name: "short nick",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Nick:    code("ni"),
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid level",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   4,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero rank",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero timeout",
+			player: Player{
+				Age:   30,
+				Score: 50,
+				Code:  "abcd",
+				Level: 2,
+				Rank:  255,
+				Wait:  time.Second,
+				Month: time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid age in list",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Ages:    []Age{5, 100},
+				Wait:    time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative wait",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    -time.Second,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wait too long",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Minute + 1,
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero retry",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Retries: []time.Duration{time.Second, 0},
+				Month:   time.June,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid month",
+			player: Player{
+				Age:     30,
+				Score:   50,
+				Code:    "abcd",
+				Level:   2,
+				Rank:    255,
+				Timeout: Timeout(time.Second),
+				Wait:    time.Second,
+				Month:   time.March,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.player.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/n10ty/houp/testdata/input/dive_cross_package/models"
+)
+
+// Envelope dives into types of the models package in every position
+type Envelope struct {
+	Primary *models.Error           `validate:"required,dive"`
+	ByCode  map[string]models.Error `validate:"dive"`
+	Self    models.Link             `validate:"dive"`
+	Links   []*models.Link          `validate:"dive"`
+	Related map[string]*models.Link `validate:"dive,required"`
+	Notes   []models.Note           `validate:"dive"`
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/n10ty/houp/testdata/input/dive_cross_package/models"
+)
+
+func TestEnvelope_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		envelope Envelope
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing primary",
+			envelope: Envelope{
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid primary",
+			envelope: Envelope{
+				Primary: &models.Error{TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid map value",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}, "E2": {LangCode: "en"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid self",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid link",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil related link",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {Href: "/"}, "down": nil},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid related link",
+			envelope: Envelope{
+				Primary: &models.Error{LangCode: "en", TypeCode: "ERROR"},
+				ByCode:  map[string]models.Error{"E1": {LangCode: "en", TypeCode: "ERROR"}},
+				Self:    models.Link{Href: "/self"},
+				Links:   []*models.Link{{Href: "/next"}, nil},
+				Related: map[string]*models.Link{"up": {}},
+				Notes:   []models.Note{{}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.envelope.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+import "fmt"
+
+// Link has a hand-written Validate method with a value receiver
+type Link struct {
+	Href string
+}
+
+// Validate checks that the link has a target
+func (l Link) Validate() error {
+	if l.Href == "" {
+		return fmt.Errorf("field Href is required")
+	}
+	return nil
+}
+
+// Note has no Validate method
+type Note struct {
+	Text string
+}
@@ -0,0 +1,36 @@
+package dive_interfaces
+
+import "fmt"
+
+// Shape has no Validate method; dive checks the dynamic type at run time
+type Shape interface {
+	Area() float64
+}
+
+// Validator declares Validate, but a nil value is still skipped
+type Validator interface {
+	Validate() error
+}
+
+// Circle is a validated shape
+type Circle struct {
+	Radius float64 `validate:"gt=0"`
+}
+
+func (c *Circle) Area() float64 { return 3.14159 * c.Radius * c.Radius }
+
+// Square has no validation
+type Square struct {
+	Side float64
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+// Drawing holds interface values of various kinds
+type Drawing struct {
+	Main    Shape                   `validate:"dive"`
+	Layers  []Shape                 `validate:"min=1,dive"`
+	Labels  map[string]fmt.Stringer `validate:"dive"`
+	Extra   any                     `validate:"dive"`
+	Checker Validator               `validate:"dive"`
+}
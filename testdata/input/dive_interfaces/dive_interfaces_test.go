@@ -0,0 +1,164 @@
+package dive_interfaces
+
+import (
+	"fmt"
+	"testing"
+)
+
+type label string
+
+func (l label) String() string { return string(l) }
+
+type strictLabel string
+
+func (l strictLabel) String() string { return string(l) }
+
+func (l strictLabel) Validate() error {
+	if l == "" {
+		return errEmptyLabel
+	}
+	return nil
+}
+
+type labelError struct{}
+
+func (labelError) Error() string { return "label is empty" }
+
+var errEmptyLabel = labelError{}
+
+func TestDrawing_Validate(t *testing.T) {
+	var nilCircle *Circle
+
+	tests := []struct {
+		name    string
+		drawing Drawing
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil main",
+			drawing: Drawing{
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "typed nil main",
+			drawing: Drawing{
+				Main:   nilCircle,
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid main",
+			drawing: Drawing{
+				Main:   &Circle{},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "shape without Validate",
+			drawing: Drawing{
+				Main:   Square{Side: -1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "circle value has no Validate",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Extra:  Circle{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty layers",
+			drawing: Drawing{
+				Main: &Circle{Radius: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid layer",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}, &Circle{Radius: -1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid labels",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Labels: map[string]fmt.Stringer{"a": label(""), "b": strictLabel("b")},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid label",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Labels: map[string]fmt.Stringer{"a": strictLabel("")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid extra",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Extra:  &Circle{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra without Validate",
+			drawing: Drawing{
+				Main:   &Circle{Radius: 1},
+				Layers: []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Extra:  42,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid checker",
+			drawing: Drawing{
+				Main:    &Circle{Radius: 1},
+				Layers:  []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Checker: &Circle{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "typed nil checker",
+			drawing: Drawing{
+				Main:    &Circle{Radius: 1},
+				Layers:  []Shape{&Circle{Radius: 2}, Square{Side: 0}},
+				Checker: nilCircle,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.drawing.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package dive_maps
+
+// Variant is a product variant, validated through its parent's dive
+type Variant struct {
+	SKU   string `validate:"required"`
+	Stock int    `validate:"gte=0"`
+}
+
+// Scores maps a judge to a score
+type Scores map[string]int
+
+// Stock maps a warehouse to its variants
+type Stock map[string]*Variant
+
+// Catalog dives into map values of struct, pointer and primitive types
+type Catalog struct {
+	Variants map[string]Variant  `validate:"required,dive"`
+	Featured map[string]*Variant `validate:"dive,required"`
+	Prices   map[int]float64     `validate:"dive,gt=0"`
+	Emails   map[string]string   `validate:"omitempty,max=5,dive,email"`
+	Scores   Scores              `validate:"dive,min=1,max=10"`
+	Aliases  *map[string]string  `validate:"dive,min=2"`
+	Stock    Stock               `validate:"dive"`
+}
@@ -0,0 +1,138 @@
+package dive_maps
+
+import "testing"
+
+func TestCatalogValidate(t *testing.T) {
+	aliases := map[string]string{"r": "x"}
+
+	tests := []struct {
+		name    string
+		catalog Catalog
+		wantErr string
+	}{
+		{
+			name: "valid",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+		},
+		{
+			name: "nil variants",
+			catalog: Catalog{
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: "field Variants is required",
+		},
+		{
+			name: "invalid struct value",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}, "blue": {Stock: 1}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: `field Variants["blue"] validation failed: field SKU is required`,
+		},
+		{
+			name: "invalid pointer value",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1", Stock: -1}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: `field Featured["top"] validation failed: field Stock must be at least 0`,
+		},
+		{
+			name: "nil pointer value",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}, "gone": nil},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: `field Featured["gone"] is required`,
+		},
+		{
+			name: "non-string key",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5, 2: 0},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: "field Prices[2] must be greater than 0",
+		},
+		{
+			name: "invalid email",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com", "support": "nope"},
+				Scores:   Scores{"ann": 7},
+			},
+			wantErr: `field Emails["support"] must be a valid email address`,
+		},
+		{
+			name: "named map value",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7, "bob": 11},
+			},
+			wantErr: `field Scores["bob"] must be at most 10`,
+		},
+		{
+			name: "pointer map",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+				Aliases:  &aliases,
+			},
+			wantErr: `field Aliases["r"] must be at least 2 characters`,
+		},
+		{
+			name: "named map of pointers",
+			catalog: Catalog{
+				Variants: map[string]Variant{"red": {SKU: "R-1", Stock: 3}},
+				Featured: map[string]*Variant{"top": {SKU: "T-1"}},
+				Prices:   map[int]float64{1: 9.5},
+				Emails:   map[string]string{"sales": "sales@example.com"},
+				Scores:   Scores{"ann": 7},
+				Stock:    Stock{"north": nil, "south": {}},
+			},
+			wantErr: `field Stock["south"] validation failed: field SKU is required`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.catalog.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package dive_unique
+
+import "time"
+
+// SKU is a custom string key type
+type SKU string
+
+// Item is referenced through pointer and value slices
+type Item struct {
+	ID       int           `json:"id" validate:"gt=0"`
+	Code     SKU           `json:"code" validate:"min=1"`
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Catalog uses dive+unique with non-string keys
+type Catalog struct {
+	Items     []*Item `json:"items" validate:"dive,unique=ID"`
+	ByCode    []*Item `json:"by_code" validate:"dive,unique=Code"`
+	Named     []*Item `json:"named" validate:"required,dive,unique=Name"`
+	Values    []Item  `json:"values" validate:"unique=ID"`
+	Durations []Item  `json:"durations" validate:"dive,unique=Duration"`
+}
@@ -0,0 +1,106 @@
+package dive_unique
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCatalogValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog Catalog
+		wantErr bool
+	}{
+		{
+			name: "valid catalog",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values: []Item{{ID: 1}, {ID: 2}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate int key in pointer slice",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 7, Code: "A"}, {ID: 7, Code: "B"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values: []Item{{ID: 1}, {ID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid element in pointer slice",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 0, Code: "A"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values: []Item{{ID: 1}, {ID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate custom string key",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 2, Code: "A"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values: []Item{{ID: 1}, {ID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "x"}, {ID: 2, Code: "B", Name: "x"}},
+				Values: []Item{{ID: 1}, {ID: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate int key in value slice",
+			catalog: Catalog{
+				Items:  []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode: []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:  []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values: []Item{{ID: 3}, {ID: 3}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate duration",
+			catalog: Catalog{
+				Items:     []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode:    []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:     []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values:    []Item{{ID: 1}, {ID: 2}},
+				Durations: []Item{{ID: 1, Code: "A", Duration: time.Second}, {ID: 2, Code: "B", Duration: time.Second}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "distinct durations",
+			catalog: Catalog{
+				Items:     []*Item{{ID: 1, Code: "A"}, nil, {ID: 2, Code: "B"}},
+				ByCode:    []*Item{{ID: 1, Code: "A"}, {ID: 1, Code: "B"}},
+				Named:     []*Item{{ID: 1, Code: "A", Name: "one"}, {ID: 2, Code: "B", Name: "two"}},
+				Values:    []Item{{ID: 1}, {ID: 2}},
+				Durations: []Item{{ID: 1, Code: "A", Duration: time.Second}, {ID: 2, Code: "B", Duration: time.Minute}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.catalog.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Catalog.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package duration
+
+// Interval is a custom string type holding a duration
+type Interval string
+
+// ServerConfig demonstrates duration validation for configuration structs
+type ServerConfig struct {
+	ReadTimeout  string   `json:"read_timeout" validate:"required,duration"`
+	PollInterval Interval `json:"poll_interval" validate:"duration"`
+	GracePeriod  *string  `json:"grace_period" validate:"omitempty,duration"`
+}
@@ -0,0 +1,33 @@
+package duration
+
+import (
+	"testing"
+)
+
+func TestServerConfigValidation(t *testing.T) {
+	grace, badGrace := "1h30m", "forever"
+
+	tests := []struct {
+		name    string
+		cfg     ServerConfig
+		wantErr bool
+	}{
+		{name: "seconds and minutes", cfg: ServerConfig{ReadTimeout: "30s", PollInterval: "5m"}},
+		{name: "fractional and compound", cfg: ServerConfig{ReadTimeout: "1.5s", PollInterval: "1h2m3s"}},
+		{name: "zero without unit", cfg: ServerConfig{ReadTimeout: "0", PollInterval: "0"}},
+		{name: "optional grace period", cfg: ServerConfig{ReadTimeout: "30s", PollInterval: "5m", GracePeriod: &grace}},
+		{name: "missing unit", cfg: ServerConfig{ReadTimeout: "30", PollInterval: "5m"}, wantErr: true},
+		{name: "unknown unit", cfg: ServerConfig{ReadTimeout: "30s", PollInterval: "5d"}, wantErr: true},
+		{name: "missing read timeout", cfg: ServerConfig{PollInterval: "5m"}, wantErr: true},
+		{name: "invalid grace period", cfg: ServerConfig{ReadTimeout: "30s", PollInterval: "5m", GracePeriod: &badGrace}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ServerConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
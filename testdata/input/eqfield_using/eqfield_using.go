@@ -0,0 +1,18 @@
+package eqfield_using
+
+import "strings"
+
+// Signup compares fields with domain-specific equality functions
+type Signup struct {
+	Email        string  `json:"email" validate:"required"`
+	ConfirmEmail string  `json:"confirm_email" validate:"eqfield=Email,using=github.com/n10ty/houp/testdata/input/eqfield_using/equality:FoldEqual"`
+	Phone        *string `json:"phone"`
+	ConfirmPhone *string `json:"confirm_phone" validate:"eqfield=Phone,using=github.com/n10ty/houp/testdata/input/eqfield_using/equality:PhoneEqual"`
+	Username     string  `json:"username"`
+	Handle       string  `json:"handle" validate:"eqfield=Username,using=trimmedEqual"`
+}
+
+// trimmedEqual compares two strings ignoring surrounding whitespace
+func trimmedEqual(a, b string) bool {
+	return strings.TrimSpace(a) == strings.TrimSpace(b)
+}
@@ -0,0 +1,58 @@
+package eqfield_using
+
+import "testing"
+
+func TestSignupValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		signup  Signup
+		wantErr bool
+	}{
+		{
+			name:    "case-insensitive email match",
+			signup:  Signup{Email: "User@Example.com", ConfirmEmail: "user@example.com"},
+			wantErr: false,
+		},
+		{
+			name:    "email mismatch",
+			signup:  Signup{Email: "user@example.com", ConfirmEmail: "other@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "phone match with different formatting",
+			signup:  Signup{Email: "a@b.c", ConfirmEmail: "a@b.c", Phone: strPtr("+1 (555) 010-9999"), ConfirmPhone: strPtr("+15550109999")},
+			wantErr: false,
+		},
+		{
+			name:    "phone mismatch",
+			signup:  Signup{Email: "a@b.c", ConfirmEmail: "a@b.c", Phone: strPtr("+15550109999"), ConfirmPhone: strPtr("+15550100000")},
+			wantErr: true,
+		},
+		{
+			name:    "confirm phone without phone",
+			signup:  Signup{Email: "a@b.c", ConfirmEmail: "a@b.c", ConfirmPhone: strPtr("+15550109999")},
+			wantErr: true,
+		},
+		{
+			name:    "handle match ignoring whitespace",
+			signup:  Signup{Email: "a@b.c", ConfirmEmail: "a@b.c", Username: "gopher", Handle: " gopher "},
+			wantErr: false,
+		},
+		{
+			name:    "handle mismatch",
+			signup:  Signup{Email: "a@b.c", ConfirmEmail: "a@b.c", Username: "gopher", Handle: "rustacean"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signup.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Signup.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package equality provides domain-specific equality functions for eqfield using=
+package equality
+
+import "strings"
+
+// FoldEqual reports whether two strings are equal ignoring case
+func FoldEqual(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// PhoneEqual reports whether two phone numbers are equal ignoring formatting characters
+func PhoneEqual(a, b string) bool {
+	return normalizePhone(a) == normalizePhone(b)
+}
+
+func normalizePhone(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '+' {
+			return r
+		}
+		return -1
+	}, s)
+}
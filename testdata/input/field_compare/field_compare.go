@@ -0,0 +1,15 @@
+package field_compare
+
+import "time"
+
+// Booking orders its dates and guest counts against each other
+type Booking struct {
+	StartDate   time.Time  `validate:"required"`
+	EndDate     time.Time  `validate:"required,gtfield=StartDate"`
+	CheckOut    *time.Time `validate:"omitempty,gtefield=StartDate,ltefield=EndDate"`
+	ConfirmedAt time.Time  `validate:"omitempty,eqfield=StartDate"`
+	Guests      int        `validate:"gt=0,ltefield=MaxGuests"`
+	MaxGuests   int
+	Deposit     *float64 `validate:"omitempty,ltfield=Price"`
+	Price       *float64
+}
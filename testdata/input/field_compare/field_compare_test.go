@@ -0,0 +1,143 @@
+package field_compare
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBookingValidate(t *testing.T) {
+	start := time.Date(2026, 5, 1, 14, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 3)
+	early, late := start.Add(-time.Hour), end.Add(time.Hour)
+	// Same instant in another location: == would report a difference, Equal does not
+	startLocal := start.In(time.FixedZone("CEST", 2*60*60))
+	deposit, price, cheap := 50.0, 200.0, 20.0
+
+	tests := []struct {
+		name    string
+		booking Booking
+		wantErr string
+	}{
+		{
+			name: "valid",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				Guests:    2,
+				MaxGuests: 4,
+			},
+		},
+		{
+			name: "all set",
+			booking: Booking{
+				StartDate:   start,
+				EndDate:     end,
+				CheckOut:    &end,
+				ConfirmedAt: startLocal,
+				Guests:      2,
+				MaxGuests:   4,
+				Deposit:     &deposit,
+				Price:       &price,
+			},
+		},
+		{
+			name: "deposit without price",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				Guests:    2,
+				MaxGuests: 4,
+				Deposit:   &deposit,
+			},
+		},
+		{
+			name: "end before start",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   early,
+				Guests:    2,
+				MaxGuests: 4,
+			},
+			wantErr: "field EndDate must be after field StartDate",
+		},
+		{
+			name: "end equals start",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   start,
+				Guests:    2,
+				MaxGuests: 4,
+			},
+			wantErr: "field EndDate must be after field StartDate",
+		},
+		{
+			name: "check-out before start",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				CheckOut:  &early,
+				Guests:    2,
+				MaxGuests: 4,
+			},
+			wantErr: "field CheckOut must be not before field StartDate",
+		},
+		{
+			name: "check-out after end",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				CheckOut:  &late,
+				Guests:    2,
+				MaxGuests: 4,
+			},
+			wantErr: "field CheckOut must be not after field EndDate",
+		},
+		{
+			name: "confirmed at another time",
+			booking: Booking{
+				StartDate:   start,
+				EndDate:     end,
+				ConfirmedAt: late,
+				Guests:      2,
+				MaxGuests:   4,
+			},
+			wantErr: "field ConfirmedAt must equal field StartDate",
+		},
+		{
+			name: "too many guests",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				Guests:    5,
+				MaxGuests: 4,
+			},
+			wantErr: "field Guests must be at most field MaxGuests",
+		},
+		{
+			name: "deposit above price",
+			booking: Booking{
+				StartDate: start,
+				EndDate:   end,
+				Guests:    2,
+				MaxGuests: 4,
+				Deposit:   &deposit,
+				Price:     &cheap,
+			},
+			wantErr: "field Deposit must be less than field Price",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.booking.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package finite
+
+// Celsius is a custom float type
+type Celsius float64
+
+// Reading demonstrates the finite rule and NaN-safe bounds
+type Reading struct {
+	Value       float64  `json:"value" validate:"finite"`
+	Temperature Celsius  `json:"temperature" validate:"finite,gte=-273.15"`
+	Ratio       float32  `json:"ratio" validate:"gt=0,lt=1"`
+	Weight      *float64 `json:"weight" validate:"omitempty,finite,min=0,max=500"`
+}
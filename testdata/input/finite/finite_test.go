@@ -0,0 +1,138 @@
+package finite
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReadingValidation(t *testing.T) {
+	nan, inf, heavy, light := math.NaN(), math.Inf(1), 501.0, 70.5
+
+	tests := []struct {
+		name    string
+		reading Reading
+		wantErr bool
+	}{
+		{
+			name: "valid reading",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       0.5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid weight",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       0.5,
+				Weight:      &light,
+			},
+			wantErr: false,
+		},
+		{
+			name: "NaN value",
+			reading: Reading{
+				Value:       nan,
+				Temperature: 21,
+				Ratio:       0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "+Inf value",
+			reading: Reading{
+				Value:       inf,
+				Temperature: 21,
+				Ratio:       0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "-Inf value",
+			reading: Reading{
+				Value:       math.Inf(-1),
+				Temperature: 21,
+				Ratio:       0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "NaN custom float type",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: Celsius(nan),
+				Ratio:       0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "below absolute zero",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: -300,
+				Ratio:       0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "NaN float32 passes no bound",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       float32(nan),
+			},
+			wantErr: true,
+		},
+		{
+			name: "float32 out of range",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "NaN weight",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       0.5,
+				Weight:      &nan,
+			},
+			wantErr: true,
+		},
+		{
+			name: "infinite weight",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       0.5,
+				Weight:      &inf,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too heavy",
+			reading: Reading{
+				Value:       1.5,
+				Temperature: 21,
+				Ratio:       0.5,
+				Weight:      &heavy,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.reading.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reading.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
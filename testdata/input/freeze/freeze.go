@@ -0,0 +1,47 @@
+package freeze
+
+import "time"
+
+// Config is swapped in atomically on reload
+//
+//validate:freeze
+type Config struct {
+	Name      string `validate:"required"`
+	Timeout   time.Duration
+	Workers   int `validate:"gt=0"`
+	Started   time.Time
+	Location  *time.Location
+	Listen    *Listener         `validate:"required,dive"`
+	Backends  []Backend         `validate:"min=1,dive"`
+	Limits    map[string]*Limit `validate:"dive"`
+	Tags      Tags
+	Fallbacks [2]*Backend
+	Matrix    [][]int
+	Root      *Route
+	retries   *int
+}
+
+// Listener is the address to listen on
+type Listener struct {
+	Addr string `validate:"required"`
+}
+
+// Backend is an upstream server
+type Backend struct {
+	URL     string `validate:"required"`
+	Headers map[string][]string
+}
+
+// Limit is a rate limit
+type Limit struct {
+	Rate int `validate:"gt=0"`
+}
+
+// Tags are free-form labels
+type Tags []string
+
+// Route is a node of the routing tree
+type Route struct {
+	Path     string
+	Children []*Route
+}
@@ -0,0 +1,82 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+)
+
+func newConfig() *Config {
+	retries := 3
+	return &Config{
+		Name:      "api",
+		Timeout:   time.Second,
+		Workers:   4,
+		Location:  time.UTC,
+		Listen:    &Listener{Addr: ":8080"},
+		Backends:  []Backend{{URL: "http://a", Headers: map[string][]string{"X": {"1"}}}},
+		Limits:    map[string]*Limit{"read": {Rate: 10}},
+		Tags:      Tags{"blue"},
+		Fallbacks: [2]*Backend{{URL: "http://b"}},
+		Matrix:    [][]int{{1, 2}},
+		Root:      &Route{Path: "/", Children: []*Route{{Path: "/users"}}},
+		retries:   &retries,
+	}
+}
+
+func TestValidateAndFreeze(t *testing.T) {
+	c := newConfig()
+	frozen, err := c.ValidateAndFreeze()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Changing the original must not reach the frozen copy
+	c.Name = "changed"
+	c.Listen.Addr = ":9090"
+	c.Backends[0].URL = "http://changed"
+	c.Backends[0].Headers["X"][0] = "changed"
+	c.Limits["read"].Rate = 1
+	c.Tags[0] = "changed"
+	c.Fallbacks[0].URL = "http://changed"
+	c.Matrix[0][0] = 100
+	c.Root.Children[0].Path = "/changed"
+	*c.retries = 0
+
+	switch {
+	case frozen.Name != "api":
+		t.Errorf("Name = %q", frozen.Name)
+	case frozen.Listen.Addr != ":8080":
+		t.Errorf("Listen.Addr = %q", frozen.Listen.Addr)
+	case frozen.Backends[0].URL != "http://a":
+		t.Errorf("Backends[0].URL = %q", frozen.Backends[0].URL)
+	case frozen.Backends[0].Headers["X"][0] != "1":
+		t.Errorf("Backends[0].Headers = %v", frozen.Backends[0].Headers)
+	case frozen.Limits["read"].Rate != 10:
+		t.Errorf("Limits[read].Rate = %d", frozen.Limits["read"].Rate)
+	case frozen.Tags[0] != "blue":
+		t.Errorf("Tags = %v", frozen.Tags)
+	case frozen.Fallbacks[0].URL != "http://b" || frozen.Fallbacks[1] != nil:
+		t.Errorf("Fallbacks = %v", frozen.Fallbacks)
+	case frozen.Matrix[0][0] != 1:
+		t.Errorf("Matrix = %v", frozen.Matrix)
+	case frozen.Root.Children[0].Path != "/users":
+		t.Errorf("Root.Children[0].Path = %q", frozen.Root.Children[0].Path)
+	case *frozen.retries != 3:
+		t.Errorf("retries = %d", *frozen.retries)
+	case frozen.Location != time.UTC:
+		t.Errorf("Location was copied")
+	}
+}
+
+func TestValidateAndFreezeInvalid(t *testing.T) {
+	c := newConfig()
+	c.Limits["write"] = &Limit{}
+	frozen, err := c.ValidateAndFreeze()
+	if frozen != nil {
+		t.Errorf("got a frozen copy of an invalid config")
+	}
+	want := `field Limits["write"] validation failed: field Rate must be greater than 0`
+	if err == nil || err.Error() != want {
+		t.Fatalf("got error %v, want %q", err, want)
+	}
+}
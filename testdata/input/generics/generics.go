@@ -0,0 +1,31 @@
+package generics
+
+// Validatable is the constraint of containers that always validate their elements
+type Validatable interface {
+	Validate() error
+}
+
+// Page is a page of results of any type; elements are validated when they have a
+// Validate method
+type Page[T any] struct {
+	Items []T    `validate:"min=1,dive"`
+	Next  *T     `validate:"dive"`
+	Token string `validate:"omitempty,len=16"`
+}
+
+// Batch only holds validatable elements, so dive calls Validate directly
+type Batch[K comparable, V Validatable] struct {
+	Entries map[K]V `validate:"required,dive"`
+}
+
+// Item is a validated element
+type Item struct {
+	SKU string `validate:"required"`
+}
+
+// Catalog uses instantiated generic types
+type Catalog struct {
+	Items Page[Item]        `validate:"dive"`
+	Names *Page[string]     `validate:"omitempty,dive"`
+	Stock Batch[int, *Item] `validate:"dive"`
+}
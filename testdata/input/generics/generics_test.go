@@ -0,0 +1,49 @@
+package generics
+
+import "testing"
+
+func TestPageValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    interface{ Validate() error }
+		wantErr string
+	}{
+		{name: "values", page: &Page[Item]{Items: []Item{{SKU: "a"}}}},
+		{name: "invalid value", page: &Page[Item]{Items: []Item{{SKU: "a"}, {}}}, wantErr: "field Items[1] validation failed: field SKU is required"},
+		{name: "pointers", page: &Page[*Item]{Items: []*Item{{SKU: "a"}, nil}}},
+		{name: "invalid pointer", page: &Page[*Item]{Items: []*Item{{}}}, wantErr: "field Items[0] validation failed: field SKU is required"},
+		{name: "next", page: &Page[Item]{Items: []Item{{SKU: "a"}}, Next: &Item{}}, wantErr: "field Next validation failed: field SKU is required"},
+		{name: "no validate method", page: &Page[string]{Items: []string{""}}},
+		{name: "empty", page: &Page[string]{}, wantErr: "field Items must have at least 1 elements"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.page.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCatalogValidate(t *testing.T) {
+	c := Catalog{
+		Items: Page[Item]{Items: []Item{{SKU: "a"}}},
+		Stock: Batch[int, *Item]{Entries: map[int]*Item{1: {SKU: "b"}}},
+	}
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c.Stock.Entries[2] = &Item{}
+	want := "field Stock validation failed: field Entries[2] validation failed: field SKU is required"
+	if err := c.Validate(); err == nil || err.Error() != want {
+		t.Fatalf("got error %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,22 @@
+package geo
+
+// Location demonstrates latitude/longitude validation on floats
+type Location struct {
+	Lat    float64  `json:"lat" validate:"latitude"`
+	Lng    float64  `json:"lng" validate:"longitude"`
+	AltLat *float32 `json:"alt_lat" validate:"omitempty,latitude"`
+}
+
+// TextLocation demonstrates latitude/longitude validation on numeric strings
+type TextLocation struct {
+	Lat    string   `json:"lat" validate:"required,latitude"`
+	Lng    string   `json:"lng" validate:"required,longitude"`
+	Points []string `json:"points" validate:"omitempty,dive,longitude"`
+}
+
+// Venue gets the receiver v, which the parsed value of a string coordinate must not
+// shadow
+type Venue struct {
+	Lat string `json:"lat" validate:"required,latitude"`
+	Lng string `json:"lng" validate:"required,longitude"`
+}
@@ -0,0 +1,77 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLocationValidation(t *testing.T) {
+	high := float32(91)
+
+	tests := []struct {
+		name    string
+		loc     Location
+		wantErr bool
+	}{
+		{name: "valid", loc: Location{Lat: 50.45, Lng: 30.52}, wantErr: false},
+		{name: "bounds inclusive", loc: Location{Lat: -90, Lng: 180}, wantErr: false},
+		{name: "latitude too high", loc: Location{Lat: 90.1, Lng: 0}, wantErr: true},
+		{name: "longitude too low", loc: Location{Lat: 0, Lng: -180.5}, wantErr: true},
+		{name: "NaN latitude", loc: Location{Lat: math.NaN(), Lng: 0}, wantErr: true},
+		{name: "optional latitude out of range", loc: Location{AltLat: &high}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loc.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Location.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTextLocationValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		loc     TextLocation
+		wantErr bool
+	}{
+		{name: "valid", loc: TextLocation{Lat: "50.45", Lng: "-30.52"}, wantErr: false},
+		{name: "not a number", loc: TextLocation{Lat: "north", Lng: "0"}, wantErr: true},
+		{name: "longitude too high", loc: TextLocation{Lat: "0", Lng: "181"}, wantErr: true},
+		{name: "NaN string", loc: TextLocation{Lat: "NaN", Lng: "0"}, wantErr: true},
+		{name: "valid points", loc: TextLocation{Lat: "1", Lng: "1", Points: []string{"10", "-179.9"}}, wantErr: false},
+		{name: "invalid point", loc: TextLocation{Lat: "1", Lng: "1", Points: []string{"10", "200"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loc.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TextLocation.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVenueValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		venue   Venue
+		wantErr bool
+	}{
+		{name: "valid", venue: Venue{Lat: "50.45", Lng: "30.52"}, wantErr: false},
+		{name: "latitude too low", venue: Venue{Lat: "-90.5", Lng: "0"}, wantErr: true},
+		{name: "longitude not a number", venue: Venue{Lat: "0", Lng: "east"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.venue.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Venue.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
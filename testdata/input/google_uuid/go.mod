@@ -0,0 +1,5 @@
+module github.com/n10ty/houp/testdata/input/google_uuid
+
+go 1.24.7
+
+require github.com/google/uuid v1.6.0
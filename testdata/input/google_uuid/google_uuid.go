@@ -0,0 +1,14 @@
+package google_uuid
+
+import "github.com/google/uuid"
+
+// Account references other records by uuid.UUID rather than by string
+type Account struct {
+	ID        uuid.UUID   `validate:"required,uuid4"`
+	OwnerID   uuid.UUID   `validate:"uuid"`
+	ParentID  *uuid.UUID  `validate:"omitempty,uuid"`
+	SessionID uuid.UUID   `validate:"omitempty,uuid4"`
+	TraceID   uuid.UUID   `validate:"uuid_rfc4122"`
+	MergedID  uuid.UUID   `validate:"uuid=nil"`
+	Members   []uuid.UUID `validate:"dive,required,uuid4"`
+}
@@ -0,0 +1,163 @@
+package google_uuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAccount_Validate(t *testing.T) {
+	v7 := uuid.Must(uuid.NewV7())
+	v4 := uuid.New()
+	owner := uuid.NewSHA1(uuid.NameSpaceURL, []byte("owner"))
+	ncs := uuid.MustParse("123e4567-e89b-12d3-0456-426614174000")
+
+	tests := []struct {
+		name    string
+		account Account
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			account: Account{
+				ID:      v4,
+				OwnerID: owner,
+				TraceID: v4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil id",
+			account: Account{
+				ID:      uuid.Nil,
+				OwnerID: owner,
+				TraceID: v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "id not version 4",
+			account: Account{
+				ID:      v7,
+				OwnerID: owner,
+				TraceID: v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "owner version 7",
+			account: Account{
+				ID:      v4,
+				OwnerID: v7,
+				TraceID: v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "owner wrong variant",
+			account: Account{
+				ID:      v4,
+				OwnerID: ncs,
+				TraceID: v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid parent",
+			account: Account{
+				ID:       v4,
+				OwnerID:  owner,
+				ParentID: &v4,
+				TraceID:  v4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "parent version 7",
+			account: Account{
+				ID:       v4,
+				OwnerID:  owner,
+				ParentID: &v7,
+				TraceID:  v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "session version 7",
+			account: Account{
+				ID:        v4,
+				OwnerID:   owner,
+				SessionID: v7,
+				TraceID:   v4,
+			},
+			wantErr: true,
+		},
+		{
+			name: "trace version 7",
+			account: Account{
+				ID:      v4,
+				OwnerID: owner,
+				TraceID: v7,
+			},
+			wantErr: false,
+		},
+		{
+			name: "trace wrong variant",
+			account: Account{
+				ID:      v4,
+				OwnerID: owner,
+				TraceID: ncs,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid merged",
+			account: Account{
+				ID:       v4,
+				OwnerID:  owner,
+				TraceID:  v4,
+				MergedID: v4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "merged version 7",
+			account: Account{
+				ID:       v4,
+				OwnerID:  owner,
+				TraceID:  v4,
+				MergedID: v7,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid members",
+			account: Account{
+				ID:      v4,
+				OwnerID: owner,
+				TraceID: v4,
+				Members: []uuid.UUID{v4, uuid.New()},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil member",
+			account: Account{
+				ID:      v4,
+				OwnerID: owner,
+				TraceID: v4,
+				Members: []uuid.UUID{v4, uuid.Nil},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.account.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
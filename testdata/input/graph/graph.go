@@ -0,0 +1,32 @@
+package graph
+
+import "github.com/n10ty/houp/testdata/input/dive_cross_package/models"
+
+// Order dives into local and cross-package structs
+type Order struct {
+	ID       string                   `validate:"required"`
+	Lines    []Line                   `validate:"required,dive"`
+	Errors   map[string]*models.Error `validate:"dive"`
+	Customer Customer                 // validated but not dived
+	Billing  Customer                 `validate:"-"` // skipped, so not a gap
+	Notes    *Notes                   `validate:"dive"`
+	Payload  []byte                   `validate:"jsonof=Customer"`
+}
+
+// Line is an order line
+type Line struct {
+	SKU      string `validate:"required"`
+	Quantity int    `validate:"gt=0"`
+}
+
+// Customer has a hand-written Validate method
+type Customer struct {
+	Email string
+}
+
+func (c *Customer) Validate() error { return nil }
+
+// Notes has no validation
+type Notes struct {
+	Text string
+}
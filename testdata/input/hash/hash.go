@@ -0,0 +1,23 @@
+package hash
+
+// Artifact is an entry of a release manifest
+type Artifact struct {
+	Name string `json:"name" validate:"required"`
+
+	// Checksums published next to the artifact
+	MD5    string `json:"md5" validate:"omitempty,md5"`
+	SHA1   string `json:"sha1" validate:"omitempty,sha1"`
+	SHA256 string `json:"sha256" validate:"required,sha256"`
+
+	// Optional pointer field
+	SHA512 *string `json:"sha512" validate:"omitempty,sha512"`
+
+	// Custom string type
+	Signature Digest `json:"signature" validate:"omitempty,sha256"`
+
+	// Digests of each layer
+	Layers []string `json:"layers" validate:"dive,sha256"`
+}
+
+// Digest is a custom string type
+type Digest string
@@ -0,0 +1,88 @@
+package hash
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	md5Hex    = "d41d8cd98f00b204e9800998ecf8427e"
+	sha1Hex   = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+	sha256Hex = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	sha512Hex = "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"
+)
+
+func TestArtifactValidate(t *testing.T) {
+	sha512 := sha512Hex
+	upper := strings.ToUpper(sha512Hex)
+	short := sha512Hex[:127]
+	tests := []struct {
+		name     string
+		artifact Artifact
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			artifact: Artifact{Name: "app", MD5: md5Hex, SHA1: sha1Hex, SHA256: sha256Hex, SHA512: &sha512, Signature: sha256Hex, Layers: []string{sha256Hex}},
+		},
+		{
+			name:     "upper case",
+			artifact: Artifact{Name: "app", SHA256: strings.ToUpper(sha256Hex), SHA512: &upper},
+		},
+		{
+			name:     "md5 too long",
+			artifact: Artifact{Name: "app", MD5: md5Hex + "0", SHA256: sha256Hex},
+			wantErr:  "field MD5 must be a valid MD5 hex digest",
+		},
+		{
+			name:     "sha1 of the wrong length",
+			artifact: Artifact{Name: "app", SHA1: md5Hex, SHA256: sha256Hex},
+			wantErr:  "field SHA1 must be a valid SHA-1 hex digest",
+		},
+		{
+			name:     "sha256 is required",
+			artifact: Artifact{Name: "app"},
+			wantErr:  "field SHA256 is required",
+		},
+		{
+			name:     "sha256 with a non-hex character",
+			artifact: Artifact{Name: "app", SHA256: "g" + sha256Hex[1:]},
+			wantErr:  "field SHA256 must be a valid SHA-256 hex digest",
+		},
+		{
+			name:     "sha256 with an algorithm prefix",
+			artifact: Artifact{Name: "app", SHA256: "sha256:" + sha256Hex},
+			wantErr:  "field SHA256 must be a valid SHA-256 hex digest",
+		},
+		{
+			name:     "sha512 too short",
+			artifact: Artifact{Name: "app", SHA256: sha256Hex, SHA512: &short},
+			wantErr:  "field SHA512 must be a valid SHA-512 hex digest",
+		},
+		{
+			name:     "custom type",
+			artifact: Artifact{Name: "app", SHA256: sha256Hex, Signature: Digest(sha1Hex)},
+			wantErr:  "field Signature must be a valid SHA-256 hex digest",
+		},
+		{
+			name:     "invalid layer",
+			artifact: Artifact{Name: "app", SHA256: sha256Hex, Layers: []string{sha256Hex, md5Hex}},
+			wantErr:  "field Layers[1] must be a valid SHA-256 hex digest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.artifact.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
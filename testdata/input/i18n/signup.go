@@ -0,0 +1,22 @@
+package i18n
+
+// Signup returns errors that can be translated with the catalogs of houp --i18n
+type Signup struct {
+	Username string   `validate:"required,min=3"`
+	Email    string   `validate:"required,email~Please check the email address"`
+	Tags     []string `validate:"dive,max=5"`
+	Items    []Item   `validate:"dive"`
+}
+
+// Item is an element of Signup.Items
+type Item struct {
+	SKU string `validate:"required"`
+}
+
+// Profile reports every invalid field
+//
+//validate:multierror
+type Profile struct {
+	Name string `validate:"required"`
+	Bio  string `validate:"max=10"`
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/n10ty/houp"
+)
+
+func loadCatalog(t *testing.T, locale string) houp.Catalog {
+	t.Helper()
+	data, err := os.ReadFile("validation.messages." + locale + ".json")
+	if err != nil {
+		t.Fatalf("failed to read catalog: %v", err)
+	}
+	c, err := houp.ParseCatalog(data)
+	if err != nil {
+		t.Fatalf("ParseCatalog() error = %v", err)
+	}
+	return c
+}
+
+func TestSignup_ValidateLocalized(t *testing.T) {
+	de := loadCatalog(t, "de")
+	tests := []struct {
+		name   string
+		signup Signup
+		wantEn string
+		wantDe string
+	}{
+		{
+			name:   "parameter rule",
+			signup: Signup{Username: "al", Email: "a@example.com"},
+			wantEn: "field Username must be at least 3 characters",
+			wantDe: "Feld Username muss mindestens 3 Zeichen lang sein",
+		},
+		{
+			name:   "custom message",
+			signup: Signup{Username: "alice", Email: "nope"},
+			wantEn: "Please check the email address",
+			wantDe: "Bitte prüfen Sie die E-Mail-Adresse",
+		},
+		{
+			name:   "dive element",
+			signup: Signup{Username: "alice", Email: "a@example.com", Tags: []string{"go", "toolong"}},
+			wantEn: "field Tags[1] must be at most 5 characters",
+			wantDe: "Feld Tags[1] darf höchstens 5 Zeichen lang sein",
+		},
+		{
+			name:   "nested struct",
+			signup: Signup{Username: "alice", Email: "a@example.com", Items: []Item{{}}},
+			wantEn: "field Items[0] validation failed: field SKU is required",
+			wantDe: "Feld Items[0] ist ungültig: Feld SKU ist erforderlich",
+		},
+		{
+			// Keys missing from the catalog keep the built-in message
+			name:   "untranslated key",
+			signup: Signup{Email: "a@example.com"},
+			wantEn: "field Username is required",
+			wantDe: "field Username is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signup.Validate()
+			if err == nil || err.Error() != tt.wantEn {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantEn)
+			}
+			if got := houp.Localize(err, de); got != tt.wantDe {
+				t.Errorf("Localize() = %q, want %q", got, tt.wantDe)
+			}
+
+			houp.SetCatalog(de)
+			defer houp.SetCatalog(nil)
+			err = tt.signup.Validate()
+			if err == nil || err.Error() != tt.wantDe {
+				t.Errorf("Validate() with catalog error = %v, want %q", err, tt.wantDe)
+			}
+		})
+	}
+}
+
+func TestSignup_ValidateKeepsNestedError(t *testing.T) {
+	s := Signup{Username: "alice", Email: "a@example.com", Items: []Item{{}}}
+	var msgErr *houp.MessageError
+	if err := s.Validate(); !errors.As(errors.Unwrap(err), &msgErr) || msgErr.Key != "Item.SKU.required" {
+		t.Errorf("Validate() error = %v, want it to wrap the Item.SKU.required error", err)
+	}
+}
+
+func TestProfile_ValidateLocalizedJoined(t *testing.T) {
+	err := (&Profile{Bio: "far too long"}).Validate()
+	want := "Feld Name ist erforderlich\nFeld Bio darf höchstens 10 Zeichen lang sein"
+	if got := houp.Localize(err, loadCatalog(t, "de")); got != want {
+		t.Errorf("Localize() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+package include_tests
+
+// orderRequest is a test-only request builder; with --include-tests its
+// Validate method goes to validation.gen_test.go
+type orderRequest struct {
+	Order   Order    `json:"order"`
+	Email   string   `json:"email" validate:"required,email"`
+	Coupons []string `json:"coupons" validate:"max=3,dive,min=4"`
+}
+
+// orderFixture embeds an Order and checks its own tags
+type orderFixture struct {
+	Name  string   `json:"name" validate:"required"`
+	Items []*Order `json:"items" validate:"dive"`
+}
@@ -0,0 +1,74 @@
+package include_tests
+
+import (
+	"testing"
+)
+
+func TestOrderRequestValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     orderRequest
+		wantErr bool
+	}{
+		{
+			name: "valid request",
+			req: orderRequest{
+				Order:   Order{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Quantity: 1},
+				Email:   "buyer@example.com",
+				Coupons: []string{"SAVE10"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid email",
+			req: orderRequest{
+				Order:   Order{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Quantity: 1},
+				Email:   "not-an-email",
+				Coupons: []string{"SAVE10"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "coupon too short",
+			req: orderRequest{
+				Order:   Order{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Quantity: 1},
+				Email:   "buyer@example.com",
+				Coupons: []string{"X"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many coupons",
+			req: orderRequest{
+				Order:   Order{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Quantity: 1},
+				Email:   "buyer@example.com",
+				Coupons: []string{"AAAA", "BBBB", "CCCC", "DDDD"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("orderRequest.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderFixtureValidation(t *testing.T) {
+	f := orderFixture{
+		Name:  "two orders",
+		Items: []*Order{{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Quantity: 2}, {ID: "bad", Quantity: 1}},
+	}
+	if err := f.Validate(); err == nil {
+		t.Error("orderFixture.Validate() expected error for invalid nested Order")
+	}
+
+	f.Items[1].ID = "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10"
+	if err := f.Validate(); err != nil {
+		t.Errorf("orderFixture.Validate() unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package include_tests
+
+// Order is a regular struct; its Validate method goes to validation.gen.go, along
+// with the email regexp that orderRequest's Validate method uses too
+type Order struct {
+	ID       string `json:"id" validate:"required,uuid4"`
+	Quantity int    `json:"quantity" validate:"min=1"`
+	Contact  string `json:"contact" validate:"omitempty,email"`
+}
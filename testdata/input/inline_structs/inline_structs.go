@@ -0,0 +1,26 @@
+package inline_structs
+
+// Order declares its shipping details and lines as anonymous structs
+type Order struct {
+	ID       string `validate:"required"`
+	Shipping struct {
+		City    string `validate:"required"`
+		Country string `validate:"required,len=2"`
+		Contact struct {
+			Email string `validate:"required,email"`
+		}
+	}
+	Billing *struct {
+		VAT string `validate:"required,min=8"`
+	} `validate:"omitempty"`
+	Lines []struct {
+		SKU      string `validate:"required"`
+		Quantity int    `validate:"gt=0"`
+	} `validate:"required,dive,unique=SKU"`
+	Discounts map[string]*struct {
+		Percent float64 `validate:"gt=0,lte=100"`
+	} `validate:"dive,keys,min=3,endkeys,required"`
+	Notes struct {
+		Text string
+	}
+}
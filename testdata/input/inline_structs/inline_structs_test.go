@@ -0,0 +1,142 @@
+package inline_structs
+
+import "testing"
+
+// Aliases of the anonymous struct types declared in Order
+type (
+	contact = struct {
+		Email string `validate:"required,email"`
+	}
+	shipping = struct {
+		City    string `validate:"required"`
+		Country string `validate:"required,len=2"`
+		Contact struct {
+			Email string `validate:"required,email"`
+		}
+	}
+	billing = struct {
+		VAT string `validate:"required,min=8"`
+	}
+	line = struct {
+		SKU      string `validate:"required"`
+		Quantity int    `validate:"gt=0"`
+	}
+	discount = struct {
+		Percent float64 `validate:"gt=0,lte=100"`
+	}
+)
+
+func TestOrderValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   Order
+		wantErr string
+	}{
+		{
+			name: "valid",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:    []line{{SKU: "A-1", Quantity: 2}},
+			},
+		},
+		{
+			name: "shipping city",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:    []line{{SKU: "A-1", Quantity: 2}},
+			},
+			wantErr: "field Shipping validation failed: field City is required",
+		},
+		{
+			name: "nested contact",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops"}},
+				Lines:    []line{{SKU: "A-1", Quantity: 2}},
+			},
+			wantErr: "field Shipping validation failed: field Contact validation failed: field Email must be a valid email address",
+		},
+		{
+			name: "billing",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Billing:  &billing{VAT: "FR1"},
+				Lines:    []line{{SKU: "A-1", Quantity: 2}},
+			},
+			wantErr: "field Billing validation failed: field VAT must be at least 8 characters",
+		},
+		{
+			name: "no lines",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+			},
+			wantErr: "field Lines is required",
+		},
+		{
+			name: "line quantity",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:    []line{{SKU: "A-1", Quantity: 0}},
+			},
+			wantErr: "field Lines[0] validation failed: field Quantity must be greater than 0",
+		},
+		{
+			name: "duplicate line",
+			order: Order{
+				ID:       "o-1",
+				Shipping: shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:    []line{{SKU: "A-1", Quantity: 2}, {SKU: "A-1", Quantity: 2}},
+			},
+			wantErr: "field Lines has duplicate SKU at index 1",
+		},
+		{
+			name: "discount",
+			order: Order{
+				ID:        "o-1",
+				Shipping:  shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:     []line{{SKU: "A-1", Quantity: 2}},
+				Discounts: map[string]*discount{"summer": {Percent: 120}},
+			},
+			wantErr: `field Discounts["summer"] validation failed: field Percent must be at most 100`,
+		},
+		{
+			name: "nil discount",
+			order: Order{
+				ID:        "o-1",
+				Shipping:  shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:     []line{{SKU: "A-1", Quantity: 2}},
+				Discounts: map[string]*discount{"summer": nil},
+			},
+			wantErr: `field Discounts["summer"] is required`,
+		},
+		{
+			name: "discount key",
+			order: Order{
+				ID:        "o-1",
+				Shipping:  shipping{City: "Lyon", Country: "FR", Contact: contact{Email: "ops@example.com"}},
+				Lines:     []line{{SKU: "A-1", Quantity: 2}},
+				Discounts: map[string]*discount{"x": {Percent: 10}},
+			},
+			wantErr: `field Discounts key "x" must be at least 3 characters`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
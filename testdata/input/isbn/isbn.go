@@ -0,0 +1,9 @@
+package isbn
+
+// Book demonstrates ISBN validation
+type Book struct {
+	ISBN     string   `json:"isbn" validate:"required,isbn"`
+	ISBN10   string   `json:"isbn10" validate:"isbn10"`
+	ISBN13   *string  `json:"isbn13" validate:"omitempty,isbn13"`
+	Editions []string `json:"editions" validate:"omitempty,dive,isbn13"`
+}
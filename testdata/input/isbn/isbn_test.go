@@ -0,0 +1,74 @@
+package isbn
+
+import "testing"
+
+func TestBookValidation(t *testing.T) {
+	valid13 := "978-3-16-148410-0"
+	invalid13 := "978-3-16-148410-1"
+
+	tests := []struct {
+		name    string
+		book    Book
+		wantErr bool
+	}{
+		{
+			name:    "valid ISBN-10 in isbn field",
+			book:    Book{ISBN: "0-306-40615-2", ISBN10: "0306406152"},
+			wantErr: false,
+		},
+		{
+			name:    "valid ISBN-13 in isbn field",
+			book:    Book{ISBN: "9780306406157", ISBN10: "0306406152"},
+			wantErr: false,
+		},
+		{
+			name:    "ISBN-10 with X check digit",
+			book:    Book{ISBN: "080442957X", ISBN10: "080442957X"},
+			wantErr: false,
+		},
+		{
+			name:    "bad ISBN-10 checksum",
+			book:    Book{ISBN: "9780306406157", ISBN10: "0306406153"},
+			wantErr: true,
+		},
+		{
+			name:    "ISBN-13 rejected by isbn10",
+			book:    Book{ISBN: "9780306406157", ISBN10: "9780306406157"},
+			wantErr: true,
+		},
+		{
+			name:    "missing required ISBN",
+			book:    Book{ISBN10: "0306406152"},
+			wantErr: true,
+		},
+		{
+			name:    "valid optional ISBN-13",
+			book:    Book{ISBN: "9780306406157", ISBN10: "0306406152", ISBN13: &valid13},
+			wantErr: false,
+		},
+		{
+			name:    "invalid optional ISBN-13",
+			book:    Book{ISBN: "9780306406157", ISBN10: "0306406152", ISBN13: &invalid13},
+			wantErr: true,
+		},
+		{
+			name:    "invalid edition",
+			book:    Book{ISBN: "9780306406157", ISBN10: "0306406152", Editions: []string{"9780306406157", "12345"}},
+			wantErr: true,
+		},
+		{
+			name:    "letters in ISBN",
+			book:    Book{ISBN: "97803064O6157", ISBN10: "0306406152"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.book.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Book.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package iso3166_codes
+
+// Shipment uses alpha-3 and numeric ISO 3166-1 country codes
+type Shipment struct {
+	Origin      string  `json:"origin" validate:"required,iso3166_1_alpha3"`
+	Destination *string `json:"destination" validate:"omitempty,iso3166_1_alpha3"`
+	OriginCode  string  `json:"origin_code" validate:"iso3166_1_numeric"`
+	Transit     *string `json:"transit" validate:"omitempty,iso3166_1_numeric"`
+}
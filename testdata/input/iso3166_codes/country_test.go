@@ -0,0 +1,32 @@
+package iso3166_codes
+
+import "testing"
+
+func TestShipmentValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		shipment Shipment
+		wantErr  bool
+	}{
+		{name: "valid", shipment: Shipment{Origin: "USA", OriginCode: "840"}, wantErr: false},
+		{name: "valid with optional", shipment: Shipment{Origin: "UKR", Destination: strPtr("DEU"), OriginCode: "804", Transit: strPtr("616")}, wantErr: false},
+		{name: "kosovo alpha-3", shipment: Shipment{Origin: "XKX", OriginCode: "004"}, wantErr: false},
+		{name: "alpha-2 instead of alpha-3", shipment: Shipment{Origin: "US", OriginCode: "840"}, wantErr: true},
+		{name: "lowercase alpha-3", shipment: Shipment{Origin: "usa", OriginCode: "840"}, wantErr: true},
+		{name: "unknown destination", shipment: Shipment{Origin: "USA", Destination: strPtr("ZZZ"), OriginCode: "840"}, wantErr: true},
+		{name: "numeric without leading zeros", shipment: Shipment{Origin: "AFG", OriginCode: "4"}, wantErr: true},
+		{name: "unknown numeric", shipment: Shipment{Origin: "USA", OriginCode: "999"}, wantErr: true},
+		{name: "unknown transit", shipment: Shipment{Origin: "USA", OriginCode: "840", Transit: strPtr("000")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.shipment.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shipment.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package iso639
+
+// Translation describes localized content with ISO 639 language codes
+type Translation struct {
+	Language  string  `json:"language" validate:"required,iso639_1"`
+	Fallback  *string `json:"fallback" validate:"omitempty,iso639_1"`
+	Catalogue string  `json:"catalogue" validate:"iso639_2"`
+	Subtitles *string `json:"subtitles" validate:"omitempty,iso639_2"`
+}
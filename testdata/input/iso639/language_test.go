@@ -0,0 +1,32 @@
+package iso639
+
+import "testing"
+
+func TestTranslationValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		translation Translation
+		wantErr     bool
+	}{
+		{name: "valid", translation: Translation{Language: "en", Catalogue: "eng"}, wantErr: false},
+		{name: "valid with optional", translation: Translation{Language: "uk", Fallback: strPtr("de"), Catalogue: "ukr", Subtitles: strPtr("fra")}, wantErr: false},
+		{name: "bibliographic code", translation: Translation{Language: "de", Catalogue: "ger"}, wantErr: false},
+		{name: "terminology code", translation: Translation{Language: "de", Catalogue: "deu"}, wantErr: false},
+		{name: "uppercase two-letter", translation: Translation{Language: "EN", Catalogue: "eng"}, wantErr: true},
+		{name: "three-letter as iso639_1", translation: Translation{Language: "eng", Catalogue: "eng"}, wantErr: true},
+		{name: "unknown fallback", translation: Translation{Language: "en", Fallback: strPtr("xx"), Catalogue: "eng"}, wantErr: true},
+		{name: "two-letter as iso639_2", translation: Translation{Language: "en", Catalogue: "en"}, wantErr: true},
+		{name: "unknown subtitles", translation: Translation{Language: "en", Catalogue: "eng", Subtitles: strPtr("qqq")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.translation.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Translation.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,4 +13,11 @@ type JSONNumberValidation struct {
 // JSONNumberPointer tests validation for pointer to json.Number
 type JSONNumberPointer struct {
 	Amount *json.Number `json:"amount" validate:"gte=0"`
+	Limit  *json.Number `json:"limit" validate:"min=1,max=10"`
+}
+
+// JSONNumberSlice tests per-element validation for slices of json.Number
+type JSONNumberSlice struct {
+	Prices  []json.Number  `json:"prices" validate:"required,dive,gte=0,lte=1000"`
+	Weights []*json.Number `json:"weights" validate:"omitempty,dive,gt=0"`
 }
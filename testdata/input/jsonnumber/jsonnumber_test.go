@@ -0,0 +1,99 @@
+package jsonnumber
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONNumberSliceValidation(t *testing.T) {
+	positive := json.Number("2.5")
+	zero := json.Number("0")
+
+	tests := []struct {
+		name    string
+		s       JSONNumberSlice
+		wantErr bool
+	}{
+		{
+			name:    "valid prices",
+			s:       JSONNumberSlice{Prices: []json.Number{"0", "10.5", "1000"}},
+			wantErr: false,
+		},
+		{
+			name:    "missing prices",
+			s:       JSONNumberSlice{},
+			wantErr: true,
+		},
+		{
+			name:    "negative price",
+			s:       JSONNumberSlice{Prices: []json.Number{"1", "-1"}},
+			wantErr: true,
+		},
+		{
+			name:    "price above maximum",
+			s:       JSONNumberSlice{Prices: []json.Number{"1000.01"}},
+			wantErr: true,
+		},
+		{
+			name:    "not a number",
+			s:       JSONNumberSlice{Prices: []json.Number{"abc"}},
+			wantErr: true,
+		},
+		{
+			name:    "nil weight is skipped",
+			s:       JSONNumberSlice{Prices: []json.Number{"1"}, Weights: []*json.Number{nil, &positive}},
+			wantErr: false,
+		},
+		{
+			name:    "zero weight",
+			s:       JSONNumberSlice{Prices: []json.Number{"1"}, Weights: []*json.Number{&zero}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONNumberSlice.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONNumberSliceErrorIndex(t *testing.T) {
+	s := JSONNumberSlice{Prices: []json.Number{"1", "abc"}}
+	err := s.Validate()
+	if err == nil {
+		t.Fatal("expected error for invalid number")
+	}
+	want := `field Prices[1] must be a valid number: strconv.ParseFloat: parsing "abc": invalid syntax`
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestJSONNumberPointerMinMax(t *testing.T) {
+	amount := json.Number("0")
+	tests := []struct {
+		name    string
+		limit   json.Number
+		wantErr bool
+	}{
+		{"within range", "5", false},
+		{"below min", "0.5", true},
+		{"above max", "11", true},
+		{"not a number", "abc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit := tt.limit
+			p := JSONNumberPointer{Amount: &amount, Limit: &limit}
+			err := p.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONNumberPointer.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
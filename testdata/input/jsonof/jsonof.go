@@ -0,0 +1,22 @@
+package jsonof
+
+import "encoding/json"
+
+// OrderCreated is the payload of an order.created event
+type OrderCreated struct {
+	OrderID string `json:"order_id" validate:"required"`
+	Total   int    `json:"total" validate:"gt=0"`
+}
+
+// Refund has no rules of its own; jsonof still decodes into it
+type Refund struct {
+	Reason string `json:"reason"`
+}
+
+// Envelope carries payloads whose schema is known at compile time
+type Envelope struct {
+	Type    string          `validate:"required"`
+	Payload json.RawMessage `validate:"jsonof=OrderCreated"`
+	Refund  []byte          `validate:"omitempty,jsonof=Refund"`
+	Failure *[]byte         `validate:"jsonof=github.com/n10ty/houp/testdata/input/dive_cross_package/models:Error"`
+}
@@ -0,0 +1,40 @@
+package jsonof
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnvelopeValidate(t *testing.T) {
+	failure := []byte(`{"code":"E1","langCode":"en","typeCode":"ERROR"}`)
+	incomplete := []byte(`{"code":"E1"}`)
+
+	tests := []struct {
+		name    string
+		value   Envelope
+		wantErr string
+	}{
+		{name: "valid", value: Envelope{Type: "order.created", Payload: []byte(`{"order_id":"o-1","total":5}`)}},
+		{name: "with refund and failure", value: Envelope{Type: "order.refunded", Payload: []byte(`{"order_id":"o-1","total":5}`), Refund: []byte(`{"reason":"late"}`), Failure: &failure}},
+		{name: "missing payload", value: Envelope{Type: "order.created"}, wantErr: "field Payload must be a JSON OrderCreated: unexpected end of JSON input"},
+		{name: "malformed payload", value: Envelope{Type: "order.created", Payload: []byte(`{"order_id":`)}, wantErr: "field Payload must be a JSON OrderCreated: "},
+		{name: "wrong field type", value: Envelope{Type: "order.created", Payload: []byte(`{"order_id":1}`)}, wantErr: "field Payload must be a JSON OrderCreated: "},
+		{name: "invalid payload", value: Envelope{Type: "order.created", Payload: []byte(`{"order_id":"o-1"}`)}, wantErr: "field Payload validation failed: field Total must be greater than 0"},
+		{name: "malformed refund", value: Envelope{Type: "order.refunded", Payload: []byte(`{"order_id":"o-1","total":5}`), Refund: []byte(`nope`)}, wantErr: "field Refund must be a JSON Refund: "},
+		{name: "invalid failure", value: Envelope{Type: "order.failed", Payload: []byte(`{"order_id":"o-1","total":5}`), Failure: &incomplete}, wantErr: "field Failure validation failed: field LangCode is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.value.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v, want prefix %q", err, tt.wantErr)
+			}
+		})
+	}
+}
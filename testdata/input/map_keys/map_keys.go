@@ -0,0 +1,19 @@
+package map_keys
+
+import "net/url"
+
+// Quota is a per-tenant limit
+type Quota struct {
+	Limit int `validate:"gt=0"`
+}
+
+// Ports maps a port number to a service name
+type Ports map[int]string
+
+// Routing checks map keys between keys and endkeys
+type Routing struct {
+	Tenants map[string]Quota  `validate:"required,dive,keys,uuid,endkeys"`
+	Labels  map[string]string `validate:"dive,keys,min=2,max=8,endkeys,required"`
+	Ports   Ports             `validate:"dive,keys,gte=1024,endkeys"`
+	Query   url.Values        `validate:"dive,keys,printable,endkeys,max=16"`
+}
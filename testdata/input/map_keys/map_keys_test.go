@@ -0,0 +1,118 @@
+package map_keys
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRoutingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		routing Routing
+		wantErr string
+	}{
+		{
+			name: "valid",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+		},
+		{
+			name: "invalid key",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}, "acme": {Limit: 1}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: `field Tenants key "acme" must be a valid UUID`,
+		},
+		{
+			name: "invalid value",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}, "6ba7b811-9dad-11d1-80b4-00c04fd430c8": {}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: `field Tenants["6ba7b811-9dad-11d1-80b4-00c04fd430c8"] validation failed: field Limit must be greater than 0`,
+		},
+		{
+			name: "short key",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod", "a": "b"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: `field Labels key "a" must be at least 2 characters`,
+		},
+		{
+			name: "long key",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod", "environment": "b"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: `field Labels key "environment" must be at most 8 characters`,
+		},
+		{
+			name: "empty value",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod", "team": ""},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: `field Labels["team"] is required`,
+		},
+		{
+			name: "non-string key",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api", 80: "http"},
+				Query:   url.Values{"q": {"go"}},
+			},
+			wantErr: "field Ports key 80 must be at least 1024",
+		},
+		{
+			name: "multi-value key",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go"}, "a\nb": {"x"}},
+			},
+			wantErr: `field Query key "a\nb" must contain only printable characters`,
+		},
+		{
+			name: "multi-value value",
+			routing: Routing{
+				Tenants: map[string]Quota{"6ba7b810-9dad-11d1-80b4-00c04fd430c8": {Limit: 10}},
+				Labels:  map[string]string{"env": "prod"},
+				Ports:   Ports{8080: "api"},
+				Query:   url.Values{"q": {"go", "a very long search query"}},
+			},
+			wantErr: `field Query["q"][1] must be at most 16 characters`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.routing.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package mapfields
+
+// Labels is a named map type
+type Labels map[string]string
+
+// Deployment demonstrates length rules on maps, slices and strings
+type Deployment struct {
+	// Between 1 and 20 labels
+	Labels map[string]string `json:"labels" validate:"required,min=1,max=20"`
+
+	// Named map type, checked only when set
+	Annotations Labels `json:"annotations" validate:"omitempty,max=2"`
+
+	// Exactly one replica count per zone
+	Replicas map[string]int `json:"replicas" validate:"len=3"`
+
+	// Pointer to a map
+	Limits *map[string]int64 `json:"limits" validate:"omitempty,min=1"`
+
+	// len on slices and strings
+	Zones  []string `json:"zones" validate:"len=3"`
+	Region string   `json:"region" validate:"len=2"`
+	Code   string   `json:"code" validate:"len=3,runes"`
+}
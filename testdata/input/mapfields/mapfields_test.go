@@ -0,0 +1,169 @@
+package mapfields
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeploymentValidate(t *testing.T) {
+	empty := map[string]int64{}
+	limits := map[string]int64{"cpu": 2}
+	manyLabels := map[string]string{}
+	for _, k := range strings.Split("abcdefghijklmnopqrstu", "") {
+		manyLabels[k] = k
+	}
+
+	tests := []struct {
+		name       string
+		deployment Deployment
+		wantErr    string
+	}{
+		{
+			name: "valid",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+		},
+		{
+			name: "pointer map with keys",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Limits:   &limits,
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+		},
+		{
+			name: "nil labels",
+			deployment: Deployment{
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Labels is required",
+		},
+		{
+			name: "empty labels",
+			deployment: Deployment{
+				Labels:   map[string]string{},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Labels is required",
+		},
+		{
+			name: "too many labels",
+			deployment: Deployment{
+				Labels:   manyLabels,
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Labels must have at most 20 keys",
+		},
+		{
+			name: "empty annotations are skipped",
+			deployment: Deployment{
+				Labels:      map[string]string{"app": "api"},
+				Annotations: Labels{},
+				Replicas:    map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:       []string{"a", "b", "c"},
+				Region:      "eu",
+				Code:        "äöü",
+			},
+		},
+		{
+			name: "too many annotations",
+			deployment: Deployment{
+				Labels:      map[string]string{"app": "api"},
+				Annotations: Labels{"a": "1", "b": "2", "c": "3"},
+				Replicas:    map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:       []string{"a", "b", "c"},
+				Region:      "eu",
+				Code:        "äöü",
+			},
+			wantErr: "field Annotations must have at most 2 keys",
+		},
+		{
+			name: "wrong replica count",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Replicas must have exactly 3 keys",
+		},
+		{
+			name: "empty pointer map",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Limits:   &empty,
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Limits must have at least 1 keys",
+		},
+		{
+			name: "wrong zone count",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b"},
+				Region:   "eu",
+				Code:     "äöü",
+			},
+			wantErr: "field Zones must have exactly 3 elements",
+		},
+		{
+			name: "wrong region length",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu-west",
+				Code:     "äöü",
+			},
+			wantErr: "field Region must be exactly 2 characters",
+		},
+		{
+			name: "code counted in bytes would fail",
+			deployment: Deployment{
+				Labels:   map[string]string{"app": "api"},
+				Replicas: map[string]int{"a": 1, "b": 1, "c": 2},
+				Zones:    []string{"a", "b", "c"},
+				Region:   "eu",
+				Code:     "abcd",
+			},
+			wantErr: "field Code must be exactly 3 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.deployment.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
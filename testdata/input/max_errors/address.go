@@ -0,0 +1,9 @@
+package max_errors
+
+// Address has more fields than the errors reported at once
+type Address struct {
+	Street  string `validate:"required"`
+	City    string `validate:"required"`
+	Zip     string `validate:"required,len=5"`
+	Country string `validate:"required,iso3166_1_alpha2"`
+}
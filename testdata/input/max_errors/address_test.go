@@ -0,0 +1,45 @@
+package max_errors
+
+import "testing"
+
+func TestAddress_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		address Address
+		wantErr string
+	}{
+		{
+			name:    "capped",
+			address: Address{},
+			wantErr: "field Street is required\nfield City is required",
+		},
+		{
+			name:    "below the cap",
+			address: Address{Street: "Main St 1", City: "Berlin", Zip: "101"},
+			wantErr: "field Zip must be exactly 5 characters\nfield Country is required",
+		},
+		{
+			name:    "one error",
+			address: Address{Street: "Main St 1", City: "Berlin", Zip: "10115"},
+			wantErr: "field Country is required",
+		},
+		{
+			name:    "valid",
+			address: Address{Street: "Main St 1", City: "Berlin", Zip: "10115", Country: "DE"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.address.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package message_templates
+
+// Article is generated with the message templates of houp.json
+type Article struct {
+	Title  string   `validate:"required,min=5,max=80"`
+	Status string   `validate:"oneof=draft published"`
+	Tags   []string `validate:"dive,required,max=10"`
+	Slug   string   `validate:"required~Slug cannot be empty,max=20"`
+	Score  int      `validate:"gte=0,lte=100"`
+}
@@ -0,0 +1,100 @@
+package message_templates
+
+import "testing"
+
+func TestArticle_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		article Article
+		wantErr string
+	}{
+		{
+			name: "valid",
+			article: Article{
+				Title:  "Hello world",
+				Status: "draft",
+				Tags:   []string{"go"},
+				Slug:   "hello",
+				Score:  50,
+			},
+		},
+		{
+			name: "required template",
+			article: Article{
+				Status: "draft",
+				Tags:   []string{"go"},
+				Slug:   "hello",
+				Score:  50,
+			},
+			wantErr: "Title is mandatory",
+		},
+		{
+			name: "min template",
+			article: Article{
+				Title:  "Hi",
+				Status: "draft",
+				Tags:   []string{"go"},
+				Slug:   "hello",
+				Score:  50,
+			},
+			wantErr: "Title must contain at least 5 characters",
+		},
+		{
+			name: "oneof template",
+			article: Article{
+				Title:  "Hello world",
+				Status: "gone",
+				Tags:   []string{"go"},
+				Slug:   "hello",
+				Score:  50,
+			},
+			wantErr: "Status must be one of [draft published]",
+		},
+		{
+			name: "element template",
+			article: Article{
+				Title:  "Hello world",
+				Status: "draft",
+				Tags:   []string{"go", "averyverylongtag"},
+				Slug:   "hello",
+				Score:  50,
+			},
+			wantErr: "Tags[1] must not be longer than 10 characters",
+		},
+		{
+			name: "custom message wins",
+			article: Article{
+				Title:  "Hello world",
+				Status: "draft",
+				Tags:   []string{"go"},
+				Score:  50,
+			},
+			wantErr: "Slug cannot be empty",
+		},
+		{
+			name: "no template",
+			article: Article{
+				Title:  "Hello world",
+				Status: "draft",
+				Tags:   []string{"go"},
+				Slug:   "hello",
+				Score:  101,
+			},
+			wantErr: "field Score must be at most 100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.article.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package method_name
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Order keeps its handwritten Validate while migrating to generated checks
+type Order struct {
+	ID       string          `validate:"required"`
+	Lines    []Line          `validate:"min=1,dive"`
+	Shipping json.RawMessage `validate:"omitempty,jsonof=Address"`
+}
+
+// Validate runs the generated checks and the rules not expressed in tags yet
+func (o *Order) Validate() error {
+	if err := o.ValidateInput(); err != nil {
+		return err
+	}
+	if len(o.ID) > 0 && o.ID[0] == '-' {
+		return errors.New("order IDs must not start with a dash")
+	}
+	return nil
+}
+
+// Line is diven into, so Order calls its generated method
+type Line struct {
+	SKU      string `validate:"required"`
+	Quantity int    `validate:"gte=1"`
+}
+
+// Address is decoded by jsonof and renames its method itself
+//
+//validate:method=Check
+type Address struct {
+	City string `validate:"required"`
+}
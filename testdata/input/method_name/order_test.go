@@ -0,0 +1,64 @@
+package method_name
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrder_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   Order
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			order: Order{ID: "A-1", Lines: []Line{{SKU: "bolt", Quantity: 2}}, Shipping: json.RawMessage(`{"City":"Berlin"}`)},
+		},
+		{
+			name:    "generated check",
+			order:   Order{ID: "A-1"},
+			wantErr: "field Lines must have at least 1 elements",
+		},
+		{
+			name:    "line",
+			order:   Order{ID: "A-1", Lines: []Line{{SKU: "bolt"}}},
+			wantErr: "field Lines[0] validation failed: field Quantity must be at least 1",
+		},
+		{
+			name:    "shipping",
+			order:   Order{ID: "A-1", Lines: []Line{{SKU: "bolt", Quantity: 2}}, Shipping: json.RawMessage(`{}`)},
+			wantErr: "field Shipping validation failed: field City is required",
+		},
+		{
+			name:    "handwritten check",
+			order:   Order{ID: "-1", Lines: []Line{{SKU: "bolt", Quantity: 2}}},
+			wantErr: "order IDs must not start with a dash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValid(t *testing.T) {
+	// Valid reports the result of the generated method, not the handwritten Validate
+	order := Order{ID: "-1", Lines: []Line{{SKU: "bolt", Quantity: 2}}}
+	if !order.Valid() {
+		t.Error("Valid() = false, want true")
+	}
+	if (&Address{}).Valid() {
+		t.Error("Address.Valid() = true, want false")
+	}
+}
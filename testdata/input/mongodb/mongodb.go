@@ -0,0 +1,8 @@
+package mongodb
+
+// Order demonstrates ObjectID validation for documents referenced by Mongo IDs
+type Order struct {
+	ID         string  `json:"id" validate:"required,mongodb"`
+	CustomerID string  `json:"customer_id" validate:"required,mongodb"`
+	CouponID   *string `json:"coupon_id" validate:"omitempty,mongodb"`
+}
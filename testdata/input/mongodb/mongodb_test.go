@@ -0,0 +1,31 @@
+package mongodb
+
+import (
+	"testing"
+)
+
+func TestOrderValidation(t *testing.T) {
+	coupon, short := "65f1c0a2B3d4e5f6a7b8c9d0", "65f1c0a2b3d4e5f6a7b8c9d"
+
+	tests := []struct {
+		name    string
+		o       Order
+		wantErr bool
+	}{
+		{name: "valid", o: Order{ID: "507f1f77bcf86cd799439011", CustomerID: "65f1c0a2b3d4e5f6a7b8c9d0"}},
+		{name: "mixed case pointer", o: Order{ID: "507f1f77bcf86cd799439011", CustomerID: "507F1F77BCF86CD799439011", CouponID: &coupon}},
+		{name: "too long", o: Order{ID: "507f1f77bcf86cd7994390110", CustomerID: "65f1c0a2b3d4e5f6a7b8c9d0"}, wantErr: true},
+		{name: "not hex", o: Order{ID: "507f1f77bcf86cd79943901g", CustomerID: "65f1c0a2b3d4e5f6a7b8c9d0"}, wantErr: true},
+		{name: "missing", o: Order{ID: "507f1f77bcf86cd799439011"}, wantErr: true},
+		{name: "short pointer", o: Order{ID: "507f1f77bcf86cd799439011", CustomerID: "65f1c0a2b3d4e5f6a7b8c9d0", CouponID: &short}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.o.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Order.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
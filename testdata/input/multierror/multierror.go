@@ -0,0 +1,20 @@
+package multierror
+
+// Signup reports every invalid field at once
+//
+//validate:multierror
+//validate:checkPasswords
+type Signup struct {
+	Email    string   `validate:"required,email"`
+	Age      *int     `validate:"required,gte=18"`
+	Password string   `validate:"required,min=8"`
+	Confirm  string   `validate:"required"`
+	Tags     []string `validate:"omitempty,dive,required"`
+	Admin    bool     `validate:"required"`
+}
+
+// Login stops at the first invalid field
+type Login struct {
+	Email    string `validate:"required,email"`
+	Password string `validate:"required"`
+}
@@ -0,0 +1,68 @@
+package multierror
+
+import (
+	"strings"
+	"testing"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestSignup_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		signup   Signup
+		wantErrs []string
+	}{
+		{
+			name:   "valid",
+			signup: Signup{Email: "a@example.com", Age: intPtr(30), Password: "password1", Confirm: "password1"},
+		},
+		{
+			name:   "every field invalid",
+			signup: Signup{Email: "nope", Password: "short", Tags: []string{""}},
+			wantErrs: []string{
+				"field Email must be a valid email address",
+				"field Age is required",
+				"field Password must be at least 8 characters",
+				"field Confirm is required",
+				"field Tags[0] is required",
+				"struct validation failed: passwords do not match",
+			},
+		},
+		{
+			name:     "one field invalid",
+			signup:   Signup{Email: "a@example.com", Age: intPtr(17), Password: "password1", Confirm: "password1"},
+			wantErrs: []string{"field Age must be at least 18"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signup.Validate()
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() error = nil, want errors")
+			}
+			got := strings.Split(err.Error(), "\n")
+			if len(got) != len(tt.wantErrs) {
+				t.Fatalf("Validate() returned %d errors, want %d:\n%v", len(got), len(tt.wantErrs), err)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLogin_Validate_FirstError(t *testing.T) {
+	err := (&Login{}).Validate()
+	if err == nil || err.Error() != "field Email is required" {
+		t.Errorf("Validate() error = %v, want only the first failure", err)
+	}
+}
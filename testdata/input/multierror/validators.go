@@ -0,0 +1,10 @@
+package multierror
+
+import "fmt"
+
+func checkPasswords(s *Signup) error {
+	if s.Password != s.Confirm {
+		return fmt.Errorf("passwords do not match")
+	}
+	return nil
+}
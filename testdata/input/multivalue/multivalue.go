@@ -0,0 +1,21 @@
+package multivalue
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// UpstreamConfig describes a proxied request
+type UpstreamConfig struct {
+	// Query parameters must be present and few
+	Query url.Values `json:"query" validate:"required,max=20"`
+
+	// Every header value must be non-empty and bounded
+	Headers http.Header `json:"headers" validate:"min=1,dive,min=1,max=256"`
+
+	// Optional trailers, checked only when set
+	Trailers http.Header `json:"trailers" validate:"omitempty,max=4,dive,email"`
+
+	// Pointer to url.Values
+	Defaults *url.Values `json:"defaults" validate:"omitempty,min=1,dive,max=8"`
+}
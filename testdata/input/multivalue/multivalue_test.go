@@ -0,0 +1,98 @@
+package multivalue
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestUpstreamConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  UpstreamConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: UpstreamConfig{
+				Query:   url.Values{"q": {"go"}},
+				Headers: http.Header{"Accept": {"application/json"}},
+			},
+		},
+		{
+			name: "missing query",
+			config: UpstreamConfig{
+				Query:   url.Values{},
+				Headers: http.Header{"Accept": {"application/json"}},
+			},
+			wantErr: "field Query is required",
+		},
+		{
+			name: "no headers",
+			config: UpstreamConfig{
+				Query: url.Values{"q": {"go"}},
+			},
+			wantErr: "field Headers must have at least 1 keys",
+		},
+		{
+			name: "empty header value",
+			config: UpstreamConfig{
+				Query:   url.Values{"q": {"go"}},
+				Headers: http.Header{"Accept": {"application/json", ""}},
+			},
+			wantErr: `field Headers["Accept"][1] must be at least 1 characters`,
+		},
+		{
+			name: "trailer value not an email",
+			config: UpstreamConfig{
+				Query:    url.Values{"q": {"go"}},
+				Headers:  http.Header{"Accept": {"application/json"}},
+				Trailers: http.Header{"From": {"ops@example.com", "nobody"}},
+			},
+			wantErr: `field Trailers["From"][1] must be a valid email address`,
+		},
+		{
+			name: "too many trailers",
+			config: UpstreamConfig{
+				Query:    url.Values{"q": {"go"}},
+				Headers:  http.Header{"Accept": {"application/json"}},
+				Trailers: http.Header{"A": nil, "B": nil, "C": nil, "D": nil, "E": nil},
+			},
+			wantErr: "field Trailers must have at most 4 keys",
+		},
+		{
+			name: "empty defaults",
+			config: UpstreamConfig{
+				Query:    url.Values{"q": {"go"}},
+				Headers:  http.Header{"Accept": {"application/json"}},
+				Defaults: &url.Values{},
+			},
+			wantErr: "field Defaults must have at least 1 keys",
+		},
+		{
+			name: "long default value",
+			config: UpstreamConfig{
+				Query:    url.Values{"q": {"go"}},
+				Headers:  http.Header{"Accept": {"application/json"}},
+				Defaults: &url.Values{"page": {"123456789"}},
+			},
+			wantErr: `field Defaults["page"][0] must be at most 8 characters`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
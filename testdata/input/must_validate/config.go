@@ -0,0 +1,7 @@
+package must_validate
+
+// Config is checked once at startup
+type Config struct {
+	Addr    string `validate:"required"`
+	Workers int    `validate:"gte=1,lte=64"`
+}
@@ -0,0 +1,33 @@
+package must_validate
+
+import "testing"
+
+func TestConfig_MustValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		wantPanic string
+	}{
+		{name: "valid", config: Config{Addr: ":8080", Workers: 4}},
+		{name: "missing addr", config: Config{Workers: 4}, wantPanic: "field Addr is required"},
+		{name: "too many workers", config: Config{Addr: ":8080", Workers: 100}, wantPanic: "field Workers must be at most 64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if tt.wantPanic == "" {
+					if r != nil {
+						t.Fatalf("MustValidate() panicked with %v, want no panic", r)
+					}
+					return
+				}
+				err, ok := r.(error)
+				if !ok || err.Error() != tt.wantPanic {
+					t.Errorf("MustValidate() panicked with %v, want error %q", r, tt.wantPanic)
+				}
+			}()
+			tt.config.MustValidate()
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package named_types
+
+// Emails is a list of recipients
+//
+//validate:min=1,max=5,dive,email
+type Emails []string
+
+// Headers are extra message headers
+//
+//validate:max=3,dive,keys,min=1,endkeys,required
+type Headers map[string]string
+
+// Tags has no rules, so no Validate method is generated for it
+type Tags []string
+
+// Lines are the attachments of a message
+//
+//validate:unique=Name,dive
+type Lines []Line
+
+// Line is an attachment
+type Line struct {
+	Name string `validate:"required"`
+	Size int    `validate:"gt=0"`
+}
+
+// Message reuses the named types
+type Message struct {
+	To      Emails  `validate:"dive"`
+	Cc      *Emails `validate:"dive"`
+	Headers Headers `validate:"required,dive"`
+	Lines   Lines   `validate:"dive"`
+}
@@ -0,0 +1,147 @@
+package named_types
+
+import (
+	"testing"
+)
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     Message
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no recipients",
+			msg: Message{
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid recipient",
+			msg: Message{
+				To:      Emails{"a@example.com", "nope"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many recipients",
+			msg: Message{
+				To:      Emails{"a@b.co", "a@b.co", "a@b.co", "a@b.co", "a@b.co", "a@b.co"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil cc",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid cc",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Cc:      &Emails{"b@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty cc",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Cc:      &Emails{},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no headers",
+			msg: Message{
+				To:    Emails{"a@example.com"},
+				Lines: Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty header value",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1", "X-Empty": ""},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty header key",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1", "": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no lines",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid line",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}, {Name: "b.pdf"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate line",
+			msg: Message{
+				To:      Emails{"a@example.com"},
+				Headers: Headers{"X-Trace": "1"},
+				Lines:   Lines{{Name: "a.pdf", Size: 10}, {Name: "a.pdf", Size: 1}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEmails_Validate(t *testing.T) {
+	emails := Emails{"a@example.com", "b"}
+	err := emails.Validate()
+	if err == nil || err.Error() != "field Emails[1] must be a valid email address" {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
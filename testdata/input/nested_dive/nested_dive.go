@@ -0,0 +1,15 @@
+package nested_dive
+
+// Cell is a validated element of a grid
+type Cell struct {
+	Label string `validate:"required"`
+}
+
+// Board holds slices of slices
+type Board struct {
+	Rows    [][]string          `validate:"required,dive,max=3,dive,required,max=8"`
+	Grid    [][]Cell            `validate:"dive,dive"`
+	Cells   [][]*Cell           `validate:"dive,dive"`
+	Scores  [][][]int           `validate:"dive,dive,dive,gte=0,lte=100"`
+	Columns map[string][]string `validate:"dive,dive,required"`
+}
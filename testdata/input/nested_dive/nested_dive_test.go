@@ -0,0 +1,130 @@
+package nested_dive
+
+import "testing"
+
+func TestBoard_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   Board
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing rows",
+			board: Board{
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "row too long",
+			board: Board{
+				Rows:    [][]string{{"a", "b", "c", "d"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty cell text",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {""}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cell text too long",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"abcdefghi"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid grid cell",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: ""}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cell pointer",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: ""}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative score",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, -1}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "score too high",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{101, 100}}},
+				Columns: map[string][]string{"name": {"id"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty column value",
+			board: Board{
+				Rows:    [][]string{{"a", "b"}, {"c"}},
+				Grid:    [][]Cell{{{Label: "x"}}},
+				Cells:   [][]*Cell{{{Label: "y"}, nil}},
+				Scores:  [][][]int{{{0, 100}}},
+				Columns: map[string][]string{"name": {""}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.board.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
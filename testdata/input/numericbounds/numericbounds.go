@@ -0,0 +1,14 @@
+package numericbounds
+
+import "encoding/json"
+
+// Limits demonstrates bounds written in scientific and underscore notation
+type Limits struct {
+	Budget   int64        `json:"budget" validate:"gte=1_000,lte=1e6"`
+	Ratio    float64      `json:"ratio" validate:"gt=-1.5e-3,lt=2.5e2"`
+	Quota    *uint32      `json:"quota" validate:"omitempty,max=1_000_000"`
+	Name     string       `json:"name" validate:"min=1e1,max=2.56e2"`
+	Tags     []string     `json:"tags" validate:"max=1e2"`
+	Price    json.Number  `json:"price" validate:"gte=1e-2,lte=1_000_000.5"`
+	Discount *json.Number `json:"discount" validate:"omitempty,lt=5e1"`
+}
@@ -0,0 +1,151 @@
+package numericbounds
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestLimitsValidation(t *testing.T) {
+	bigQuota := uint32(1_000_001)
+	discount := json.Number("50")
+
+	tests := []struct {
+		name    string
+		limits  Limits
+		wantErr bool
+	}{
+		{
+			name: "valid limits",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "budget upper bound inclusive",
+			limits: Limits{
+				Budget: 1_000_000,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "budget too low",
+			limits: Limits{
+				Budget: 999,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "budget too high",
+			limits: Limits{
+				Budget: 1_000_001,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ratio at exclusive lower bound",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  -0.0015,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ratio too high",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  250,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "quota too high",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Quota:  &bigQuota,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "name too short",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Name:   "short",
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "name too long",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 257),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many tags",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Tags:   make([]string, 101),
+				Price:  json.Number("10"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "price too low",
+			limits: Limits{
+				Budget: 5000,
+				Ratio:  0.5,
+				Name:   strings.Repeat("a", 20),
+				Price:  json.Number("0.001"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "discount at exclusive bound",
+			limits: Limits{
+				Budget:   5000,
+				Ratio:    0.5,
+				Name:     strings.Repeat("a", 20),
+				Price:    json.Number("10"),
+				Discount: &discount,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Limits.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package numericstring
+
+// Amount is a custom string type holding a decimal amount
+type Amount string
+
+// Order demonstrates bounds on numeric strings
+type Order struct {
+	Quantity string  `json:"quantity" validate:"required,numeric,gte=1,lte=100"`
+	Price    Amount  `json:"price" validate:"numeric,gt=0,lt=1e6"`
+	Discount *string `json:"discount" validate:"omitempty,numeric,gte=0,lt=100"`
+	Code     string  `json:"code" validate:"numeric"`
+}
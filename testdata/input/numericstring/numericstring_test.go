@@ -0,0 +1,163 @@
+package numericstring
+
+import (
+	"testing"
+)
+
+func TestOrderValidation(t *testing.T) {
+	ten, hundred, notNumber := "10", "100", "ten"
+
+	tests := []struct {
+		name    string
+		order   Order
+		wantErr bool
+	}{
+		{
+			name: "valid order",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: false,
+		},
+		{
+			name: "lowest quantity",
+			order: Order{
+				Quantity: "1",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: false,
+		},
+		{
+			name: "highest quantity",
+			order: Order{
+				Quantity: "100",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: false,
+		},
+		{
+			name: "quantity below gte",
+			order: Order{
+				Quantity: "0",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "quantity above lte",
+			order: Order{
+				Quantity: "100.5",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "quantity not a number",
+			order: Order{
+				Quantity: "three",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "NaN is not a number",
+			order: Order{
+				Quantity: "NaN",
+				Price:    "19.99",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Inf is not a number",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Code:     "Inf",
+			},
+			wantErr: true,
+		},
+		{
+			name: "price must be greater than zero",
+			order: Order{
+				Quantity: "3",
+				Price:    "0",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "price in scientific notation",
+			order: Order{
+				Quantity: "3",
+				Price:    "1.5e3",
+				Code:     "0042",
+			},
+			wantErr: false,
+		},
+		{
+			name: "price at exclusive upper bound",
+			order: Order{
+				Quantity: "3",
+				Price:    "1000000",
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid discount",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Discount: &ten,
+				Code:     "0042",
+			},
+			wantErr: false,
+		},
+		{
+			name: "discount at exclusive bound",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Discount: &hundred,
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "discount not a number",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Discount: &notNumber,
+				Code:     "0042",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty code is not a number",
+			order: Order{
+				Quantity: "3",
+				Price:    "19.99",
+				Code:     "",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Order.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Package checks provides custom validators for the omitempty_struct test data
+package checks
+
+import (
+	"errors"
+	"time"
+)
+
+// NotBefore2000 rejects timestamps before the year 2000
+func NotBefore2000(t time.Time) error {
+	if t.Year() < 2000 {
+		return errors.New("must not be before 2000")
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package omitempty_struct
+
+import "time"
+
+// Money is a comparable value object without an IsZero method
+type Money struct {
+	Amount   int    `json:"amount" validate:"gt=0"`
+	Currency string `json:"currency" validate:"iso4217"`
+}
+
+// Period is a value object that reports its own zero state
+type Period struct {
+	From string `json:"from" validate:"datetime=2006-01-02"`
+	To   string `json:"to" validate:"datetime=2006-01-02"`
+}
+
+// IsZero reports whether neither bound is set
+func (p *Period) IsZero() bool {
+	return p.From == "" && p.To == ""
+}
+
+// Labels is not comparable and has no IsZero method, so omitempty cannot skip it
+type Labels struct {
+	Values []string `json:"values" validate:"min=1"`
+}
+
+// Order has optional struct-typed fields
+type Order struct {
+	Price   Money     `json:"price" validate:"omitempty,dive"`
+	Window  Period    `json:"window" validate:"omitempty,dive"`
+	Created time.Time `json:"created" validate:"omitempty,github.com/n10ty/houp/testdata/input/omitempty_struct/checks:NotBefore2000"`
+	Labels  Labels    `json:"labels" validate:"omitempty,dive"`
+}
@@ -0,0 +1,34 @@
+package omitempty_struct
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderValidate(t *testing.T) {
+	labels := Labels{Values: []string{"gift"}}
+
+	tests := []struct {
+		name    string
+		order   Order
+		wantErr bool
+	}{
+		{name: "zero optional structs are skipped", order: Order{Labels: labels}, wantErr: false},
+		{name: "valid price", order: Order{Price: Money{Amount: 10, Currency: "EUR"}, Labels: labels}, wantErr: false},
+		{name: "partially set price is validated", order: Order{Price: Money{Currency: "EUR"}, Labels: labels}, wantErr: true},
+		{name: "valid window", order: Order{Window: Period{From: "2024-01-01", To: "2024-02-01"}, Labels: labels}, wantErr: false},
+		{name: "invalid window", order: Order{Window: Period{From: "01/01/2024"}, Labels: labels}, wantErr: true},
+		{name: "valid created", order: Order{Created: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Labels: labels}, wantErr: false},
+		{name: "invalid created", order: Order{Created: time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), Labels: labels}, wantErr: true},
+		{name: "non-comparable struct is always validated", order: Order{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Order.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package oneof
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// airports is the set of the Origin and Stops tags, which is large enough to get
+// the shared pkg_oneof_b391cc22 helper
+var airports = strings.Fields("AMS ATL BCN BER BKK BOS CDG CPH DEN DFW DOH DUB DXB FCO FRA HEL HKG IST JFK LAX LHR LIS MAD MIA MUC NRT ORD OSL PEK PRG SFO SIN SYD VIE WAW YYZ ZRH")
+
+// BenchmarkCodeSet compares the generated switch with the alternatives it replaced:
+// a binary search over a sorted slice, a package-level map and a map literal built
+// on every call. Each iteration looks up every member and one value outside the set.
+func BenchmarkCodeSet(b *testing.B) {
+	lookups := append(slices.Clone(airports), "XXX")
+	index := make(map[string]struct{}, len(airports))
+	for _, a := range airports {
+		index[a] = struct{}{}
+	}
+
+	strategies := []struct {
+		name     string
+		contains func(string) bool
+	}{
+		{name: "switch", contains: pkg_oneof_b391cc22},
+		{name: "binary search", contains: func(v string) bool {
+			_, ok := slices.BinarySearch(airports, v)
+			return ok
+		}},
+		{name: "map", contains: func(v string) bool {
+			_, ok := index[v]
+			return ok
+		}},
+		{name: "map literal", contains: func(v string) bool {
+			_, ok := map[string]struct{}{
+				"AMS": {}, "ATL": {}, "BCN": {}, "BER": {}, "BKK": {}, "BOS": {}, "CDG": {}, "CPH": {},
+				"DEN": {}, "DFW": {}, "DOH": {}, "DUB": {}, "DXB": {}, "FCO": {}, "FRA": {}, "HEL": {},
+				"HKG": {}, "IST": {}, "JFK": {}, "LAX": {}, "LHR": {}, "LIS": {}, "MAD": {}, "MIA": {},
+				"MUC": {}, "NRT": {}, "ORD": {}, "OSL": {}, "PEK": {}, "PRG": {}, "SFO": {}, "SIN": {},
+				"SYD": {}, "VIE": {}, "WAW": {}, "YYZ": {}, "ZRH": {},
+			}[v]
+			return ok
+		}},
+	}
+	for _, s := range strategies {
+		b.Run(s.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, v := range lookups {
+					if s.contains(v) != (v != "XXX") {
+						b.Fatalf("lookup of %q is wrong", v)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package oneof
+
+// Level is a custom string type
+type Level string
+
+// Flight uses small sets, which are checked inline, and a large set of airport
+// codes, which gets a shared helper
+type Flight struct {
+	Status   string   `validate:"required,oneof=scheduled boarding departed"`
+	Level    Level    `validate:"omitempty,oneof=economy business first"`
+	Gate     *int     `validate:"omitempty,oneof=1 2 3 10"`
+	Priority uint8    `validate:"oneof=0 1 2"`
+	Offset   int      `validate:"oneof=-1 0 1"`
+	Origin   string   `validate:"oneof=AMS ATL BCN BER BKK BOS CDG CPH DEN DFW DOH DUB DXB FCO FRA HEL HKG IST JFK LAX LHR LIS MAD MIA MUC NRT ORD OSL PEK PRG SFO SIN SYD VIE WAW YYZ ZRH"`
+	Stops    []string `validate:"subsetof=AMS ATL BCN BER BKK BOS CDG CPH DEN DFW DOH DUB DXB FCO FRA HEL HKG IST JFK LAX LHR LIS MAD MIA MUC NRT ORD OSL PEK PRG SFO SIN SYD VIE WAW YYZ ZRH"`
+	Meals    []Level  `validate:"dive,oneof=economy business"`
+	Seats    [2]int   `validate:"subsetof=0 1 2 3"`
+}
@@ -0,0 +1,146 @@
+package oneof
+
+import "testing"
+
+func TestFlightValidate(t *testing.T) {
+	gate, badGate := 10, 4
+
+	tests := []struct {
+		name    string
+		flight  Flight
+		wantErr string
+	}{
+		{
+			name: "valid",
+			flight: Flight{
+				Status: "boarding",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+		},
+		{
+			name: "all set",
+			flight: Flight{
+				Status:   "boarding",
+				Level:    "first",
+				Gate:     &gate,
+				Priority: 2,
+				Offset:   -1,
+				Origin:   "AMS",
+				Stops:    []string{"FRA", "ZRH"},
+				Meals:    []Level{"business"},
+				Seats:    [2]int{3, 0},
+			},
+		},
+		{
+			name: "status",
+			flight: Flight{
+				Status: "landed",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Status must be one of: boarding departed scheduled",
+		},
+		{
+			name: "custom string type",
+			flight: Flight{
+				Status: "boarding",
+				Level:  "premium",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Level must be one of: business economy first",
+		},
+		{
+			name: "pointer",
+			flight: Flight{
+				Status: "boarding",
+				Gate:   &badGate,
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Gate must be one of: 1 2 3 10",
+		},
+		{
+			name: "unsigned",
+			flight: Flight{
+				Status:   "boarding",
+				Priority: 3,
+				Origin:   "AMS",
+				Stops:    []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Priority must be one of: 0 1 2",
+		},
+		{
+			name: "negative",
+			flight: Flight{
+				Status: "boarding",
+				Offset: -2,
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Offset must be one of: -1 0 1",
+		},
+		{
+			name: "large set",
+			flight: Flight{
+				Status: "boarding",
+				Origin: "XXX",
+				Stops:  []string{"FRA", "ZRH"},
+			},
+			wantErr: "field Origin must be one of the 37 allowed values",
+		},
+		{
+			name: "subset",
+			flight: Flight{
+				Status: "boarding",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH", "QQQ"},
+			},
+			wantErr: "field Stops[2] must be one of the 37 allowed values",
+		},
+		{
+			name: "dive",
+			flight: Flight{
+				Status: "boarding",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+				Meals:  []Level{"economy", "first"},
+			},
+			wantErr: "field Meals[1] must be one of: business economy",
+		},
+		{
+			name: "array subset",
+			flight: Flight{
+				Status: "boarding",
+				Origin: "AMS",
+				Stops:  []string{"FRA", "ZRH"},
+				Seats:  [2]int{1, 5},
+			},
+			wantErr: "field Seats[1] must be one of: 0 1 2 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.flight.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func BenchmarkFlightValidate(b *testing.B) {
+	f := Flight{Status: "boarding", Origin: "ZRH", Stops: []string{"AMS", "LHR", "SIN"}}
+	for i := 0; i < b.N; i++ {
+		if err := f.Validate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
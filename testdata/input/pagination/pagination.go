@@ -0,0 +1,19 @@
+package pagination
+
+// ListOrders is a page of orders
+//
+//validate:pagination=Page,PerPage,maxPerPage=100
+type ListOrders struct {
+	Page    int
+	PerPage int
+	Status  string `validate:"omitempty,max=10"`
+}
+
+// Search pages through search hits without a page size limit
+//
+//validate:pagination=PageNumber,Size
+type Search struct {
+	Query      string `validate:"required"`
+	PageNumber int64
+	Size       uint32
+}
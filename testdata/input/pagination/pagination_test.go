@@ -0,0 +1,64 @@
+package pagination
+
+import (
+	"math"
+	"testing"
+)
+
+func TestListOrdersValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   ListOrders
+		wantErr string
+	}{
+		{name: "valid", value: ListOrders{Page: 3, PerPage: 50}},
+		{name: "max page size", value: ListOrders{Page: 1, PerPage: 100}},
+		{name: "zero page", value: ListOrders{Page: 0, PerPage: 10}, wantErr: "field Page must be at least 1"},
+		{name: "negative page", value: ListOrders{Page: -2, PerPage: 10}, wantErr: "field Page must be at least 1"},
+		{name: "zero page size", value: ListOrders{Page: 1}, wantErr: "field PerPage must be between 1 and 100"},
+		{name: "page size too large", value: ListOrders{Page: 1, PerPage: 101}, wantErr: "field PerPage must be between 1 and 100"},
+		{name: "offset overflow", value: ListOrders{Page: math.MaxInt, PerPage: 100}, wantErr: "fields Page and PerPage give an offset beyond the int range"},
+		{name: "status checked after pagination", value: ListOrders{Page: 1, PerPage: 10, Status: "outstanding"}, wantErr: "field Status must be at most 10 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.value.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSearchValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   Search
+		wantErr string
+	}{
+		{name: "valid", value: Search{Query: "go", PageNumber: 1, Size: math.MaxUint32}},
+		{name: "zero size", value: Search{Query: "go", PageNumber: 1}, wantErr: "field Size must be at least 1"},
+		{name: "last page", value: Search{Query: "go", PageNumber: math.MaxInt / 1000, Size: 1000}},
+		{name: "offset overflow", value: Search{Query: "go", PageNumber: math.MaxInt64, Size: 2}, wantErr: "fields PageNumber and Size give an offset beyond the int range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.value.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
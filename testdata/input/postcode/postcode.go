@@ -0,0 +1,18 @@
+package postcode
+
+// CountryCode is an ISO 3166-1 alpha-2 code
+type CountryCode string
+
+// Address demonstrates country-aware postal code validation
+type Address struct {
+	Country  string `json:"country" validate:"required,iso3166_1_alpha2"`
+	PostCode string `json:"post_code" validate:"required,postcode_iso3166_alpha2=Country"`
+}
+
+// Shipment uses an untagged custom country type and an optional country
+type Shipment struct {
+	Destination   CountryCode `json:"destination"`
+	DestinationPC string      `json:"destination_pc" validate:"postcode_iso3166_alpha2=Destination"`
+	Origin        *string     `json:"origin"`
+	OriginPC      *string     `json:"origin_pc" validate:"omitempty,postcode_iso3166_alpha2=Origin"`
+}
@@ -0,0 +1,60 @@
+package postcode
+
+import (
+	"testing"
+)
+
+func TestAddressValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    Address
+		wantErr bool
+	}{
+		{name: "US ZIP", addr: Address{Country: "US", PostCode: "94105"}},
+		{name: "US ZIP+4", addr: Address{Country: "US", PostCode: "94105-1804"}},
+		{name: "UK postcode", addr: Address{Country: "GB", PostCode: "SW1A 1AA"}},
+		{name: "Canadian postal code", addr: Address{Country: "CA", PostCode: "K1A 0B1"}},
+		{name: "Dutch postcode", addr: Address{Country: "NL", PostCode: "1012 AB"}},
+		{name: "Polish postcode", addr: Address{Country: "PL", PostCode: "00-950"}},
+		{name: "Irish Eircode", addr: Address{Country: "IE", PostCode: "D02 X285"}},
+		{name: "US code for Germany", addr: Address{Country: "DE", PostCode: "94105-1804"}, wantErr: true},
+		{name: "German code for Poland", addr: Address{Country: "PL", PostCode: "10115"}, wantErr: true},
+		{name: "malformed UK postcode", addr: Address{Country: "GB", PostCode: "SW1A 1A"}, wantErr: true},
+		{name: "country without postal codes", addr: Address{Country: "AE", PostCode: "00000"}, wantErr: true},
+		{name: "missing post code", addr: Address{Country: "US"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.addr.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Address.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShipmentValidation(t *testing.T) {
+	jp, jpCode, fr := "JP", "100-0001", "FR"
+
+	tests := []struct {
+		name    string
+		s       Shipment
+		wantErr bool
+	}{
+		{name: "custom country type", s: Shipment{Destination: "SE", DestinationPC: "114 55"}},
+		{name: "optional origin set", s: Shipment{Destination: "SE", DestinationPC: "11455", Origin: &jp, OriginPC: &jpCode}},
+		{name: "origin code does not match country", s: Shipment{Destination: "SE", DestinationPC: "11455", Origin: &fr, OriginPC: &jpCode}, wantErr: true},
+		{name: "origin code without origin country", s: Shipment{Destination: "SE", DestinationPC: "11455", OriginPC: &jpCode}, wantErr: true},
+		{name: "empty destination country", s: Shipment{DestinationPC: "11455"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shipment.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package printable
+
+// LogEntry holds user-supplied values that end up in log lines
+type LogEntry struct {
+	// Only printable runes, no tabs or newlines
+	Username string `json:"username" validate:"required,printable"`
+
+	// Unicode text is fine as long as it has no control characters
+	Message string `json:"message" validate:"no_control_chars"`
+
+	// Optional pointer field
+	Referrer *string `json:"referrer" validate:"omitempty,printable"`
+
+	// Custom string type
+	Agent UserAgent `json:"agent" validate:"no_control_chars"`
+
+	// Each tag must be printable
+	Tags []string `json:"tags" validate:"dive,printable"`
+}
+
+// UserAgent is a custom string type
+type UserAgent string
@@ -0,0 +1,74 @@
+package printable
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogEntryValidate(t *testing.T) {
+	tab := "a\tb"
+	tests := []struct {
+		name    string
+		entry   LogEntry
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			entry: LogEntry{Username: "jürgen_01", Message: "Grüße, мир ✓", Agent: "curl/8.0", Tags: []string{"a b"}},
+		},
+		{
+			name:    "newline in username",
+			entry:   LogEntry{Username: "admin\nINFO forged"},
+			wantErr: "field Username must contain only printable characters",
+		},
+		{
+			name:    "line separator is not printable",
+			entry:   LogEntry{Username: "a\u2028b"},
+			wantErr: "field Username must contain only printable characters",
+		},
+		{
+			name:  "line separator is not a control character",
+			entry: LogEntry{Username: "a", Message: "a\u2028b"},
+		},
+		{
+			name:    "escape in message",
+			entry:   LogEntry{Username: "a", Message: "\x1b[31mred"},
+			wantErr: "field Message must not contain control characters",
+		},
+		{
+			name:    "invalid UTF-8",
+			entry:   LogEntry{Username: "a", Message: "\xff"},
+			wantErr: "field Message must not contain control characters",
+		},
+		{
+			name:    "tab in pointer field",
+			entry:   LogEntry{Username: "a", Referrer: &tab},
+			wantErr: "field Referrer must contain only printable characters",
+		},
+		{
+			name:    "control character in custom type",
+			entry:   LogEntry{Username: "a", Agent: "x\x00"},
+			wantErr: "field Agent must not contain control characters",
+		},
+		{
+			name:    "control character in tag",
+			entry:   LogEntry{Username: "a", Tags: []string{"ok", "bad\r"}},
+			wantErr: "field Tags[1] must contain only printable characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.entry.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
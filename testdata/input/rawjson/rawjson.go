@@ -0,0 +1,12 @@
+package rawjson
+
+import "encoding/json"
+
+// Event stores its payloads as raw JSON
+type Event struct {
+	Payload  json.RawMessage  `validate:"required,json"`
+	Metadata json.RawMessage  `validate:"omitempty,json,max=256"`
+	Patch    *json.RawMessage `validate:"omitempty,json"`
+	Body     []byte           `validate:"json"`
+	Filter   string           `validate:"omitempty,json"`
+}
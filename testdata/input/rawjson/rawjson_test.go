@@ -0,0 +1,128 @@
+package rawjson
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEvent_Validate(t *testing.T) {
+	nullPatch, badPatch := json.RawMessage(`null`), json.RawMessage(`nul`)
+
+	tests := []struct {
+		name    string
+		event   Event
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+				Body:    []byte(`[1,2,3]`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing payload",
+			event: Event{
+				Body: []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty payload",
+			event: Event{
+				Payload: json.RawMessage{},
+				Body:    []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed payload",
+			event: Event{
+				Payload: json.RawMessage(`{"id":`),
+				Body:    []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid metadata",
+			event: Event{
+				Payload:  json.RawMessage(`{"id":1}`),
+				Metadata: json.RawMessage(`{"tags":["a"]}`),
+				Body:     []byte(`[1,2,3]`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "malformed metadata",
+			event: Event{
+				Payload:  json.RawMessage(`{"id":1}`),
+				Metadata: json.RawMessage(`{tags}`),
+				Body:     []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "metadata too large",
+			event: Event{
+				Payload:  json.RawMessage(`{"id":1}`),
+				Metadata: json.RawMessage(`"` + strings.Repeat("a", 255) + `"`),
+				Body:     []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid patch",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+				Patch:   &nullPatch,
+				Body:    []byte(`[1,2,3]`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "malformed patch",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+				Patch:   &badPatch,
+				Body:    []byte(`[1,2,3]`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty body",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid filter",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+				Body:    []byte(`[1,2,3]`),
+				Filter:  `{"status":"open"}`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "malformed filter",
+			event: Event{
+				Payload: json.RawMessage(`{"id":1}`),
+				Body:    []byte(`[1,2,3]`),
+				Filter:  `status=open`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.event.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package receivers
+
+// Item dives into a slice, whose loop variable is i
+type Item struct {
+	Parts []Part `validate:"min=1,dive"`
+}
+
+// Part is diven into by Item
+type Part struct {
+	Name string `validate:"required"`
+}
+
+// _draft starts with an underscore, which cannot be a receiver
+type _draft struct {
+	Title string `validate:"required"`
+}
+
+// Élan starts with a multi-byte letter
+type Élan struct {
+	Level int `validate:"gte=1"`
+}
+
+// Order has a method of its own, whose receiver name generated methods reuse
+type Order struct {
+	Lines []int `validate:"min=1"`
+}
+
+// Total sums the lines
+func (ord *Order) Total() int {
+	sum := 0
+	for _, line := range ord.Lines {
+		sum += line
+	}
+	return sum
+}
+
+// Account sets the receiver name itself
+//
+//validate:receiver=acct
+type Account struct {
+	Owner string `validate:"required"`
+}
@@ -0,0 +1,32 @@
+package receivers
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{ Validate() error }
+		wantErr string
+	}{
+		{name: "item", value: &Item{Parts: []Part{{Name: "bolt"}}}},
+		{name: "item part", value: &Item{Parts: []Part{{}}}, wantErr: "field Parts[0] validation failed: field Name is required"},
+		{name: "draft", value: &_draft{}, wantErr: "field Title is required"},
+		{name: "élan", value: &Élan{}, wantErr: "field Level must be at least 1"},
+		{name: "order", value: &Order{}, wantErr: "field Lines must have at least 1 elements"},
+		{name: "account", value: &Account{Owner: "ann"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.value.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
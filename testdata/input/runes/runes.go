@@ -0,0 +1,13 @@
+package runes
+
+// Nick is a custom string type
+type Nick string
+
+// Member demonstrates length checks counted in runes rather than bytes
+type Member struct {
+	Username string   `json:"username" validate:"required,min=3,max=12,runes"`
+	Nick     Nick     `json:"nick" validate:"omitempty,max=4,runes"`
+	Bio      *string  `json:"bio" validate:"omitempty,max=5,runes,trim"`
+	Tags     []string `json:"tags" validate:"max=3,dive,max=3,runes"`
+	Code     string   `json:"code" validate:"max=4"`
+}
@@ -0,0 +1,70 @@
+package runes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMemberValidate(t *testing.T) {
+	bio := "  Grüße  "
+	longBio := "Grüße!"
+	tests := []struct {
+		name    string
+		member  Member
+		wantErr string
+	}{
+		{
+			name:   "multi-byte username within limits",
+			member: Member{Username: "Jürgen", Nick: "Zoë", Bio: &bio, Tags: []string{"東京", "é"}},
+		},
+		{
+			name:   "three runes of six bytes",
+			member: Member{Username: "日本語"},
+		},
+		{
+			name:    "two runes are too short",
+			member:  Member{Username: "日本"},
+			wantErr: "field Username must be at least 3 characters",
+		},
+		{
+			name:    "too many runes",
+			member:  Member{Username: "ааааааааааааа"},
+			wantErr: "field Username must be at most 12 characters",
+		},
+		{
+			name:    "custom type",
+			member:  Member{Username: "abc", Nick: "Zoëys"},
+			wantErr: "field Nick must be at most 4 characters",
+		},
+		{
+			name:    "trimmed runes",
+			member:  Member{Username: "abc", Bio: &longBio},
+			wantErr: "field Bio must be at most 5 characters",
+		},
+		{
+			name:    "element rune count",
+			member:  Member{Username: "abc", Tags: []string{"東京都", "ab cd"}},
+			wantErr: "field Tags[1] must be at most 3 characters",
+		},
+		{
+			name:    "bytes without the runes option",
+			member:  Member{Username: "abc", Code: "äöü"},
+			wantErr: "field Code must be at most 4 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.member.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package runtime_helpers
+
+// Account uses rules whose tables, regexps and helpers come from the runtime package
+type Account struct {
+	Email    string   `validate:"required,email"`
+	Currency string   `validate:"iso4217"`
+	Country  string   `validate:"iso3166_1_alpha2"`
+	Language string   `validate:"omitempty,iso639_1"`
+	IBAN     string   `validate:"iban"`
+	Aliases  []string `validate:"dive,email"`
+	Schedule string   `validate:"omitempty,cron"`
+	// uuid has no fixed pattern, so its regexp is still generated
+	ID string `validate:"omitempty,uuid4"`
+}
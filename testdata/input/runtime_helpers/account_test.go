@@ -0,0 +1,131 @@
+package runtime_helpers
+
+import "testing"
+
+func TestAccountValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		account Account
+		wantErr string
+	}{
+		{
+			name: "valid",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+			},
+		},
+		{
+			name: "valid optional fields",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				Language: "en",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+				Schedule: "*/5 * * * *",
+				ID:       "9b2f7c3e-1a4d-4f6b-8c2d-3e4f5a6b7c8d",
+			},
+		},
+		{
+			name: "email",
+			account: Account{
+				Email:    "ann",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+			},
+			wantErr: "field Email must be a valid email address",
+		},
+		{
+			name: "currency",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUX",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+			},
+			wantErr: "field Currency must be a valid ISO 4217 currency code",
+		},
+		{
+			name: "country",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "XX",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+			},
+			wantErr: "field Country must be a valid ISO 3166-1 alpha-2 country code",
+		},
+		{
+			name: "language",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				Language: "xx",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+			},
+			wantErr: "field Language must be a valid ISO 639-1 language code",
+		},
+		{
+			name: "iban checksum",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB83 WEST 1234 5698 7654 32",
+			},
+			wantErr: "field IBAN must be a valid IBAN",
+		},
+		{
+			name: "alias",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+				Aliases:  []string{"ann@example.com", "bob"},
+			},
+			wantErr: "field Aliases[1] must be a valid email address",
+		},
+		{
+			name: "cron",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+				Schedule: "61 * * * *",
+			},
+			wantErr: "field Schedule must be a valid cron expression",
+		},
+		{
+			name: "uuid",
+			account: Account{
+				Email:    "ann@example.com",
+				Currency: "EUR",
+				Country:  "DE",
+				IBAN:     "GB82 WEST 1234 5698 7654 32",
+				ID:       "not-a-uuid",
+			},
+			wantErr: "field ID must be a valid version 4 UUID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.account.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
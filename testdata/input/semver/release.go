@@ -0,0 +1,8 @@
+package semver
+
+// Release describes a release manifest entry
+type Release struct {
+	Version    string   `json:"version" validate:"required,semver"`
+	MinVersion *string  `json:"min_version" validate:"omitempty,semver"`
+	Compatible []string `json:"compatible" validate:"omitempty,dive,semver"`
+}
@@ -0,0 +1,45 @@
+package semver
+
+import "testing"
+
+func TestReleaseValidate(t *testing.T) {
+	valid := []string{
+		"0.0.0", "1.2.3", "10.20.30", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-0.3.7",
+		"1.0.0-x.7.z.92", "1.0.0+20130313144700", "1.0.0-beta+exp.sha.5114f85", "1.0.0-rc.1+build.1",
+	}
+	for _, v := range valid {
+		t.Run("valid "+v, func(t *testing.T) {
+			r := Release{Version: v}
+			if err := r.Validate(); err != nil {
+				t.Errorf("Release.Validate() with %q error = %v", v, err)
+			}
+		})
+	}
+
+	invalid := []string{
+		"1", "1.2", "v1.2.3", "01.2.3", "1.02.3", "1.2.3-", "1.2.3-01", "1.2.3+", "1.2.3-alpha..1", "1.2.3.4",
+	}
+	for _, v := range invalid {
+		t.Run("invalid "+v, func(t *testing.T) {
+			r := Release{Version: v}
+			if err := r.Validate(); err == nil {
+				t.Errorf("Release.Validate() with %q expected error", v)
+			}
+		})
+	}
+
+	t.Run("invalid min version", func(t *testing.T) {
+		minVersion := "1.x"
+		r := Release{Version: "1.0.0", MinVersion: &minVersion}
+		if err := r.Validate(); err == nil {
+			t.Error("Release.Validate() expected error for invalid min version")
+		}
+	})
+
+	t.Run("invalid compatible element", func(t *testing.T) {
+		r := Release{Version: "1.0.0", Compatible: []string{"0.9.0", "0.8"}}
+		if err := r.Validate(); err == nil {
+			t.Error("Release.Validate() expected error for invalid compatible version")
+		}
+	})
+}
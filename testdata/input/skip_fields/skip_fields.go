@@ -0,0 +1,13 @@
+package skip_fields
+
+// Profile excludes some fields from validation
+type Profile struct {
+	Name   string `validate:"required"`
+	Legacy string `validate:"-"`
+	Nick   string `validate:"required,nickname"` //validate:skip
+
+	//validate:skip
+	Settings struct {
+		Theme string `validate:"required"`
+	}
+}
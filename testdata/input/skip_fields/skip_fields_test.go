@@ -0,0 +1,23 @@
+package skip_fields
+
+import "testing"
+
+func TestProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr bool
+	}{
+		{"valid", Profile{Name: "Ann"}, false},
+		{"missing name", Profile{}, true},
+		{"skipped fields are not checked", Profile{Name: "Ann", Legacy: "", Nick: ""}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.profile.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package checks
+
+import "fmt"
+
+// ValidateSKU is referenced from a validate tag
+func ValidateSKU(sku string) error {
+	if len(sku) != 8 {
+		return fmt.Errorf("sku must be 8 characters")
+	}
+	return nil
+}
+
+// ValidateBarcode is never referenced and should be reported as unused
+func ValidateBarcode(code string) error {
+	if code == "" {
+		return fmt.Errorf("barcode must not be empty")
+	}
+	return nil
+}
+
+// Normalize is not validator-shaped and should be ignored
+func Normalize(s string) string {
+	return s
+}
@@ -0,0 +1,19 @@
+package stats
+
+// Product uses a custom field validator and common rule combinations
+type Product struct {
+	Name  string   `validate:"required,min=3,max=50"`
+	SKU   string   `validate:"required,github.com/n10ty/houp/testdata/input/stats/checks:ValidateSKU"`
+	Price float64  `validate:"gt=0"`
+	Tags  []string `validate:"omitempty,dive,min=1"`
+}
+
+// Category has a single validated field
+type Category struct {
+	Title string `validate:"required,min=3,max=50"`
+}
+
+// Untagged has no validation and is not counted
+type Untagged struct {
+	Note string
+}
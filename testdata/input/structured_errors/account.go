@@ -0,0 +1,41 @@
+package structured_errors
+
+// Account returns *houp.FieldError values when generated with --errors=structured
+type Account struct {
+	Name  string   `json:"name" validate:"required,min=3"`
+	Email string   `json:"email,omitempty" validate:"required,email"`
+	Age   *int     `validate:"omitempty,gte=18"`
+	Role  string   `validate:"oneof=admin member"`
+	Tags  []string `validate:"dive,max=10"`
+	Keys  []Key    `validate:"dive"`
+	Lat   string   `json:"lat,omitempty" validate:"omitempty,latitude"`
+}
+
+// Key is an element of Account.Keys
+type Key struct {
+	ID string `validate:"required,len=8"`
+}
+
+// Contact reports every invalid field, for a JSON response listing them all
+//
+//validate:multierror
+type Contact struct {
+	Email string `json:"email" validate:"required,email"`
+	Phone string `json:"phone" validate:"required"`
+}
+
+// Venue gets the receiver v, which the locals of its checks must not shadow
+type Venue struct {
+	Lat      string `json:"lat" validate:"required,latitude"`
+	Country  string `json:"country" validate:"required,iso3166_1_alpha2"`
+	PostCode string `json:"post_code" validate:"postcode_iso3166_alpha2=Country"`
+}
+
+// Region gets the receiver re, which the locals of its checks must not shadow
+//
+//validate:receiver=re
+type Region struct {
+	Lng      string `json:"lng" validate:"longitude"`
+	Country  string `json:"country"`
+	PostCode string `json:"post_code" validate:"postcode_iso3166_alpha2=Country"`
+}
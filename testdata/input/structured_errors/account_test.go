@@ -0,0 +1,208 @@
+package structured_errors
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/n10ty/houp"
+)
+
+func TestAccount_Validate(t *testing.T) {
+	age := 16
+	tests := []struct {
+		name      string
+		account   Account
+		wantField string
+		wantRule  string
+		wantParam string
+		wantMsg   string
+	}{
+		{
+			name: "valid",
+			account: Account{
+				Name:  "alice",
+				Email: "a@example.com",
+				Role:  "admin",
+				Tags:  []string{"go"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+			},
+		},
+		{
+			name: "name too short",
+			account: Account{
+				Name:  "al",
+				Email: "a@example.com",
+				Role:  "admin",
+				Tags:  []string{"go"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+			},
+			wantField: "Name", wantRule: "min", wantParam: "3",
+			wantMsg: "field Name must be at least 3 characters",
+		},
+		{
+			name: "underage",
+			account: Account{
+				Name:  "alice",
+				Email: "a@example.com",
+				Age:   &age,
+				Role:  "admin",
+				Tags:  []string{"go"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+			},
+			wantField: "Age", wantRule: "gte", wantParam: "18",
+			wantMsg: "field Age must be at least 18",
+		},
+		{
+			name: "unknown role",
+			account: Account{
+				Name:  "alice",
+				Email: "a@example.com",
+				Role:  "guest",
+				Tags:  []string{"go"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+			},
+			wantField: "Role", wantRule: "oneof", wantParam: "admin member",
+			wantMsg: "field Role must be one of: admin member",
+		},
+		{
+			name: "long tag",
+			account: Account{
+				Name:  "alice",
+				Email: "a@example.com",
+				Role:  "admin",
+				Tags:  []string{"go", "averyverylongtag"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+			},
+			wantField: "Tags", wantRule: "max", wantParam: "10",
+			wantMsg: "field Tags[1] must be at most 10 characters",
+		},
+		{
+			name: "latitude out of range",
+			account: Account{
+				Name:  "alice",
+				Email: "a@example.com",
+				Role:  "admin",
+				Tags:  []string{"go"},
+				Keys:  []Key{{ID: "abcdefgh"}},
+				Lat:   "91",
+			},
+			wantField: "Lat", wantRule: "latitude", wantParam: "",
+			wantMsg: "field Lat must be a valid latitude",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.account.Validate()
+			if tt.wantRule == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			var fe *houp.FieldError
+			if !errors.As(err, &fe) {
+				t.Fatalf("Validate() error = %v, want *houp.FieldError", err)
+			}
+			if fe.Struct != "Account" || fe.Field != tt.wantField || fe.Rule != tt.wantRule || fe.Param != tt.wantParam {
+				t.Errorf("FieldError = {%s %s %s %q}, want {Account %s %s %q}", fe.Struct, fe.Field, fe.Rule, fe.Param, tt.wantField, tt.wantRule, tt.wantParam)
+			}
+			if !errors.Is(err, houp.RuleError(tt.wantRule)) {
+				t.Errorf("errors.Is(err, houp.RuleError(%q)) = false, want true", tt.wantRule)
+			}
+			if err.Error() != tt.wantMsg {
+				t.Errorf("Validate() error = %q, want %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestAccount_ValidateNestedKey(t *testing.T) {
+	a := Account{Name: "alice", Email: "a@example.com", Role: "admin", Keys: []Key{{ID: "short"}}}
+	err := a.Validate()
+
+	var fe *houp.FieldError
+	if !errors.As(err, &fe) || fe.Rule != "dive" {
+		t.Fatalf("Validate() error = %v, want a dive *houp.FieldError", err)
+	}
+	// The failure of the key itself is reachable through the dive error
+	var inner *houp.FieldError
+	if !errors.As(fe.Err, &inner) || inner.Struct != "Key" || inner.Field != "ID" || inner.Rule != "len" || inner.Param != "8" {
+		t.Errorf("nested error = %+v, want Key.ID len=8", inner)
+	}
+	if !errors.Is(err, houp.ErrLen) || errors.Is(err, houp.ErrRequired) {
+		t.Errorf("errors.Is(err, houp.ErrLen) = false or errors.Is(err, houp.ErrRequired) = true for %v", err)
+	}
+	if want := "field Keys[0] validation failed: field ID must be exactly 8 characters"; err.Error() != want {
+		t.Errorf("Validate() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestContact_ValidateJSON(t *testing.T) {
+	c := Contact{Email: "nope"}
+	body, err := json.Marshal(houp.FieldErrors(c.Validate()))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `[{"field":"email","rule":"email","message":"field Email must be a valid email address"},` +
+		`{"field":"phone","rule":"required","message":"field Phone is required"}]`
+	if string(body) != want {
+		t.Errorf("json.Marshal() = %s, want %s", body, want)
+	}
+}
+
+func TestReceiverNamedLikeLocals(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantField string
+		wantRule  string
+		wantValue string
+	}{
+		{
+			name: "valid venue",
+			err:  (&Venue{Lat: "50.45", Country: "NL", PostCode: "1012 AB"}).Validate(),
+		},
+		{
+			name:      "venue latitude",
+			err:       (&Venue{Lat: "north", Country: "NL", PostCode: "1012 AB"}).Validate(),
+			wantField: "Lat", wantRule: "latitude", wantValue: "north",
+		},
+		{
+			name:      "venue postal code",
+			err:       (&Venue{Lat: "50.45", Country: "NL", PostCode: "10"}).Validate(),
+			wantField: "PostCode", wantRule: "postcode_iso3166_alpha2", wantValue: "10",
+		},
+		{
+			name: "valid region",
+			err:  (&Region{Lng: "4.9", Country: "NL", PostCode: "1012 AB"}).Validate(),
+		},
+		{
+			name:      "region longitude",
+			err:       (&Region{Lng: "181", Country: "NL", PostCode: "1012 AB"}).Validate(),
+			wantField: "Lng", wantRule: "longitude", wantValue: "181",
+		},
+		{
+			name:      "region postal code",
+			err:       (&Region{Lng: "4.9", Country: "XX", PostCode: "1012 AB"}).Validate(),
+			wantField: "PostCode", wantRule: "postcode_iso3166_alpha2", wantValue: "1012 AB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantRule == "" {
+				if tt.err != nil {
+					t.Fatalf("Validate() error = %v, want nil", tt.err)
+				}
+				return
+			}
+			var fe *houp.FieldError
+			if !errors.As(tt.err, &fe) {
+				t.Fatalf("Validate() error = %v, want *houp.FieldError", tt.err)
+			}
+			if fe.Field != tt.wantField || fe.Rule != tt.wantRule || fe.Value != tt.wantValue {
+				t.Errorf("FieldError = {%s %s %v}, want {%s %s %s}", fe.Field, fe.Rule, fe.Value, tt.wantField, tt.wantRule, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// User is a domain type; its validation is generated into the validation package
+//
+// validate:CheckUser
+type User struct {
+	Email        string             `validate:"required,email"`
+	ConfirmEmail string             `validate:"eqfield=Email,using=SameEmail"`
+	Addresses    []Address          `validate:"min=1,dive"`
+	Billing      *Address           `validate:"dive"`
+	Contacts     map[string]Address `validate:"dive"`
+	Aliases      Emails             `validate:"dive"`
+	Settings     json.RawMessage    `validate:"omitempty,jsonof=Settings"`
+
+	// Unexported fields without rules are left alone
+	cache string
+}
+
+// Address is diven into by User
+type Address struct {
+	City    string `validate:"required"`
+	Country string `validate:"required,len=2"`
+}
+
+// Settings is decoded from User.Settings
+type Settings struct {
+	Theme string `validate:"oneof=light dark"`
+}
+
+// Emails are alternative addresses of a user
+//
+//validate:max=3,dive,email
+type Emails []string
+
+// Team checks its members against the caller's tenant
+//
+// validate:CheckTenant
+type Team struct {
+	Name    string  `validate:"required"`
+	Members []*User `validate:"dive"`
+}
+
+// CheckUser rejects users whose only address is their billing address
+func CheckUser(u *User) error {
+	if u.Billing != nil && len(u.Addresses) == 1 && u.Addresses[0] == *u.Billing {
+		return errors.New("billing address must not be the only address")
+	}
+	return nil
+}
+
+// SameEmail compares email addresses ignoring case
+func SameEmail(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+type tenantKey struct{}
+
+// WithTenant returns a context for requests of tenant
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// CheckTenant only lets a tenant manage teams named after it
+func CheckTenant(ctx context.Context, t *Team) error {
+	if tenant, _ := ctx.Value(tenantKey{}).(string); tenant != "" && !strings.HasPrefix(t.Name, tenant+"-") {
+		return errors.New("team belongs to another tenant")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/n10ty/houp/testdata/input/subpackage/models"
+)
+
+func TestValidateUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    models.User
+		wantErr string
+	}{
+		{
+			name: "valid",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+			},
+		},
+		{
+			name: "struct validator",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+				Billing:      &models.Address{City: "Berlin", Country: "DE"},
+			},
+			wantErr: "struct validation failed: billing address must not be the only address",
+		},
+		{
+			name: "eqfield using",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "bob@example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+			},
+			wantErr: "field ConfirmEmail must equal field Email",
+		},
+		{
+			name: "slice dive",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}, {City: "Rome"}},
+			},
+			wantErr: "field Addresses[1] validation failed: field Country is required",
+		},
+		{
+			name: "pointer dive",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}, {City: "Paris", Country: "FR"}},
+				Billing:      &models.Address{Country: "DE"},
+			},
+			wantErr: "field Billing validation failed: field City is required",
+		},
+		{
+			name: "map dive",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+				Contacts:     map[string]models.Address{"work": {City: "Oslo", Country: "NOR"}},
+			},
+			wantErr: `field Contacts["work"] validation failed: field Country must be exactly 2 characters`,
+		},
+		{
+			name: "named type dive",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+				Aliases:      models.Emails{"ann"},
+			},
+			wantErr: "field Aliases validation failed: field Emails[0] must be a valid email address",
+		},
+		{
+			name: "jsonof",
+			user: models.User{
+				Email:        "ann@example.com",
+				ConfirmEmail: "Ann@Example.com",
+				Addresses:    []models.Address{{City: "Berlin", Country: "DE"}},
+				Settings:     json.RawMessage(`{"Theme":"blue"}`),
+			},
+			wantErr: "field Settings validation failed: field Theme must be one of: dark light",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUser(&tt.user)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateUser() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateUser() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTeamContext(t *testing.T) {
+	acme := models.WithTenant(context.Background(), "acme")
+	member := &models.User{Email: "ann@example.com", ConfirmEmail: "ann@example.com", Addresses: []models.Address{{City: "Berlin", Country: "DE"}}}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		team    models.Team
+		wantErr bool
+	}{
+		{name: "own tenant", ctx: acme, team: models.Team{Name: "acme-ops", Members: []*models.User{member, nil}}},
+		{name: "other tenant", ctx: acme, team: models.Team{Name: "globex-ops"}, wantErr: true},
+		{name: "invalid member", ctx: acme, team: models.Team{Name: "acme-ops", Members: []*models.User{{Email: "ann"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTeamContext(tt.ctx, &tt.team); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTeamContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Without a tenant every team name is allowed
+	if err := ValidateTeam(&models.Team{Name: "globex-ops"}); err != nil {
+		t.Errorf("ValidateTeam() error = %v, want nil", err)
+	}
+}
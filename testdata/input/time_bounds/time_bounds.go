@@ -0,0 +1,19 @@
+package time_bounds
+
+import "time"
+
+// Token is an access token that must not be issued in the future or expired
+type Token struct {
+	Subject   string     `validate:"required"`
+	IssuedAt  time.Time  `validate:"required,lte=now"`
+	ExpiresAt time.Time  `validate:"required,gt=now"`
+	RevokedAt *time.Time `validate:"omitempty,past"`
+}
+
+// Job is a job scheduled to run later
+type Job struct {
+	Name    string     `validate:"required"`
+	RunAt   time.Time  `validate:"future"`
+	NotLate *time.Time `validate:"omitempty,gte=now"`
+	Created time.Time  `validate:"omitempty,lt=now"`
+}
@@ -0,0 +1,160 @@
+package time_bounds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenValidate(t *testing.T) {
+	now := time.Now()
+	earlier, later := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		token   Token
+		wantErr string
+	}{
+		{
+			name: "valid",
+			token: Token{
+				Subject:   "alice",
+				IssuedAt:  earlier,
+				ExpiresAt: later,
+			},
+		},
+		{
+			name: "revoked",
+			token: Token{
+				Subject:   "alice",
+				IssuedAt:  earlier,
+				ExpiresAt: later,
+				RevokedAt: &earlier,
+			},
+		},
+		{
+			name: "issued in the future",
+			token: Token{
+				Subject:   "alice",
+				IssuedAt:  later,
+				ExpiresAt: later,
+			},
+			wantErr: "field IssuedAt must be not in the future",
+		},
+		{
+			name: "expired",
+			token: Token{
+				Subject:   "alice",
+				IssuedAt:  earlier,
+				ExpiresAt: earlier,
+			},
+			wantErr: "field ExpiresAt must be in the future",
+		},
+		{
+			name: "missing expiry",
+			token: Token{
+				Subject:  "alice",
+				IssuedAt: earlier,
+			},
+			wantErr: "field ExpiresAt is required",
+		},
+		{
+			name: "revoked in the future",
+			token: Token{
+				Subject:   "alice",
+				IssuedAt:  earlier,
+				ExpiresAt: later,
+				RevokedAt: &later,
+			},
+			wantErr: "field RevokedAt must be in the past",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.token.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJobValidate(t *testing.T) {
+	now := time.Now()
+	earlier, later := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		job     Job
+		wantErr string
+	}{
+		{
+			name: "valid",
+			job: Job{
+				Name:  "report",
+				RunAt: later,
+			},
+		},
+		{
+			name: "all set",
+			job: Job{
+				Name:    "report",
+				RunAt:   later,
+				NotLate: &later,
+				Created: earlier,
+			},
+		},
+		{
+			name: "run in the past",
+			job: Job{
+				Name:  "report",
+				RunAt: earlier,
+			},
+			wantErr: "field RunAt must be in the future",
+		},
+		{
+			name: "zero run time",
+			job: Job{
+				Name: "report",
+			},
+			wantErr: "field RunAt must be in the future",
+		},
+		{
+			name: "deadline passed",
+			job: Job{
+				Name:    "report",
+				RunAt:   later,
+				NotLate: &earlier,
+			},
+			wantErr: "field NotLate must be not in the past",
+		},
+		{
+			name: "created later",
+			job: Job{
+				Name:    "report",
+				RunAt:   later,
+				Created: later,
+			},
+			wantErr: "field Created must be in the past",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.job.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("got error %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
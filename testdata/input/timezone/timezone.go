@@ -0,0 +1,8 @@
+package timezone
+
+// Schedule holds IANA time zone names
+type Schedule struct {
+	Zone      string   `json:"zone" validate:"required,timezone"`
+	Display   *string  `json:"display" validate:"omitempty,timezone"`
+	Secondary []string `json:"secondary" validate:"omitempty,dive,timezone"`
+}
@@ -0,0 +1,35 @@
+package timezone
+
+import (
+	"testing"
+	_ "time/tzdata" // make the tests independent of the host zoneinfo
+)
+
+func TestScheduleValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{name: "valid", schedule: Schedule{Zone: "Europe/Kyiv"}, wantErr: false},
+		{name: "UTC", schedule: Schedule{Zone: "UTC"}, wantErr: false},
+		{name: "valid display and secondary", schedule: Schedule{Zone: "America/New_York", Display: strPtr("Asia/Tokyo"), Secondary: []string{"Europe/Berlin"}}, wantErr: false},
+		{name: "empty", schedule: Schedule{}, wantErr: true},
+		{name: "unknown zone", schedule: Schedule{Zone: "Mars/Olympus"}, wantErr: true},
+		{name: "Local is rejected", schedule: Schedule{Zone: "Local"}, wantErr: true},
+		{name: "invalid display", schedule: Schedule{Zone: "UTC", Display: strPtr("Nowhere")}, wantErr: true},
+		{name: "empty display", schedule: Schedule{Zone: "UTC", Display: strPtr("")}, wantErr: true},
+		{name: "invalid secondary", schedule: Schedule{Zone: "UTC", Secondary: []string{"Europe/Paris", "Europe/Atlantis"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Schedule.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package trim
+
+// Handle is a custom string type
+type Handle string
+
+// Profile demonstrates length checks on trimmed values
+type Profile struct {
+	Name     string   `json:"name" validate:"required,min=3,max=20,trim"`
+	Handle   Handle   `json:"handle" validate:"min=2,trim"`
+	Bio      *string  `json:"bio" validate:"omitempty,max=10,trim"`
+	Tags     []string `json:"tags" validate:"max=3,dive,min=2,trim"`
+	Nickname string   `json:"nickname" validate:"min=3"`
+}
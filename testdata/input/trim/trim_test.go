@@ -0,0 +1,127 @@
+package trim
+
+import (
+	"testing"
+)
+
+func TestProfileValidation(t *testing.T) {
+	paddedBio, longBio := "   hello   ", "hello world!"
+
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr bool
+	}{
+		{
+			name: "valid profile",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: false,
+		},
+		{
+			name: "padded name counts trimmed length",
+			profile: Profile{
+				Name:     "  a ",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: true,
+		},
+		{
+			name: "padded name long enough",
+			profile: Profile{
+				Name:     "  Ada  ",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: false,
+		},
+		{
+			name: "padding does not count towards max",
+			profile: Profile{
+				Name:     "  twenty-characters!!!  ",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: false,
+		},
+		{
+			name: "name too long after trim",
+			profile: Profile{
+				Name:     "twenty-one-characters",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom string type trimmed",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   " a ",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: true,
+		},
+		{
+			name: "padded bio within max after trim",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   "ada",
+				Bio:      &paddedBio,
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: false,
+		},
+		{
+			name: "bio over max",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   "ada",
+				Bio:      &longBio,
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: true,
+		},
+		{
+			name: "tag shorter than min after trim",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   "ada",
+				Tags:     []string{" x "},
+				Nickname: "  a",
+			},
+			wantErr: true,
+		},
+		{
+			name: "untrimmed field keeps raw length",
+			profile: Profile{
+				Name:     "Ada",
+				Handle:   "ada",
+				Tags:     []string{"go"},
+				Nickname: "  a",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.profile.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Profile.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
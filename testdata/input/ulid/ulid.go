@@ -0,0 +1,8 @@
+package ulid
+
+// Event is identified by ULIDs
+type Event struct {
+	ID       string   `json:"id" validate:"required,ulid"`
+	ParentID *string  `json:"parent_id" validate:"omitempty,ulid"`
+	Related  []string `json:"related" validate:"omitempty,dive,ulid"`
+}
@@ -0,0 +1,34 @@
+package ulid
+
+import "testing"
+
+func TestEventValidate(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		event   Event
+		wantErr bool
+	}{
+		{name: "valid", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"}, wantErr: false},
+		{name: "valid lowercase", event: Event{ID: "01arz3ndektsv4rrffq69g5fav"}, wantErr: false},
+		{name: "max value", event: Event{ID: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"}, wantErr: false},
+		{name: "overflow first char", event: Event{ID: "8ZZZZZZZZZZZZZZZZZZZZZZZZZ"}, wantErr: true},
+		{name: "too short", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FA"}, wantErr: true},
+		{name: "too long", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAVX"}, wantErr: true},
+		{name: "excluded letter", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAU"}, wantErr: true},
+		{name: "uuid is not a ulid", event: Event{ID: "550e8400-e29b-41d4-a716-446655440000"}, wantErr: true},
+		{name: "valid parent", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", ParentID: strPtr("01BX5ZZKBKACTAV9WEVGEMMVRZ")}, wantErr: false},
+		{name: "invalid parent", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", ParentID: strPtr("not-a-ulid")}, wantErr: true},
+		{name: "invalid related", event: Event{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Related: []string{"01BX5ZZKBKACTAV9WEVGEMMVRZ", "01ARZ3NDEKTSV4RRFFQ69G5FAO"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.event.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Event.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,24 @@ type Product struct {
 	Name string `json:"name"`
 }
 
+// Rate represents an exchange rate published for a country
+type Rate struct {
+	Currency string  `json:"currency"`
+	Country  string  `json:"country"`
+	Tier     int     `json:"tier"`
+	Value    float64 `json:"value"`
+}
+
+// Status is a custom string type
+type Status string
+
+// Account has key fields that are not plain strings
+type Account struct {
+	Number  int64    `json:"number"`
+	Status  Status   `json:"status"`
+	Aliases []string `json:"aliases"`
+}
+
 // UniqueValidation demonstrates unique constraint validation
 type UniqueValidation struct {
 	// Slice of structs with unique Email
@@ -21,6 +39,24 @@ type UniqueValidation struct {
 	// Slice of pointers with unique SKU
 	Products []*Product `json:"products" validate:"unique=SKU"`
 
+	// Slice of structs with a unique Currency+Country pair
+	Rates []Rate `json:"rates" validate:"unique=Currency+Country"`
+
+	// Slice of pointers with a unique key mixing string and int fields
+	Tiers []*Rate `json:"tiers" validate:"unique=Currency+Country+Tier"`
+
+	// Slice of structs keyed by an int64 field
+	Accounts []Account `json:"accounts" validate:"unique=Number"`
+
+	// Slice of structs keyed by a custom string type
+	ByStatus []Account `json:"byStatus" validate:"unique=Status"`
+
+	// Slice of pointers keyed by a slice field, compared by its formatted value
+	ByAliases []*Account `json:"byAliases" validate:"unique=Aliases"`
+
+	// Slice of a custom string type
+	Statuses []Status `json:"statuses" validate:"unique"`
+
 	// Slice of scalars - unique values
 	Tags []string `json:"tags" validate:"unique"`
 
@@ -0,0 +1,140 @@
+package unique
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUniqueValidationCompositeKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       UniqueValidation
+		wantErr string
+	}{
+		{
+			name: "distinct pairs",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Rates:       []Rate{{Currency: "EUR", Country: "DE"}, {Currency: "EUR", Country: "FR"}, {Currency: "USD", Country: "DE"}},
+				CategoryIDs: []int{1},
+			},
+		},
+		{
+			name: "duplicate pair",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Rates:       []Rate{{Currency: "EUR", Country: "DE", Value: 1}, {Currency: "USD", Country: "US"}, {Currency: "EUR", Country: "DE", Value: 2}},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field Rates has duplicate Currency+Country at index 2",
+		},
+		{
+			name: "pointer elements differing in tier",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Tiers:       []*Rate{{Currency: "EUR", Country: "DE", Tier: 1}, nil, {Currency: "EUR", Country: "DE", Tier: 2}},
+				CategoryIDs: []int{1},
+			},
+		},
+		{
+			name: "pointer elements with duplicate key",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Tiers:       []*Rate{{Currency: "EUR", Country: "DE", Tier: 1}, nil, {Currency: "EUR", Country: "DE", Tier: 1}},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field Tiers has duplicate Currency+Country+Tier at index 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUniqueValidationTypedKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       UniqueValidation
+		wantErr string
+	}{
+		{
+			name: "distinct numbers",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Accounts:    []Account{{Number: 1}, {Number: 2}},
+				CategoryIDs: []int{1},
+			},
+		},
+		{
+			name: "duplicate number",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Accounts:    []Account{{Number: 1}, {Number: 1}},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field Accounts has duplicate Number at index 1",
+		},
+		{
+			name: "duplicate custom string type",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				ByStatus:    []Account{{Status: "open"}, {Status: "closed"}, {Status: "open"}},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field ByStatus has duplicate Status at index 2",
+		},
+		{
+			name: "distinct slice fields",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				ByAliases:   []*Account{{Aliases: []string{"a", "b"}}, {Aliases: []string{"a"}}},
+				CategoryIDs: []int{1},
+			},
+		},
+		{
+			name: "duplicate slice field",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				ByAliases:   []*Account{{Aliases: []string{"a", "b"}}, nil, {Aliases: []string{"a", "b"}}},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field ByAliases has duplicate Aliases at index 2",
+		},
+		{
+			name: "duplicate custom string element",
+			v: UniqueValidation{
+				Users:       []User{{Email: "a@example.com"}},
+				Statuses:    []Status{"open", "open"},
+				CategoryIDs: []int{1},
+			},
+			wantErr: "field Statuses has duplicate value at index 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
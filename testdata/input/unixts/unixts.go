@@ -0,0 +1,14 @@
+package unixts
+
+// EpochMillis is a millisecond timestamp as sent by browsers
+type EpochMillis int64
+
+// Event demonstrates Unix timestamp validation of event payloads
+type Event struct {
+	OccurredAt int64       `json:"occurred_at" validate:"required,unixts"`
+	ReceivedAt EpochMillis `json:"received_at" validate:"unixts=ms"`
+	Sequence   uint32      `json:"sequence" validate:"unixts,from=2020-01-01,to=2030-01-01"`
+	ExpiresAt  *int64      `json:"expires_at" validate:"omitempty,unixts"`
+	Header     string      `json:"header" validate:"required,unixts=s,from=2015-06-01"`
+	Trace      *string     `json:"trace" validate:"omitempty,unixts=ms,to=2050-01-01"`
+}
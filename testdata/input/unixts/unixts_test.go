@@ -0,0 +1,128 @@
+package unixts
+
+import (
+	"testing"
+)
+
+func TestEventValidation(t *testing.T) {
+	future := int64(4200000000)
+	traceMs, traceSeconds := "1700000000123", "1700000000"
+
+	tests := []struct {
+		name    string
+		event   Event
+		wantErr bool
+	}{
+		{
+			name: "valid event",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+			},
+			wantErr: false,
+		},
+		{
+			name: "milliseconds as seconds",
+			event: Event{
+				OccurredAt: 1700000000123,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "seconds as milliseconds",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "before custom range",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1500000000,
+				Header:     "1700000000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "after default range",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				ExpiresAt:  &future,
+				Header:     "1700000000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "string not a number",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "2023-11-14",
+			},
+			wantErr: true,
+		},
+		{
+			name: "string before from",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1400000000",
+			},
+			wantErr: true,
+		},
+		{
+			name: "pointer string in range",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+				Trace:      &traceMs,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pointer string wrong unit",
+			event: Event{
+				OccurredAt: 1700000000,
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+				Trace:      &traceSeconds,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing required",
+			event: Event{
+				ReceivedAt: 1700000000123,
+				Sequence:   1700000000,
+				Header:     "1700000000",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.event.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Event.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
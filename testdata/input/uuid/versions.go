@@ -0,0 +1,11 @@
+package uuid
+
+// Versioned demonstrates version-specific UUID validation
+type Versioned struct {
+	NameID     string  `json:"name_id" validate:"uuid3"`
+	RandomID   string  `json:"random_id" validate:"required,uuid4"`
+	HashID     string  `json:"hash_id" validate:"uuid5"`
+	AnyID      string  `json:"any_id" validate:"uuid_rfc4122"`
+	ParentID   string  `json:"parent_id" validate:"uuid4=nil"`
+	OptionalID *string `json:"optional_id" validate:"omitempty,uuid=nil"`
+}
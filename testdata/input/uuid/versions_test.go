@@ -0,0 +1,149 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestVersionedValidation(t *testing.T) {
+	nilUUID := "00000000-0000-0000-0000-000000000000"
+	malformed := "00000000-0000-0000-0000-00000000000"
+
+	tests := []struct {
+		name    string
+		v       Versioned
+		wantErr bool
+	}{
+		{
+			name: "all valid",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: false,
+		},
+		{
+			name: "uuid3 field with version 4",
+			v: Versioned{
+				NameID:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid4 field with version 1",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid5 field with version 3",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid4 rejects nil UUID without option",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: nilUUID,
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid_rfc4122 accepts version 1",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: false,
+		},
+		{
+			name: "uuid_rfc4122 rejects non-RFC 4122 variant",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-c8c4-dc0c0c07398f",
+				ParentID: "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid4=nil accepts nil UUID",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: nilUUID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "uuid4=nil rejects version 1",
+			v: Versioned{
+				NameID:   "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:   "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			},
+			wantErr: true,
+		},
+		{
+			name: "uuid=nil accepts nil UUID through pointer",
+			v: Versioned{
+				NameID:     "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:     "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:      "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID:   "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+				OptionalID: &nilUUID,
+			},
+			wantErr: false,
+		},
+		{
+			name: "uuid=nil rejects malformed value",
+			v: Versioned{
+				NameID:     "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+				RandomID:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				HashID:     "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+				AnyID:      "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+				ParentID:   "9b2e6a31-4f0d-4c8e-b1a7-3d5c2e8f6a10",
+				OptionalID: &malformed,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Versioned.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
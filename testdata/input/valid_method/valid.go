@@ -0,0 +1,31 @@
+package valid_method
+
+import "time"
+
+// User gets a generated Valid method
+type User struct {
+	Name  string `validate:"required"`
+	Email string `validate:"required,email"`
+}
+
+// Page is generic; Valid keeps its type parameters
+type Page[T any] struct {
+	Items []T `validate:"max=50"`
+}
+
+// Token declares its own Valid method, which is kept
+type Token struct {
+	Value     string `validate:"required"`
+	ExpiresAt time.Time
+}
+
+// Valid reports whether the token is set and has not expired
+func (t *Token) Valid() bool {
+	return t.Validate() == nil && time.Now().Before(t.ExpiresAt)
+}
+
+// Flag has a field named Valid, so no method is generated
+type Flag struct {
+	Name  string `validate:"required"`
+	Valid bool
+}
@@ -0,0 +1,41 @@
+package valid_method
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUser_Valid(t *testing.T) {
+	tests := []struct {
+		name string
+		user User
+		want bool
+	}{
+		{name: "valid", user: User{Name: "alice", Email: "a@example.com"}, want: true},
+		{name: "missing name", user: User{Email: "a@example.com"}},
+		{name: "invalid email", user: User{Name: "alice", Email: "nope"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.user.Valid(); got != tt.want {
+				t.Errorf("Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPage_Valid(t *testing.T) {
+	if !(&Page[int]{Items: []int{1, 2}}).Valid() {
+		t.Errorf("Valid() = false, want true")
+	}
+	if (&Page[int]{Items: make([]int, 51)}).Valid() {
+		t.Errorf("Valid() = true, want false")
+	}
+}
+
+func TestToken_OwnValid(t *testing.T) {
+	expired := Token{Value: "abc", ExpiresAt: time.Now().Add(-time.Hour)}
+	if expired.Valid() {
+		t.Errorf("Valid() = true for an expired token, want the declared method to be kept")
+	}
+}
@@ -0,0 +1,19 @@
+package validatable
+
+import "github.com/n10ty/houp/validate"
+
+// Batch holds documents of any type with a Validate method
+type Batch struct {
+	Documents []validate.Validatable `validate:"min=1,dive"`
+}
+
+// Invoice is one kind of document
+type Invoice struct {
+	Number string `validate:"required"`
+	Total  int    `validate:"gt=0"`
+}
+
+// Receipt is another
+type Receipt struct {
+	Store string `validate:"required"`
+}
@@ -0,0 +1,70 @@
+package validatable
+
+import (
+	"testing"
+
+	"github.com/n10ty/houp/validate"
+)
+
+var (
+	_ validate.Validatable = (*Invoice)(nil)
+	_ validate.Validatable = (*Receipt)(nil)
+	_ validate.Validatable = (*Batch)(nil)
+)
+
+func TestBatch_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		batch   Batch
+		wantErr string
+	}{
+		{
+			name:  "valid documents",
+			batch: Batch{Documents: []validate.Validatable{&Invoice{Number: "A-1", Total: 100}, &Receipt{Store: "Corner"}}},
+		},
+		{
+			name:    "invalid document",
+			batch:   Batch{Documents: []validate.Validatable{&Invoice{Number: "A-1", Total: 100}, &Receipt{}}},
+			wantErr: "field Documents[1] validation failed: field Store is required",
+		},
+		{
+			name:  "nil document skipped",
+			batch: Batch{Documents: []validate.Validatable{(*Invoice)(nil)}},
+		},
+		{
+			name:    "empty",
+			batch:   Batch{},
+			wantErr: "field Documents must have at least 1 elements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.batch.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// validateAll accepts any generated type
+func validateAll(vs ...validate.Validatable) error {
+	for _, v := range vs {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestValidateAll(t *testing.T) {
+	if err := validateAll(&Invoice{Number: "A-1", Total: 1}, &Receipt{}); err == nil || err.Error() != "field Store is required" {
+		t.Errorf("validateAll() error = %v, want %q", err, "field Store is required")
+	}
+}
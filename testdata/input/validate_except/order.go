@@ -0,0 +1,19 @@
+package validate_except
+
+// Order is created from a request body; the server sets ID and CreatedAt
+type Order struct {
+	ID        string   `validate:"required,uuid"`
+	CreatedAt string   `validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Customer  string   `validate:"required,min=2"`
+	Items     []string `validate:"min=1,dive,required"`
+	Note      string
+}
+
+// Comment reports every invalid field at once
+//
+//validate:multierror
+type Comment struct {
+	ID     string `validate:"required"`
+	Author string `validate:"required"`
+	Body   string `validate:"required,max=280"`
+}
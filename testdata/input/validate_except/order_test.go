@@ -0,0 +1,64 @@
+package validate_except
+
+import "testing"
+
+func TestOrder_ValidateExcept(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   Order
+		fields  []string
+		wantErr string
+	}{
+		{
+			name:   "server fields skipped",
+			order:  Order{Customer: "Ann", Items: []string{"book"}},
+			fields: []string{"ID", "CreatedAt"},
+		},
+		{
+			name:    "unlisted field checked",
+			order:   Order{Customer: "Ann", Items: []string{"book"}},
+			fields:  []string{"ID"},
+			wantErr: "field CreatedAt is required",
+		},
+		{
+			name:    "invalid inbound field",
+			order:   Order{Customer: "A", Items: []string{"book"}},
+			fields:  []string{"ID", "CreatedAt"},
+			wantErr: "field Customer must be at least 2 characters",
+		},
+		{
+			name:   "field without rules",
+			order:  Order{ID: "3f2a8a3e-6a4c-4f8e-9b7a-1d2c3e4f5a6b", CreatedAt: "2024-01-02T03:04:05Z", Customer: "Ann", Items: []string{"book"}},
+			fields: []string{"Note"},
+		},
+		{
+			name:    "unknown field",
+			order:   Order{Customer: "Ann", Items: []string{"book"}},
+			fields:  []string{"Id"},
+			wantErr: "Order has no field Id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.ValidateExcept(tt.fields...)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateExcept() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateExcept() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComment_ValidateExcept(t *testing.T) {
+	comment := Comment{}
+	err := comment.ValidateExcept("ID")
+	want := "field Author is required\nfield Body is required"
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateExcept() error = %v, want %q", err, want)
+	}
+}
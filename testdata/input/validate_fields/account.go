@@ -0,0 +1,13 @@
+package validate_fields
+
+// Account is updated field by field with PATCH requests
+//
+//validate:checkQuota
+type Account struct {
+	Name     string   `validate:"required,min=2"`
+	Email    string   `validate:"omitempty,email"`
+	Tags     []string `validate:"dive,max=5"`
+	Nickname string
+	Quota    int
+	Used     int
+}
@@ -0,0 +1,34 @@
+package validate_fields
+
+import "testing"
+
+func TestAccount_ValidateFields(t *testing.T) {
+	// Name is invalid and Used exceeds Quota, but only the listed fields are checked
+	account := Account{Email: "nope", Tags: []string{"go"}, Used: 10}
+	tests := []struct {
+		name    string
+		fields  []string
+		wantErr string
+	}{
+		{name: "no fields"},
+		{name: "valid field", fields: []string{"Tags"}},
+		{name: "field without rules", fields: []string{"Nickname", "Quota"}},
+		{name: "invalid field", fields: []string{"Tags", "Email"}, wantErr: "field Email must be a valid email address"},
+		{name: "first failure", fields: []string{"Name", "Email"}, wantErr: "field Name is required"},
+		{name: "unknown field", fields: []string{"Tags", "Phone"}, wantErr: "Account has no field Phone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := account.ValidateFields(tt.fields...)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateFields() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateFields() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
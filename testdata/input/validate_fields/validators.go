@@ -0,0 +1,10 @@
+package validate_fields
+
+import "fmt"
+
+func checkQuota(a *Account) error {
+	if a.Used > a.Quota {
+		return fmt.Errorf("used %d exceeds quota %d", a.Used, a.Quota)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package value_receiver
+
+import (
+	"errors"
+
+	"github.com/n10ty/houp/validate"
+)
+
+// Event is passed around by value
+//
+//validate:freeze
+//validate:checkWindow
+type Event struct {
+	Title     string              `validate:"required"`
+	Start     int                 `validate:"gte=0"`
+	End       int                 `validate:"gte=0"`
+	Venue     Venue               `validate:"dive"`
+	Attendees map[string]Attendee `validate:"dive"`
+	Labels    Labels              `validate:"dive"`
+}
+
+// Venue is validated through the dive of Event
+type Venue struct {
+	City string `validate:"required"`
+}
+
+// Attendee is a map value
+type Attendee struct {
+	Email string `validate:"required,email"`
+}
+
+// Labels is a named slice type with its own rules
+//
+//validate:max=3,dive,required
+type Labels []string
+
+// Feed holds values of any validated type
+type Feed struct {
+	Items []validate.Validatable `validate:"dive"`
+}
+
+// checkWindow still receives a pointer, to the copy held by the value receiver
+func checkWindow(e *Event) error {
+	if e.End < e.Start {
+		return errors.New("event ends before it starts")
+	}
+	return nil
+}
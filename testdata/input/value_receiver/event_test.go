@@ -0,0 +1,120 @@
+package value_receiver
+
+import (
+	"testing"
+
+	"github.com/n10ty/houp/validate"
+)
+
+var (
+	_ validate.Validatable = Event{}
+	_ validate.Validatable = Labels{}
+)
+
+func TestEvent_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   Event
+		wantErr string
+	}{
+		{
+			name: "valid",
+			event: Event{
+				Title:     "Launch",
+				Start:     1,
+				End:       2,
+				Venue:     Venue{City: "Berlin"},
+				Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}},
+				Labels:    Labels{"go"},
+			},
+		},
+		{
+			name: "struct validator",
+			event: Event{
+				Title:     "Launch",
+				Start:     1,
+				Venue:     Venue{City: "Berlin"},
+				Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}},
+				Labels:    Labels{"go"},
+			},
+			wantErr: "struct validation failed: event ends before it starts",
+		},
+		{
+			name: "venue",
+			event: Event{
+				Title:     "Launch",
+				Start:     1,
+				End:       2,
+				Venue:     Venue{},
+				Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}},
+				Labels:    Labels{"go"},
+			},
+			wantErr: "field Venue validation failed: field City is required",
+		},
+		{
+			name: "attendee",
+			event: Event{
+				Title:     "Launch",
+				Start:     1,
+				End:       2,
+				Venue:     Venue{City: "Berlin"},
+				Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}, "bob": {Email: "bob"}},
+				Labels:    Labels{"go"},
+			},
+			wantErr: `field Attendees["bob"] validation failed: field Email must be a valid email address`,
+		},
+		{
+			name: "labels",
+			event: Event{
+				Title:     "Launch",
+				Start:     1,
+				End:       2,
+				Venue:     Venue{City: "Berlin"},
+				Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}},
+				Labels:    Labels{"a", "b", "c", "d"},
+			},
+			wantErr: "field Labels validation failed: field Labels must have at most 3 elements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.event.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFeed_Validate(t *testing.T) {
+	feed := Feed{Items: []validate.Validatable{Event{Title: "Launch", Venue: Venue{City: "Berlin"}}, Venue{}}}
+	want := "field Items[1] validation failed: field City is required"
+	if err := feed.Validate(); err == nil || err.Error() != want {
+		t.Errorf("Validate() error = %v, want %q", err, want)
+	}
+}
+
+func TestEvent_ValidateAndFreeze(t *testing.T) {
+	event := Event{
+		Title:     "Launch",
+		Start:     1,
+		End:       2,
+		Venue:     Venue{City: "Berlin"},
+		Attendees: map[string]Attendee{"ann": {Email: "ann@example.com"}},
+		Labels:    Labels{"go"},
+	}
+	frozen, err := event.ValidateAndFreeze()
+	if err != nil {
+		t.Fatalf("ValidateAndFreeze() error = %v", err)
+	}
+	event.Labels[0] = "changed"
+	if frozen.Labels[0] != "go" {
+		t.Errorf("frozen Labels[0] = %q, want %q", frozen.Labels[0], "go")
+	}
+}
@@ -0,0 +1,20 @@
+// Package validate holds the interfaces that types with houp-generated methods
+// implement, so code can accept any of them without naming the concrete types.
+// Generated code does not import it: dives check for the same method set.
+package validate
+
+import "context"
+
+// Validatable is implemented by every type houp generates a Validate method for.
+// A field of this type, or a slice or map of it, tagged dive is validated through
+// the method of its dynamic type.
+type Validatable interface {
+	Validate() error
+}
+
+// ContextValidatable is implemented by types that have context-aware validators,
+// or all types generated with --context
+type ContextValidatable interface {
+	Validatable
+	ValidateContext(ctx context.Context) error
+}